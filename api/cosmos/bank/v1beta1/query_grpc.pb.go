@@ -19,19 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Balance_FullMethodName                    = "/cosmos.bank.v1beta1.Query/Balance"
-	Query_AllBalances_FullMethodName                = "/cosmos.bank.v1beta1.Query/AllBalances"
-	Query_SpendableBalances_FullMethodName          = "/cosmos.bank.v1beta1.Query/SpendableBalances"
-	Query_SpendableBalanceByDenom_FullMethodName    = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
-	Query_TotalSupply_FullMethodName                = "/cosmos.bank.v1beta1.Query/TotalSupply"
-	Query_SupplyOf_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SupplyOf"
-	Query_Params_FullMethodName                     = "/cosmos.bank.v1beta1.Query/Params"
-	Query_DenomsMetadata_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
-	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
-	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
-	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
-	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
-	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Balance_FullMethodName                       = "/cosmos.bank.v1beta1.Query/Balance"
+	Query_AllBalances_FullMethodName                   = "/cosmos.bank.v1beta1.Query/AllBalances"
+	Query_SpendableBalances_FullMethodName             = "/cosmos.bank.v1beta1.Query/SpendableBalances"
+	Query_SpendableBalanceByDenom_FullMethodName       = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
+	Query_TotalSupply_FullMethodName                   = "/cosmos.bank.v1beta1.Query/TotalSupply"
+	Query_SupplyOf_FullMethodName                      = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	Query_Params_FullMethodName                        = "/cosmos.bank.v1beta1.Query/Params"
+	Query_DenomsMetadata_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
+	Query_DenomMetadata_FullMethodName                 = "/cosmos.bank.v1beta1.Query/DenomMetadata"
+	Query_DenomMetadataByQueryString_FullMethodName    = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
+	Query_DenomOwners_FullMethodName                   = "/cosmos.bank.v1beta1.Query/DenomOwners"
+	Query_DenomOwnersByQuery_FullMethodName            = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
+	Query_SendEnabled_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Holds_FullMethodName                         = "/cosmos.bank.v1beta1.Query/Holds"
+	Query_FactoryDenom_FullMethodName                  = "/cosmos.bank.v1beta1.Query/FactoryDenom"
+	Query_FactoryDenomsByCreator_FullMethodName        = "/cosmos.bank.v1beta1.Query/FactoryDenomsByCreator"
+	Query_ScheduledTransfer_FullMethodName             = "/cosmos.bank.v1beta1.Query/ScheduledTransfer"
+	Query_ScheduledTransfersBySender_FullMethodName    = "/cosmos.bank.v1beta1.Query/ScheduledTransfersBySender"
+	Query_ScheduledTransfersByRecipient_FullMethodName = "/cosmos.bank.v1beta1.Query/ScheduledTransfersByRecipient"
+	Query_SendPolicy_FullMethodName                    = "/cosmos.bank.v1beta1.Query/SendPolicy"
+	Query_SendPolicies_FullMethodName                  = "/cosmos.bank.v1beta1.Query/SendPolicies"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +100,25 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Holds queries the coins held in an account, grouped by the reason of the hold.
+	//
+	// Held coins are part of the account balance but are not spendable until the hold
+	// is released.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// FactoryDenom queries the admin and send restriction of a factory denom.
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the factory denoms created by an account.
+	FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error)
+	// ScheduledTransfer queries a scheduled transfer by its id.
+	ScheduledTransfer(ctx context.Context, in *QueryScheduledTransferRequest, opts ...grpc.CallOption) (*QueryScheduledTransferResponse, error)
+	// ScheduledTransfersBySender queries the pending scheduled transfers sent by an account.
+	ScheduledTransfersBySender(ctx context.Context, in *QueryScheduledTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryScheduledTransfersBySenderResponse, error)
+	// ScheduledTransfersByRecipient queries the pending scheduled transfers received by an account.
+	ScheduledTransfersByRecipient(ctx context.Context, in *QueryScheduledTransfersByRecipientRequest, opts ...grpc.CallOption) (*QueryScheduledTransfersByRecipientResponse, error)
+	// SendPolicy queries the send policy of a denom.
+	SendPolicy(ctx context.Context, in *QuerySendPolicyRequest, opts ...grpc.CallOption) (*QuerySendPolicyResponse, error)
+	// SendPolicies queries the send policies of all the denoms.
+	SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error)
 }
 
 type queryClient struct {
@@ -232,6 +259,86 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, Query_Holds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFactoryDenomsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenomsByCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledTransfer(ctx context.Context, in *QueryScheduledTransferRequest, opts ...grpc.CallOption) (*QueryScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledTransfersBySender(ctx context.Context, in *QueryScheduledTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryScheduledTransfersBySenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledTransfersBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledTransfersBySender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledTransfersByRecipient(ctx context.Context, in *QueryScheduledTransfersByRecipientRequest, opts ...grpc.CallOption) (*QueryScheduledTransfersByRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledTransfersByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledTransfersByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendPolicy(ctx context.Context, in *QuerySendPolicyRequest, opts ...grpc.CallOption) (*QuerySendPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySendPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_SendPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendPolicies(ctx context.Context, in *QuerySendPoliciesRequest, opts ...grpc.CallOption) (*QuerySendPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySendPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_SendPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -290,6 +397,25 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Holds queries the coins held in an account, grouped by the reason of the hold.
+	//
+	// Held coins are part of the account balance but are not spendable until the hold
+	// is released.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// FactoryDenom queries the admin and send restriction of a factory denom.
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the factory denoms created by an account.
+	FactoryDenomsByCreator(context.Context, *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error)
+	// ScheduledTransfer queries a scheduled transfer by its id.
+	ScheduledTransfer(context.Context, *QueryScheduledTransferRequest) (*QueryScheduledTransferResponse, error)
+	// ScheduledTransfersBySender queries the pending scheduled transfers sent by an account.
+	ScheduledTransfersBySender(context.Context, *QueryScheduledTransfersBySenderRequest) (*QueryScheduledTransfersBySenderResponse, error)
+	// ScheduledTransfersByRecipient queries the pending scheduled transfers received by an account.
+	ScheduledTransfersByRecipient(context.Context, *QueryScheduledTransfersByRecipientRequest) (*QueryScheduledTransfersByRecipientResponse, error)
+	// SendPolicy queries the send policy of a denom.
+	SendPolicy(context.Context, *QuerySendPolicyRequest) (*QuerySendPolicyResponse, error)
+	// SendPolicies queries the send policies of all the denoms.
+	SendPolicies(context.Context, *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -339,6 +465,30 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenomsByCreator(context.Context, *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsByCreator not implemented")
+}
+func (UnimplementedQueryServer) ScheduledTransfer(context.Context, *QueryScheduledTransferRequest) (*QueryScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTransfer not implemented")
+}
+func (UnimplementedQueryServer) ScheduledTransfersBySender(context.Context, *QueryScheduledTransfersBySenderRequest) (*QueryScheduledTransfersBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTransfersBySender not implemented")
+}
+func (UnimplementedQueryServer) ScheduledTransfersByRecipient(context.Context, *QueryScheduledTransfersByRecipientRequest) (*QueryScheduledTransfersByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTransfersByRecipient not implemented")
+}
+func (UnimplementedQueryServer) SendPolicy(context.Context, *QuerySendPolicyRequest) (*QuerySendPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPolicy not implemented")
+}
+func (UnimplementedQueryServer) SendPolicies(context.Context, *QuerySendPoliciesRequest) (*QuerySendPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPolicies not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -594,6 +744,150 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Holds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenomsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, req.(*QueryFactoryDenomsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledTransfer(ctx, req.(*QueryScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledTransfersBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledTransfersBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledTransfersBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledTransfersBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledTransfersBySender(ctx, req.(*QueryScheduledTransfersBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledTransfersByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledTransfersByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledTransfersByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledTransfersByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledTransfersByRecipient(ctx, req.(*QueryScheduledTransfersByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendPolicy(ctx, req.(*QuerySendPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendPolicies(ctx, req.(*QuerySendPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +947,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsByCreator",
+			Handler:    _Query_FactoryDenomsByCreator_Handler,
+		},
+		{
+			MethodName: "ScheduledTransfer",
+			Handler:    _Query_ScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ScheduledTransfersBySender",
+			Handler:    _Query_ScheduledTransfersBySender_Handler,
+		},
+		{
+			MethodName: "ScheduledTransfersByRecipient",
+			Handler:    _Query_ScheduledTransfersByRecipient_Handler,
+		},
+		{
+			MethodName: "SendPolicy",
+			Handler:    _Query_SendPolicy_Handler,
+		},
+		{
+			MethodName: "SendPolicies",
+			Handler:    _Query_SendPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
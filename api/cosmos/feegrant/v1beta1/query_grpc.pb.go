@@ -24,6 +24,8 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_SponsorPool_FullMethodName         = "/cosmos.feegrant.v1beta1.Query/SponsorPool"
+	Query_SponsorPoolUsage_FullMethodName    = "/cosmos.feegrant.v1beta1.Query/SponsorPoolUsage"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +40,12 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// SponsorPool returns the sponsor pool of the sponsor, including its
+	// remaining budget.
+	SponsorPool(ctx context.Context, in *QuerySponsorPoolRequest, opts ...grpc.CallOption) (*QuerySponsorPoolResponse, error)
+	// SponsorPoolUsage returns the fees sponsored by the pool for the user within
+	// the current period.
+	SponsorPoolUsage(ctx context.Context, in *QuerySponsorPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorPoolUsageResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +86,26 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) SponsorPool(ctx context.Context, in *QuerySponsorPoolRequest, opts ...grpc.CallOption) (*QuerySponsorPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorPoolResponse)
+	err := c.cc.Invoke(ctx, Query_SponsorPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorPoolUsage(ctx context.Context, in *QuerySponsorPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorPoolUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorPoolUsageResponse)
+	err := c.cc.Invoke(ctx, Query_SponsorPoolUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +118,12 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// SponsorPool returns the sponsor pool of the sponsor, including its
+	// remaining budget.
+	SponsorPool(context.Context, *QuerySponsorPoolRequest) (*QuerySponsorPoolResponse, error)
+	// SponsorPoolUsage returns the fees sponsored by the pool for the user within
+	// the current period.
+	SponsorPoolUsage(context.Context, *QuerySponsorPoolUsageRequest) (*QuerySponsorPoolUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +143,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) SponsorPool(context.Context, *QuerySponsorPoolRequest) (*QuerySponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPool not implemented")
+}
+func (UnimplementedQueryServer) SponsorPoolUsage(context.Context, *QuerySponsorPoolUsageRequest) (*QuerySponsorPoolUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPoolUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +224,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsorPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorPool(ctx, req.(*QuerySponsorPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorPoolUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorPoolUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorPoolUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsorPoolUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorPoolUsage(ctx, req.(*QuerySponsorPoolUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +279,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "SponsorPool",
+			Handler:    _Query_SponsorPool_Handler,
+		},
+		{
+			MethodName: "SponsorPoolUsage",
+			Handler:    _Query_SponsorPoolUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName    = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_CreateSponsorPool_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/CreateSponsorPool"
+	Msg_CloseSponsorPool_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/CloseSponsorPool"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +42,11 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorPool opens a pool sponsoring, from the sponsor's account, the
+	// fees of any address executing the allowed messages.
+	CreateSponsorPool(ctx context.Context, in *MsgCreateSponsorPool, opts ...grpc.CallOption) (*MsgCreateSponsorPoolResponse, error)
+	// CloseSponsorPool closes the sponsor pool of the sponsor.
+	CloseSponsorPool(ctx context.Context, in *MsgCloseSponsorPool, opts ...grpc.CallOption) (*MsgCloseSponsorPoolResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +87,26 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) CreateSponsorPool(ctx context.Context, in *MsgCreateSponsorPool, opts ...grpc.CallOption) (*MsgCreateSponsorPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSponsorPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseSponsorPool(ctx context.Context, in *MsgCloseSponsorPool, opts ...grpc.CallOption) (*MsgCloseSponsorPoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCloseSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CloseSponsorPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -94,6 +121,11 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorPool opens a pool sponsoring, from the sponsor's account, the
+	// fees of any address executing the allowed messages.
+	CreateSponsorPool(context.Context, *MsgCreateSponsorPool) (*MsgCreateSponsorPoolResponse, error)
+	// CloseSponsorPool closes the sponsor pool of the sponsor.
+	CloseSponsorPool(context.Context, *MsgCloseSponsorPool) (*MsgCloseSponsorPoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +145,12 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) CreateSponsorPool(context.Context, *MsgCreateSponsorPool) (*MsgCreateSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsorPool not implemented")
+}
+func (UnimplementedMsgServer) CloseSponsorPool(context.Context, *MsgCloseSponsorPool) (*MsgCloseSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSponsorPool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -188,6 +226,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSponsorPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsorPool(ctx, req.(*MsgCreateSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CloseSponsorPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseSponsorPool(ctx, req.(*MsgCloseSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +281,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "CreateSponsorPool",
+			Handler:    _Msg_CreateSponsorPool_Handler,
+		},
+		{
+			MethodName: "CloseSponsorPool",
+			Handler:    _Msg_CloseSponsorPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName            = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_OffenceHistory_FullMethodName    = "/cosmos.slashing.v1beta1.Query/OffenceHistory"
+	Query_MaintenanceWindow_FullMethodName = "/cosmos.slashing.v1beta1.Query/MaintenanceWindow"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +38,10 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// OffenceHistory queries the offence history of given cons address, oldest first.
+	OffenceHistory(ctx context.Context, in *QueryOffenceHistoryRequest, opts ...grpc.CallOption) (*QueryOffenceHistoryResponse, error)
+	// MaintenanceWindow queries the maintenance window announced by a validator.
+	MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +82,26 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) OffenceHistory(ctx context.Context, in *QueryOffenceHistoryRequest, opts ...grpc.CallOption) (*QueryOffenceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOffenceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_OffenceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +114,10 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// OffenceHistory queries the offence history of given cons address, oldest first.
+	OffenceHistory(context.Context, *QueryOffenceHistoryRequest) (*QueryOffenceHistoryResponse, error)
+	// MaintenanceWindow queries the maintenance window announced by a validator.
+	MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +137,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) OffenceHistory(context.Context, *QueryOffenceHistoryRequest) (*QueryOffenceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffenceHistory not implemented")
+}
+func (UnimplementedQueryServer) MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindow not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +218,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OffenceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffenceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffenceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OffenceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffenceHistory(ctx, req.(*QueryOffenceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindow(ctx, req.(*QueryMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +273,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "OffenceHistory",
+			Handler:    _Query_OffenceHistory_Handler,
+		},
+		{
+			MethodName: "MaintenanceWindow",
+			Handler:    _Query_MaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName              = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_AnnounceMaintenance_FullMethodName = "/cosmos.slashing.v1beta1.Msg/AnnounceMaintenance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AnnounceMaintenance defines a method for a validator to announce a one-time
+	// maintenance window, during which its missed blocks are not counted against
+	// its liveness.
+	AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +71,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAnnounceMaintenanceResponse)
+	err := c.cc.Invoke(ctx, Msg_AnnounceMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -79,6 +94,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AnnounceMaintenance defines a method for a validator to announce a one-time
+	// maintenance window, during which its missed blocks are not counted against
+	// its liveness.
+	AnnounceMaintenance(context.Context, *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +114,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) AnnounceMaintenance(context.Context, *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceMaintenance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -152,6 +174,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AnnounceMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnounceMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AnnounceMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, req.(*MsgAnnounceMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,6 +207,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AnnounceMaintenance",
+			Handler:    _Msg_AnnounceMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -1,8 +1,22 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is a CircuitBreaker which can also decide on the message
+// itself, e.g. on its signers or field values. When the circuit breaker of the
+// MsgServiceRouter implements it, IsMsgAllowed is called instead of IsAllowed
+// before executing each message.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+}
@@ -121,7 +121,7 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	// decorate the hybrid handler with the circuit breaker
 	circuitBreakerHybridHandler := func(ctx context.Context, req, resp protoiface.MessageV1) error {
 		messageName := codectypes.MsgTypeURL(req)
-		allowed, err := msr.circuitBreaker.IsAllowed(ctx, messageName)
+		allowed, err := msr.isMsgAllowed(ctx, messageName, req)
 		if err != nil {
 			return err
 		}
@@ -134,6 +134,15 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	return nil
 }
 
+// isMsgAllowed checks the message against the circuit breaker, on the message
+// itself if the circuit breaker is a MsgCircuitBreaker.
+func (msr *MsgServiceRouter) isMsgAllowed(ctx context.Context, msgURL string, msg sdk.Msg) (bool, error) {
+	if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+		return cb.IsMsgAllowed(ctx, msg)
+	}
+	return msr.circuitBreaker.IsAllowed(ctx, msgURL)
+}
+
 func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, method grpc.MethodDesc, handler interface{}) error {
 	fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
 	methodHandler := method.Handler
@@ -205,7 +214,7 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 
 		if msr.circuitBreaker != nil {
 			msgURL := sdk.MsgTypeURL(msg)
-			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+			isAllowed, err := msr.isMsgAllowed(ctx, msgURL, msg)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,253 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultCacheMaxEntries is the default amount of decoded values a CachedMap
+// or a CachedItem retains before the memo is reset.
+const DefaultCacheMaxEntries = 1024
+
+// CacheStats reports the hit and miss counters of a cached collection.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// CacheOption configures a CachedMap or a CachedItem.
+type CacheOption func(o *cacheOptions)
+
+type cacheOptions struct {
+	maxEntries int
+	observer   func(name string, hit bool)
+}
+
+// WithCacheMaxEntries sets the maximum number of decoded values retained by the cache.
+// Once the limit is reached the memo is reset. A non-positive value disables the limit.
+func WithCacheMaxEntries(maxEntries int) CacheOption {
+	return func(o *cacheOptions) {
+		o.maxEntries = maxEntries
+	}
+}
+
+// WithCacheObserver registers a function which is called on every cached read
+// with the name of the collection and whether the read was a hit or a miss.
+// It can be used to forward hit/miss telemetry to the application's metrics sink.
+func WithCacheObserver(observer func(name string, hit bool)) CacheOption {
+	return func(o *cacheOptions) {
+		o.observer = observer
+	}
+}
+
+// CachedMap wraps a Map and memoizes the values decoded by Get.
+//
+// The underlying store is always read, so gas consumption and the view of the
+// current store branch are the same as with a plain Map; a memoized value is
+// only returned when the bytes read from the store are equal to the bytes it
+// was decoded from. This makes the cache safe to share across block execution,
+// CheckTx and cached contexts, and it only saves the cost of decoding values.
+// Writes performed through the CachedMap invalidate the memoized value of the key.
+//
+// Values returned by Get are shared with the cache and must not be mutated.
+// Iteration is not cached and goes straight to the underlying Map.
+type CachedMap[K, V any] struct {
+	Map[K, V]
+
+	cache *valueCache[V]
+}
+
+// NewCachedMap wraps the provided Map with a memoization layer for decoded values.
+func NewCachedMap[K, V any](m Map[K, V], opts ...CacheOption) CachedMap[K, V] {
+	o := cacheOptions{maxEntries: DefaultCacheMaxEntries}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return CachedMap[K, V]{
+		Map:   m,
+		cache: newValueCache[V](m.name, o),
+	}
+}
+
+// Get returns the value associated with the provided key,
+// errors with ErrNotFound if the key does not exist, or
+// with ErrEncoding if the key or value decoding fails.
+func (m CachedMap[K, V]) Get(ctx context.Context, key K) (v V, err error) {
+	bytesKey, err := EncodeKeyWithPrefix(m.prefix, m.kc, key)
+	if err != nil {
+		return v, err
+	}
+
+	valueBytes, err := m.sa(ctx).Get(bytesKey)
+	if err != nil {
+		return v, err
+	}
+	if valueBytes == nil {
+		return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, m.kc.Stringify(key), m.vc.ValueType())
+	}
+
+	if v, ok := m.cache.get(bytesKey, valueBytes); ok {
+		return v, nil
+	}
+
+	v, err = m.vc.Decode(valueBytes)
+	if err != nil {
+		return v, fmt.Errorf("%w: value decode: %w", ErrEncoding, err)
+	}
+	m.cache.set(bytesKey, valueBytes, v)
+	return v, nil
+}
+
+// Set maps the provided value to the provided key in the store.
+// Errors with ErrEncoding if key or value encoding fails.
+func (m CachedMap[K, V]) Set(ctx context.Context, key K, value V) error {
+	if err := m.invalidate(key); err != nil {
+		return err
+	}
+	return m.Map.Set(ctx, key, value)
+}
+
+// Remove removes the key from the storage.
+// Errors with ErrEncoding if key encoding fails.
+// If the key does not exist then this is a no-op.
+func (m CachedMap[K, V]) Remove(ctx context.Context, key K) error {
+	if err := m.invalidate(key); err != nil {
+		return err
+	}
+	return m.Map.Remove(ctx, key)
+}
+
+// Clear clears the collection contained within the provided key range
+// and resets the cache.
+func (m CachedMap[K, V]) Clear(ctx context.Context, ranger Ranger[K]) error {
+	m.cache.reset()
+	return m.Map.Clear(ctx, ranger)
+}
+
+// Reset drops every memoized value. Applications can call it at block
+// boundaries to bound the cache to the values read within a block.
+func (m CachedMap[K, V]) Reset() { m.cache.reset() }
+
+// Stats returns the hit and miss counters of the cache.
+func (m CachedMap[K, V]) Stats() CacheStats { return m.cache.stats() }
+
+func (m CachedMap[K, V]) invalidate(key K) error {
+	bytesKey, err := EncodeKeyWithPrefix(m.prefix, m.kc, key)
+	if err != nil {
+		return err
+	}
+	m.cache.delete(bytesKey)
+	return nil
+}
+
+// CachedItem wraps an Item and memoizes its decoded value,
+// with the same guarantees as CachedMap.
+type CachedItem[V any] struct {
+	m CachedMap[noKey, V]
+}
+
+// NewCachedItem wraps the provided Item with a memoization layer for its decoded value.
+func NewCachedItem[V any](item Item[V], opts ...CacheOption) CachedItem[V] {
+	return CachedItem[V]{m: NewCachedMap((Map[noKey, V])(item), opts...)}
+}
+
+// Get gets the item, if it is not set it returns an ErrNotFound error.
+// If value decoding fails then an ErrEncoding is returned.
+func (i CachedItem[V]) Get(ctx context.Context) (V, error) {
+	return i.m.Get(ctx, noKey{})
+}
+
+// Set sets the item in the store. If Value encoding fails then an ErrEncoding is returned.
+func (i CachedItem[V]) Set(ctx context.Context, value V) error {
+	return i.m.Set(ctx, noKey{}, value)
+}
+
+// Has reports whether the item exists in the store or not.
+// Returns an error in case encoding fails.
+func (i CachedItem[V]) Has(ctx context.Context) (bool, error) {
+	return i.m.Has(ctx, noKey{})
+}
+
+// Remove removes the item in the store.
+func (i CachedItem[V]) Remove(ctx context.Context) error {
+	return i.m.Remove(ctx, noKey{})
+}
+
+// Reset drops the memoized value.
+func (i CachedItem[V]) Reset() { i.m.Reset() }
+
+// Stats returns the hit and miss counters of the cache.
+func (i CachedItem[V]) Stats() CacheStats { return i.m.Stats() }
+
+// valueCache memoizes decoded values together with the raw bytes they were decoded from.
+type valueCache[V any] struct {
+	name string
+	opts cacheOptions
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry[V]
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type cacheEntry[V any] struct {
+	raw   []byte
+	value V
+}
+
+func newValueCache[V any](name string, opts cacheOptions) *valueCache[V] {
+	return &valueCache[V]{
+		name:    name,
+		opts:    opts,
+		entries: make(map[string]cacheEntry[V]),
+	}
+}
+
+func (c *valueCache[V]) get(key, raw []byte) (v V, ok bool) {
+	c.mu.RLock()
+	entry, found := c.entries[string(key)]
+	c.mu.RUnlock()
+
+	hit := found && bytes.Equal(entry.raw, raw)
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	if c.opts.observer != nil {
+		c.opts.observer(c.name, hit)
+	}
+	if !hit {
+		return v, false
+	}
+	return entry.value, true
+}
+
+func (c *valueCache[V]) set(key, raw []byte, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.opts.maxEntries > 0 && len(c.entries) >= c.opts.maxEntries {
+		c.entries = make(map[string]cacheEntry[V])
+	}
+	c.entries[string(key)] = cacheEntry[V]{raw: bytes.Clone(raw), value: value}
+}
+
+func (c *valueCache[V]) delete(key []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, string(key))
+}
+
+func (c *valueCache[V]) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]cacheEntry[V])
+}
+
+func (c *valueCache[V]) stats() CacheStats {
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
@@ -0,0 +1,175 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedMap(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewCachedMap(NewMap(schemaBuilder, NewPrefix(0), "m", StringKey, Uint64Value))
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// not found is not cached
+	_, err = m.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, CacheStats{}, m.Stats())
+
+	// first read is a miss, second one a hit
+	require.NoError(t, m.Set(ctx, "a", 1))
+	v, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	v, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 1}, m.Stats())
+
+	// writes invalidate
+	require.NoError(t, m.Set(ctx, "a", 2))
+	v, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 2}, m.Stats())
+
+	require.NoError(t, m.Remove(ctx, "a"))
+	_, err = m.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// writes which bypass the cached map, like a cached context being
+	// written to its parent, are observed.
+	require.NoError(t, m.Set(ctx, "b", 1))
+	_, err = m.Get(ctx, "b")
+	require.NoError(t, err)
+	require.NoError(t, m.Map.Set(ctx, "b", 3))
+	v, err = m.Get(ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), v)
+
+	// clear resets the cache
+	require.NoError(t, m.Clear(ctx, nil))
+	_, err = m.Get(ctx, "b")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCachedMap_Options(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	observed := map[bool]int{}
+	m := NewCachedMap(
+		NewMap(schemaBuilder, NewPrefix(0), "m", StringKey, Uint64Value),
+		WithCacheMaxEntries(1),
+		WithCacheObserver(func(name string, hit bool) {
+			require.Equal(t, "m", name)
+			observed[hit]++
+		}),
+	)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, m.Set(ctx, "a", 1))
+	require.NoError(t, m.Set(ctx, "b", 2))
+
+	_, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	_, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	// evicts "a"
+	_, err = m.Get(ctx, "b")
+	require.NoError(t, err)
+	_, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+
+	require.Equal(t, map[bool]int{true: 1, false: 3}, observed)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 3}, m.Stats())
+
+	m.Reset()
+	_, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 4}, m.Stats())
+}
+
+func TestCachedItem(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	item := NewCachedItem(NewItem(schemaBuilder, NewPrefix("item"), "item", Uint64Value))
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, item.Set(ctx, 1000))
+	for i := 0; i < 3; i++ {
+		v, err := item.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1000), v)
+	}
+	require.Equal(t, CacheStats{Hits: 2, Misses: 1}, item.Stats())
+
+	has, err := item.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, item.Remove(ctx))
+	has, err = item.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = item.Get(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestCachedMap_Determinism runs the same random sequence of operations against
+// a plain Map and a CachedMap, interleaved with writes that bypass the cache,
+// and asserts the results and the final state are identical.
+func TestCachedMap_Determinism(t *testing.T) {
+	plainSk, plainCtx := deps()
+	plainSchema := NewSchemaBuilder(plainSk)
+	plain := NewMap(plainSchema, NewPrefix(0), "m", Uint64Key, StringValue)
+	_, err := plainSchema.Build()
+	require.NoError(t, err)
+
+	cachedSk, cachedCtx := deps()
+	cachedSchema := NewSchemaBuilder(cachedSk)
+	cached := NewCachedMap(NewMap(cachedSchema, NewPrefix(0), "m", Uint64Key, StringValue), WithCacheMaxEntries(8))
+	_, err = cachedSchema.Build()
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		key := uint64(r.Intn(16))
+		value := fmt.Sprintf("v%d", r.Intn(4))
+		switch r.Intn(5) {
+		case 0:
+			require.NoError(t, plain.Set(plainCtx, key, value))
+			require.NoError(t, cached.Set(cachedCtx, key, value))
+		case 1:
+			require.NoError(t, plain.Remove(plainCtx, key))
+			require.NoError(t, cached.Remove(cachedCtx, key))
+		case 2:
+			require.NoError(t, plain.Set(plainCtx, key, value))
+			require.NoError(t, cached.Map.Set(cachedCtx, key, value))
+		default:
+			want, wantErr := plain.Get(plainCtx, key)
+			got, gotErr := cached.Get(cachedCtx, key)
+			require.Equal(t, want, got)
+			require.Equal(t, wantErr, gotErr)
+		}
+	}
+
+	stats := cached.Stats()
+	require.NotZero(t, stats.Hits)
+	require.NotZero(t, stats.Misses)
+
+	wantKVs, err := plain.Iterate(plainCtx, nil)
+	require.NoError(t, err)
+	want, err := wantKVs.KeyValues()
+	require.NoError(t, err)
+	gotKVs, err := cached.Iterate(cachedCtx, nil)
+	require.NoError(t, err)
+	got, err := gotKVs.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,72 @@
+package codec
+
+// NewAltKeyCodec returns a new AltKeyCodec. canonicalKeyCodec is the codec that you want the key
+// to be encoded and decoded as, alternativeDecoder is a function that will attempt to decode the key
+// in case the canonicalKeyCodec fails to decode it. The alternativeDecoder is used both for terminal
+// and non-terminal decoding, and must return the number of bytes it read.
+func NewAltKeyCodec[K any](canonicalKeyCodec KeyCodec[K], alternativeDecoder func([]byte) (int, K, error)) KeyCodec[K] {
+	return AltKeyCodec[K]{
+		canonicalKeyCodec:  canonicalKeyCodec,
+		alternativeDecoder: alternativeDecoder,
+	}
+}
+
+// AltKeyCodec is a codec that can decode a key from state in an alternative format.
+// This is useful while the keys of a collection are being migrated from one encoding
+// to another, for example from a string address to its bytes representation: keys which
+// are not migrated yet can still be iterated and decoded.
+// Keys are always encoded with the canonical key codec.
+// NOTE: a terminal key is decoded as canonical only if the canonical key codec reads all the bytes.
+// If the canonical format can also decode the alternative format, then this codec will produce
+// undefined and undesirable behavior.
+type AltKeyCodec[K any] struct {
+	canonicalKeyCodec  KeyCodec[K]
+	alternativeDecoder func([]byte) (int, K, error)
+}
+
+// Decode will attempt to decode the key from state using the canonical key codec.
+// If it fails to decode, it will attempt to decode the key using the alternative decoder.
+func (a AltKeyCodec[K]) Decode(b []byte) (int, K, error) {
+	n, k, err := a.canonicalKeyCodec.Decode(b)
+	if err != nil || n != len(b) {
+		return a.alternativeDecoder(b)
+	}
+	return n, k, nil
+}
+
+// DecodeNonTerminal will attempt to decode the key from state using the canonical key codec.
+// If it fails to decode, it will attempt to decode the key using the alternative decoder.
+func (a AltKeyCodec[K]) DecodeNonTerminal(b []byte) (int, K, error) {
+	n, k, err := a.canonicalKeyCodec.DecodeNonTerminal(b)
+	if err != nil {
+		return a.alternativeDecoder(b)
+	}
+	return n, k, nil
+}
+
+// CanonicalKeyCodec returns the key codec used to encode keys.
+func (a AltKeyCodec[K]) CanonicalKeyCodec() KeyCodec[K] { return a.canonicalKeyCodec }
+
+// Below there is the implementation of KeyCodec relying on the canonical key codec.
+
+func (a AltKeyCodec[K]) Encode(buffer []byte, key K) (int, error) {
+	return a.canonicalKeyCodec.Encode(buffer, key)
+}
+
+func (a AltKeyCodec[K]) Size(key K) int { return a.canonicalKeyCodec.Size(key) }
+
+func (a AltKeyCodec[K]) EncodeJSON(value K) ([]byte, error) {
+	return a.canonicalKeyCodec.EncodeJSON(value)
+}
+
+func (a AltKeyCodec[K]) DecodeJSON(b []byte) (K, error) { return a.canonicalKeyCodec.DecodeJSON(b) }
+
+func (a AltKeyCodec[K]) Stringify(key K) string { return a.canonicalKeyCodec.Stringify(key) }
+
+func (a AltKeyCodec[K]) KeyType() string { return a.canonicalKeyCodec.KeyType() }
+
+func (a AltKeyCodec[K]) EncodeNonTerminal(buffer []byte, key K) (int, error) {
+	return a.canonicalKeyCodec.EncodeNonTerminal(buffer, key)
+}
+
+func (a AltKeyCodec[K]) SizeNonTerminal(key K) int { return a.canonicalKeyCodec.SizeNonTerminal(key) }
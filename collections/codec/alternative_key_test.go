@@ -0,0 +1,64 @@
+package codec_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestAltKeyCodec(t *testing.T) {
+	// we assume we want to migrate the key from its decimal string
+	// representation to the big endian uint64 encoding.
+	canonical := codec.NewUint64Key[uint64]()
+	legacy := codec.NewStringKeyCodec[string]()
+	alternative := func(b []byte) (int, uint64, error) {
+		n, s, err := legacy.DecodeNonTerminal(b)
+		if err != nil {
+			return 0, 0, err
+		}
+		v, err := strconv.ParseUint(s, 10, 64)
+		return n, v, err
+	}
+
+	cdc := codec.NewAltKeyCodec(canonical, alternative)
+
+	t.Run("decodes alternative key", func(t *testing.T) {
+		buf := make([]byte, legacy.SizeNonTerminal("100"))
+		_, err := legacy.EncodeNonTerminal(buf, "100")
+		require.NoError(t, err)
+
+		n, got, err := cdc.Decode(buf)
+		require.NoError(t, err)
+		require.Equal(t, len(buf), n)
+		require.Equal(t, uint64(100), got)
+
+		// non-terminal decoding only reads the alternative key
+		n, got, err = cdc.DecodeNonTerminal(append(buf, 0xFF))
+		require.NoError(t, err)
+		require.Equal(t, len(buf), n)
+		require.Equal(t, uint64(100), got)
+	})
+
+	t.Run("decodes canonical key", func(t *testing.T) {
+		buf := make([]byte, cdc.Size(100))
+		_, err := cdc.Encode(buf, 100)
+		require.NoError(t, err)
+		n, got, err := cdc.Decode(buf)
+		require.NoError(t, err)
+		require.Equal(t, 8, n)
+		require.Equal(t, uint64(100), got)
+	})
+
+	t.Run("fails when no decoder applies", func(t *testing.T) {
+		_, _, err := cdc.Decode([]byte("abc"))
+		require.Error(t, err)
+	})
+
+	t.Run("conformance", func(t *testing.T) {
+		colltest.TestKeyCodec(t, cdc, uint64(100))
+	})
+}
@@ -1,9 +1,10 @@
 package codec
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewBoolKey[T ~bool]() NameableKeyCodec[T] { return boolKey[T]{} }
@@ -36,13 +37,15 @@ func (b boolKey[T]) Decode(buffer []byte) (int, T, error) {
 func (b boolKey[T]) Size(_ T) int { return 1 }
 
 func (b boolKey[T]) EncodeJSON(value T) ([]byte, error) {
-	return json.Marshal(value)
+	return EncodeKindJSON(schema.BoolKind, (bool)(value))
 }
 
 func (b boolKey[T]) DecodeJSON(buffer []byte) (T, error) {
-	var t T
-	err := json.Unmarshal(buffer, &t)
-	return t, err
+	v, err := DecodeKindJSON(schema.BoolKind, buffer)
+	if err != nil {
+		return false, err
+	}
+	return (T)(v.(bool)), nil
 }
 
 func (b boolKey[T]) Stringify(key T) string {
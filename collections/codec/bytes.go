@@ -1,9 +1,10 @@
 package codec
 
 import (
-	"encoding/json"
 	"fmt"
 	"math"
+
+	"cosmossdk.io/schema"
 )
 
 // MaxBytesKeyNonTerminalSize defines the maximum length of a bytes key encoded
@@ -28,13 +29,15 @@ func (bytesKey[T]) Size(key T) int {
 }
 
 func (bytesKey[T]) EncodeJSON(value T) ([]byte, error) {
-	return json.Marshal(value)
+	return EncodeKindJSON(schema.BytesKind, ([]byte)(value))
 }
 
 func (bytesKey[T]) DecodeJSON(b []byte) (T, error) {
-	var t T
-	err := json.Unmarshal(b, &t)
-	return t, err
+	v, err := DecodeKindJSON(schema.BytesKind, b)
+	if err != nil {
+		return nil, err
+	}
+	return (T)(v.([]byte)), nil
 }
 
 func (b bytesKey[T]) Stringify(key T) string {
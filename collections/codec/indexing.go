@@ -45,21 +45,50 @@ type SchemaCodec[T any] struct {
 
 // KeySchemaCodec gets the schema codec for the provided KeyCodec either
 // by casting to HasSchemaCodec or returning a fallback codec.
+// Keys which do not map to a simple schema kind are indexed using the
+// codec's own JSON encoding.
 func KeySchemaCodec[K any](cdc KeyCodec[K]) (SchemaCodec[K], error) {
 	if indexable, ok := cdc.(HasSchemaCodec[K]); ok {
 		return indexable.SchemaCodec()
 	} else {
-		return FallbackSchemaCodec[K](), nil
+		return codecJSONSchemaCodec(cdc.EncodeJSON, cdc.DecodeJSON), nil
 	}
 }
 
 // ValueSchemaCodec gets the schema codec for the provided ValueCodec either
 // by casting to HasSchemaCodec or returning a fallback codec.
+// Values which do not map to a simple schema kind are indexed using the
+// codec's own JSON encoding, so that indexers and genesis files agree.
 func ValueSchemaCodec[V any](cdc ValueCodec[V]) (SchemaCodec[V], error) {
 	if indexable, ok := cdc.(HasSchemaCodec[V]); ok {
 		return indexable.SchemaCodec()
 	} else {
-		return FallbackSchemaCodec[V](), nil
+		return codecJSONSchemaCodec(cdc.EncodeJSON, cdc.DecodeJSON), nil
+	}
+}
+
+// codecJSONSchemaCodec behaves like FallbackSchemaCodec, except that types which
+// do not map to a simple schema kind are encoded to a JSON string using the
+// provided JSON encoder and decoder.
+func codecJSONSchemaCodec[T any](encodeJSON func(T) ([]byte, error), decodeJSON func([]byte) (T, error)) SchemaCodec[T] {
+	var t T
+	if err := schema.KindForGoValue(t).Validate(); err == nil {
+		return FallbackSchemaCodec[T]()
+	}
+	return SchemaCodec[T]{
+		Fields: []schema.Field{{Kind: schema.StringKind}},
+		ToSchemaType: func(t T) (any, error) {
+			bz, err := encodeJSON(t)
+			return string(bz), err
+		},
+		FromSchemaType: func(a any) (T, error) {
+			var t T
+			sz, ok := a.(string)
+			if !ok {
+				return t, fmt.Errorf("expected string, got %T", a)
+			}
+			return decodeJSON([]byte(sz))
+		},
 	}
 }
 
@@ -2,9 +2,10 @@ package codec
 
 import (
 	"encoding/binary"
-	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewInt64Key[T ~int64]() NameableKeyCodec[T] { return int64Key[T]{} }
@@ -30,20 +31,15 @@ func (i int64Key[T]) Decode(buffer []byte) (int, T, error) {
 func (i int64Key[T]) Size(_ T) int { return 8 }
 
 func (i int64Key[T]) EncodeJSON(value T) ([]byte, error) {
-	return []byte(`"` + strconv.FormatInt((int64)(value), 10) + `"`), nil
+	return EncodeKindJSON(schema.Int64Kind, (int64)(value))
 }
 
 func (i int64Key[T]) DecodeJSON(b []byte) (T, error) {
-	var s string
-	err := json.Unmarshal(b, &s)
-	if err != nil {
-		return 0, err
-	}
-	k, err := strconv.ParseInt(s, 10, 64)
+	k, err := DecodeKindJSON(schema.Int64Kind, b)
 	if err != nil {
 		return 0, err
 	}
-	return (T)(k), nil
+	return (T)(k.(int64)), nil
 }
 
 func (i int64Key[T]) Stringify(key T) string { return strconv.FormatInt((int64)(key), 10) }
@@ -92,20 +88,15 @@ func (i int32Key[T]) Decode(buffer []byte) (int, T, error) {
 func (i int32Key[T]) Size(_ T) int { return 4 }
 
 func (i int32Key[T]) EncodeJSON(value T) ([]byte, error) {
-	return []byte(`"` + strconv.FormatInt((int64)(value), 10) + `"`), nil
+	return EncodeKindJSON(schema.Int32Kind, (int32)(value))
 }
 
 func (i int32Key[T]) DecodeJSON(b []byte) (T, error) {
-	var s string
-	err := json.Unmarshal(b, &s)
-	if err != nil {
-		return 0, err
-	}
-	k, err := strconv.ParseInt(s, 10, 32)
+	k, err := DecodeKindJSON(schema.Int32Kind, b)
 	if err != nil {
 		return 0, err
 	}
-	return (T)(k), nil
+	return (T)(k.(int32)), nil
 }
 
 func (i int32Key[T]) Stringify(key T) string { return strconv.FormatInt((int64)(key), 10) }
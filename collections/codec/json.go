@@ -0,0 +1,206 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// EncodeKindJSON encodes the provided value following the JSON encoding
+// specified by the schema.Kind. The value must be of the Go type the kind
+// expects, see schema.Kind for details.
+// Primitive codecs use EncodeKindJSON so that the JSON emitted in genesis
+// files and by indexers is the same for a given kind.
+func EncodeKindJSON(kind schema.Kind, value any) ([]byte, error) {
+	if err := kind.ValidateValueType(value); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncoding, err)
+	}
+	switch kind {
+	case schema.StringKind, schema.EnumKind, schema.BoolKind:
+		return json.Marshal(value)
+	case schema.BytesKind:
+		return json.Marshal(base64.StdEncoding.EncodeToString(value.([]byte)))
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind,
+		schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind:
+		return json.Marshal(value)
+	case schema.Int64Kind:
+		return []byte(strconv.Quote(strconv.FormatInt(value.(int64), 10))), nil
+	case schema.Uint64Kind:
+		return []byte(strconv.Quote(strconv.FormatUint(value.(uint64), 10))), nil
+	case schema.IntegerKind, schema.DecimalKind:
+		return []byte(strconv.Quote(value.(string))), nil
+	case schema.Float32Kind:
+		return encodeFloatJSON(float64(value.(float32)), 32)
+	case schema.Float64Kind:
+		return encodeFloatJSON(value.(float64), 64)
+	case schema.TimeKind:
+		return json.Marshal(value.(time.Time).UTC().Format(time.RFC3339Nano))
+	case schema.DurationKind:
+		return json.Marshal(encodeDuration(value.(time.Duration)))
+	case schema.JSONKind:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, value.(json.RawMessage)); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrEncoding, err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported JSON kind %s", ErrEncoding, kind)
+	}
+}
+
+// DecodeKindJSON decodes the JSON encoding of a value of the provided schema.Kind
+// and returns it as the Go type the kind expects.
+// 64-bit integers are accepted both as strings and numbers, and smaller
+// integers are accepted both as numbers and strings, to remain compatible with
+// JSON produced by previous versions of the codecs.
+func DecodeKindJSON(kind schema.Kind, b []byte) (any, error) {
+	switch kind {
+	case schema.StringKind, schema.EnumKind:
+		var s string
+		err := json.Unmarshal(b, &s)
+		return s, err
+	case schema.BoolKind:
+		var v bool
+		err := json.Unmarshal(b, &v)
+		return v, err
+	case schema.BytesKind:
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, err
+		}
+		return decodeBase64(s)
+	case schema.Int8Kind:
+		i, err := decodeIntJSON(b, 8)
+		return int8(i), err
+	case schema.Int16Kind:
+		i, err := decodeIntJSON(b, 16)
+		return int16(i), err
+	case schema.Int32Kind:
+		i, err := decodeIntJSON(b, 32)
+		return int32(i), err
+	case schema.Int64Kind:
+		return decodeIntJSON(b, 64)
+	case schema.Uint8Kind:
+		u, err := decodeUintJSON(b, 8)
+		return uint8(u), err
+	case schema.Uint16Kind:
+		u, err := decodeUintJSON(b, 16)
+		return uint16(u), err
+	case schema.Uint32Kind:
+		u, err := decodeUintJSON(b, 32)
+		return uint32(u), err
+	case schema.Uint64Kind:
+		return decodeUintJSON(b, 64)
+	case schema.IntegerKind, schema.DecimalKind:
+		s, err := numberString(b)
+		if err != nil {
+			return nil, err
+		}
+		return s, kind.ValidateValue(s)
+	case schema.Float32Kind:
+		var f float32
+		err := json.Unmarshal(b, &f)
+		return f, err
+	case schema.Float64Kind:
+		var f float64
+		err := json.Unmarshal(b, &f)
+		return f, err
+	case schema.TimeKind:
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case schema.DurationKind:
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, err
+		}
+		return decodeDuration(s)
+	case schema.JSONKind:
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("%w: invalid JSON", ErrEncoding)
+		}
+		return json.RawMessage(b), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported JSON kind %s", ErrEncoding, kind)
+	}
+}
+
+// numberString returns the textual representation of a JSON number or string.
+func numberString(b []byte) (string, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		err := json.Unmarshal(b, &s)
+		return s, err
+	}
+	var n json.Number
+	err := json.Unmarshal(b, &n)
+	return n.String(), err
+}
+
+func decodeIntJSON(b []byte, bitSize int) (int64, error) {
+	s, err := numberString(b)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, bitSize)
+}
+
+func decodeUintJSON(b []byte, bitSize int) (uint64, error) {
+	s, err := numberString(b)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(s, 10, bitSize)
+}
+
+func encodeFloatJSON(f float64, bitSize int) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("%w: unsupported float value %v", ErrEncoding, f)
+	}
+	return []byte(strconv.FormatFloat(f, 'g', -1, bitSize)), nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	for _, enc := range []*base64.Encoding{
+		base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding,
+	} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: invalid base64 string", ErrEncoding)
+}
+
+// encodeDuration renders a duration as a decimal number of seconds with no
+// trailing zeros followed by the 's' suffix, e.g. "1.5s".
+func encodeDuration(d time.Duration) string {
+	sign := ""
+	u := uint64(d)
+	if d < 0 {
+		sign = "-"
+		u = -u
+	}
+	secs, nanos := u/uint64(time.Second), u%uint64(time.Second)
+	if nanos == 0 {
+		return sign + strconv.FormatUint(secs, 10) + "s"
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	return sign + strconv.FormatUint(secs, 10) + "." + frac + "s"
+}
+
+func decodeDuration(s string) (time.Duration, error) {
+	if !strings.HasSuffix(s, "s") {
+		return 0, fmt.Errorf("%w: invalid duration %q", ErrEncoding, s)
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,72 @@
+package codec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+)
+
+func TestKindJSON(t *testing.T) {
+	cases := []struct {
+		kind  schema.Kind
+		value any
+		json  string
+	}{
+		{schema.StringKind, "hello", `"hello"`},
+		{schema.BytesKind, []byte{0x1, 0x2}, `"AQI="`},
+		{schema.BoolKind, true, `true`},
+		{schema.Int8Kind, int8(-8), `-8`},
+		{schema.Uint8Kind, uint8(8), `8`},
+		{schema.Int16Kind, int16(-16), `-16`},
+		{schema.Uint16Kind, uint16(16), `16`},
+		{schema.Int32Kind, int32(-32), `-32`},
+		{schema.Uint32Kind, uint32(32), `32`},
+		{schema.Int64Kind, int64(-64), `"-64"`},
+		{schema.Uint64Kind, uint64(64), `"64"`},
+		{schema.IntegerKind, "-123456789012345678901234567890", `"-123456789012345678901234567890"`},
+		{schema.DecimalKind, "1.5", `"1.5"`},
+		{schema.Float64Kind, 1.25, `1.25`},
+		{schema.TimeKind, time.Unix(1, 500).UTC(), `"1970-01-01T00:00:01.0000005Z"`},
+		{schema.DurationKind, 1500 * time.Millisecond, `"1.5s"`},
+		{schema.DurationKind, -2 * time.Second, `"-2s"`},
+		{schema.JSONKind, json.RawMessage(`{ "a": 1 }`), `{"a":1}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.kind.String(), func(t *testing.T) {
+			bz, err := EncodeKindJSON(tc.kind, tc.value)
+			require.NoError(t, err)
+			require.Equal(t, tc.json, string(bz))
+
+			decoded, err := DecodeKindJSON(tc.kind, bz)
+			require.NoError(t, err)
+			if tc.kind == schema.JSONKind {
+				require.JSONEq(t, string(tc.value.(json.RawMessage)), string(decoded.(json.RawMessage)))
+				return
+			}
+			require.Equal(t, tc.value, decoded)
+		})
+	}
+
+	t.Run("wrong go type", func(t *testing.T) {
+		_, err := EncodeKindJSON(schema.Uint32Kind, uint64(1))
+		require.ErrorIs(t, err, ErrEncoding)
+	})
+
+	t.Run("legacy quoted integers", func(t *testing.T) {
+		v, err := DecodeKindJSON(schema.Uint32Kind, []byte(`"32"`))
+		require.NoError(t, err)
+		require.Equal(t, uint32(32), v)
+
+		v, err = DecodeKindJSON(schema.Int64Kind, []byte(`-64`))
+		require.NoError(t, err)
+		require.Equal(t, int64(-64), v)
+
+		_, err = DecodeKindJSON(schema.Uint16Kind, []byte(`70000`))
+		require.Error(t, err)
+	})
+}
@@ -2,8 +2,9 @@ package codec
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
+
+	"cosmossdk.io/schema"
 )
 
 func NewStringKeyCodec[T ~string]() NameableKeyCodec[T] { return stringKey[T]{} }
@@ -24,13 +25,15 @@ func (stringKey[T]) Decode(buffer []byte) (int, T, error) {
 }
 
 func (stringKey[T]) EncodeJSON(value T) ([]byte, error) {
-	return json.Marshal(value)
+	return EncodeKindJSON(schema.StringKind, (string)(value))
 }
 
 func (stringKey[T]) DecodeJSON(b []byte) (T, error) {
-	var value T
-	err := json.Unmarshal(b, &value)
-	return value, err
+	v, err := DecodeKindJSON(schema.StringKind, b)
+	if err != nil {
+		return "", err
+	}
+	return (T)(v.(string)), nil
 }
 
 func (stringKey[T]) Size(key T) int {
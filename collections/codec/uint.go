@@ -2,9 +2,10 @@ package codec
 
 import (
 	"encoding/binary"
-	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"cosmossdk.io/schema"
 )
 
 func NewUint64Key[T ~uint64]() NameableKeyCodec[T] { return uint64Key[T]{} }
@@ -77,14 +78,16 @@ func (uint32Key[T]) Decode(buffer []byte) (int, T, error) {
 
 func (uint32Key[T]) Size(_ T) int { return 4 }
 
-func (uint32Key[T]) EncodeJSON(value T) ([]byte, error) { return uintEncodeJSON((uint64)(value)) }
+func (uint32Key[T]) EncodeJSON(value T) ([]byte, error) {
+	return EncodeKindJSON(schema.Uint32Kind, (uint32)(value))
+}
 
 func (uint32Key[T]) DecodeJSON(b []byte) (T, error) {
-	u, err := uintDecodeJSON(b, 32)
+	u, err := DecodeKindJSON(schema.Uint32Kind, b)
 	if err != nil {
 		return 0, err
 	}
-	return (T)(u), nil
+	return (T)(u.(uint32)), nil
 }
 
 func (uint32Key[T]) Stringify(key T) string { return strconv.FormatUint(uint64(key), 10) }
@@ -121,14 +124,16 @@ func (uint16Key[T]) Decode(buffer []byte) (int, T, error) {
 
 func (uint16Key[T]) Size(key T) int { return 2 }
 
-func (uint16Key[T]) EncodeJSON(value T) ([]byte, error) { return uintEncodeJSON((uint64)(value)) }
+func (uint16Key[T]) EncodeJSON(value T) ([]byte, error) {
+	return EncodeKindJSON(schema.Uint16Kind, (uint16)(value))
+}
 
 func (uint16Key[T]) DecodeJSON(b []byte) (T, error) {
-	u, err := uintDecodeJSON(b, 16)
+	u, err := DecodeKindJSON(schema.Uint16Kind, b)
 	if err != nil {
 		return 0, err
 	}
-	return (T)(u), nil
+	return (T)(u.(uint16)), nil
 }
 
 func (uint16Key[T]) Stringify(key T) string { return strconv.FormatUint((uint64)(key), 10) }
@@ -148,15 +153,9 @@ func (u uint16Key[T]) WithName(name string) KeyCodec[T] {
 }
 
 func uintEncodeJSON(value uint64) ([]byte, error) {
-	str := `"` + strconv.FormatUint(value, 10) + `"`
-	return []byte(str), nil
+	return EncodeKindJSON(schema.Uint64Kind, value)
 }
 
 func uintDecodeJSON(b []byte, bitSize int) (uint64, error) {
-	var str string
-	err := json.Unmarshal(b, &str)
-	if err != nil {
-		return 0, err
-	}
-	return strconv.ParseUint(str, 10, bitSize)
+	return decodeUintJSON(b, bitSize)
 }
@@ -0,0 +1,164 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// KeyMigration moves the entries of a Map stored with a former key encoding to a Map
+// using the new key encoding. The former entries can live under a different prefix, or
+// under the prefix of the new Map when keys are re-encoded in place.
+//
+// The migration runs in batches and records its progress in the store, so large maps
+// can be migrated across several blocks by calling Migrate, for example from a
+// PreBlocker, until it reports completion. During the transition period the new Map
+// can use a codec.AltKeyCodec to decode the keys which are not migrated yet.
+//
+// When migrating in place, keys which can be decoded by the key codec of the new Map,
+// or by its canonical key codec if it is a codec.AltKeyCodec, are considered migrated and are skipped, hence the new key codec must not be able to
+// decode the keys of the former encoding. This is the case when adding a component to
+// a Pair, but not when moving from StringKey to BytesKey, which should use a new prefix.
+type KeyMigration[OldK, NewK, V any] struct {
+	oldPrefix []byte
+	oldKc     codec.KeyCodec[OldK]
+	to        Map[NewK, V]
+	// newKc is the canonical key codec of the new Map.
+	newKc   codec.KeyCodec[NewK]
+	convert func(OldK, V) (NewK, error)
+	// cursor holds the last migrated store key, it is empty once the migration is completed.
+	cursor Item[[]byte]
+}
+
+// NewKeyMigration instantiates a new KeyMigration moving the entries stored under oldPrefix
+// with oldKeyCodec to the to Map. The convert function returns the new key of an entry
+// given its former key and its value. The progress of the migration is stored under
+// cursorPrefix and registered in the schema with cursorName.
+func NewKeyMigration[OldK, NewK, V any](
+	schema *SchemaBuilder,
+	cursorPrefix Prefix,
+	cursorName string,
+	oldPrefix Prefix,
+	oldKeyCodec codec.KeyCodec[OldK],
+	to Map[NewK, V],
+	convert func(OldK, V) (NewK, error),
+) KeyMigration[OldK, NewK, V] {
+	newKc := to.kc
+	if alt, ok := newKc.(codec.AltKeyCodec[NewK]); ok {
+		newKc = alt.CanonicalKeyCodec()
+	}
+	return KeyMigration[OldK, NewK, V]{
+		oldPrefix: oldPrefix.Bytes(),
+		oldKc:     oldKeyCodec,
+		to:        to,
+		newKc:     newKc,
+		convert:   convert,
+		cursor:    NewItem(schema, cursorPrefix, cursorName, BytesValue),
+	}
+}
+
+// Done reports whether the migration is completed.
+func (m KeyMigration[OldK, NewK, V]) Done(ctx context.Context) (bool, error) {
+	cursor, err := m.cursor.Get(ctx)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return len(cursor) == 0, nil
+}
+
+// Migrate migrates at most limit entries, resuming from where the previous call stopped,
+// and reports whether the migration is completed. A non-positive limit migrates all the
+// remaining entries. Entries skipped because they are already migrated count towards the limit.
+// Errors with ErrEncoding if a former key can not be decoded, or with ErrConflict if two
+// entries are migrated to the same key.
+func (m KeyMigration[OldK, NewK, V]) Migrate(ctx context.Context, limit int) (done bool, err error) {
+	start := m.oldPrefix
+	cursor, err := m.cursor.Get(ctx)
+	switch {
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return false, err
+	case len(cursor) == 0:
+		return true, nil
+	default:
+		start = nextBytesKey(bytes.Clone(cursor))
+	}
+
+	type entry struct{ key, value []byte }
+	kvStore := m.to.sa(ctx)
+	iter, err := kvStore.Iterator(start, nextBytesPrefixKey(m.oldPrefix))
+	if err != nil {
+		return false, err
+	}
+	// collect the batch first, so the store is not written while iterating.
+	var entries []entry
+	for ; iter.Valid() && (limit <= 0 || len(entries) < limit); iter.Next() {
+		entries = append(entries, entry{key: bytes.Clone(iter.Key()), value: bytes.Clone(iter.Value())})
+	}
+	done = !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return false, err
+	}
+
+	inPlace := bytes.Equal(m.oldPrefix, m.to.prefix)
+	for _, e := range entries {
+		rawKey := e.key[len(m.oldPrefix):]
+		if inPlace {
+			if n, _, err := m.newKc.Decode(rawKey); err == nil && n == len(rawKey) {
+				continue
+			}
+		}
+		if err := m.migrate(ctx, e.key, rawKey, e.value); err != nil {
+			return false, err
+		}
+	}
+
+	if done {
+		return true, m.cursor.Set(ctx, []byte{})
+	}
+	return false, m.cursor.Set(ctx, entries[len(entries)-1].key)
+}
+
+func (m KeyMigration[OldK, NewK, V]) migrate(ctx context.Context, storeKey, rawKey, valueBytes []byte) error {
+	n, oldKey, err := m.oldKc.Decode(rawKey)
+	if err != nil {
+		return fmt.Errorf("%w: key '%X' decode: %w", ErrEncoding, storeKey, err)
+	}
+	if n != len(rawKey) {
+		return fmt.Errorf("%w: key '%X' decode: read %d bytes out of %d", ErrEncoding, storeKey, n, len(rawKey))
+	}
+	value, err := m.to.vc.Decode(valueBytes)
+	if err != nil {
+		return fmt.Errorf("%w: key '%s' value decode: %w", ErrEncoding, m.oldKc.Stringify(oldKey), err)
+	}
+	newKey, err := m.convert(oldKey, value)
+	if err != nil {
+		return err
+	}
+	newStoreKey, err := EncodeKeyWithPrefix(m.to.prefix, m.to.kc, newKey)
+	if err != nil {
+		return err
+	}
+
+	kvStore := m.to.sa(ctx)
+	if !bytes.Equal(newStoreKey, storeKey) {
+		has, err := kvStore.Has(newStoreKey)
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("%w: key '%s' migrates to existing key '%s'", ErrConflict, m.oldKc.Stringify(oldKey), m.to.kc.Stringify(newKey))
+		}
+	}
+	if err := kvStore.Delete(storeKey); err != nil {
+		return err
+	}
+	// the value bytes are kept as they are, only the key encoding changes.
+	return kvStore.Set(newStoreKey, valueBytes)
+}
@@ -0,0 +1,147 @@
+package collections
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+)
+
+func TestKeyMigration(t *testing.T) {
+	sk, ctx := deps()
+
+	// the former map keyed ids by their decimal string representation.
+	legacySchema := NewSchemaBuilder(sk)
+	legacy := NewMap(legacySchema, NewPrefix(0), "legacy", StringKey, Uint64Value)
+	_, err := legacySchema.Build()
+	require.NoError(t, err)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, legacy.Set(ctx, strconv.FormatUint(i, 10), i*10))
+	}
+
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "m", Uint64Key, Uint64Value)
+	migration := NewKeyMigration(schemaBuilder, NewPrefix(2), "m_migration", NewPrefix(0), StringKey, m,
+		func(k string, _ uint64) (uint64, error) { return strconv.ParseUint(k, 10, 64) },
+	)
+	_, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	done, err := migration.Done(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+
+	// migrate in batches of 3 entries, resuming from the previous batch.
+	for i := 0; i < 3; i++ {
+		done, err = migration.Migrate(ctx, 3)
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+	done, err = migration.Migrate(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	done, err = migration.Done(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	for i := uint64(0); i < 10; i++ {
+		v, err := m.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i*10, v)
+	}
+	iter, err := legacy.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// completed migrations are a no-op.
+	require.NoError(t, legacy.Set(ctx, "100", 1))
+	done, err = migration.Migrate(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, done)
+	has, err := m.Has(ctx, 100)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestKeyMigration_InPlace(t *testing.T) {
+	sk, ctx := deps()
+
+	// the former map was keyed by name only, the new one by name and id,
+	// where the id is the value.
+	legacySchema := NewSchemaBuilder(sk)
+	legacy := NewMap(legacySchema, NewPrefix(0), "m", StringKey, Uint64Value)
+	_, err := legacySchema.Build()
+	require.NoError(t, err)
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, legacy.Set(ctx, fmt.Sprintf("name-%d", i), i))
+	}
+
+	pairCodec := PairKeyCodec(StringKey, Uint64Key)
+	// while migrating, the keys which are not migrated yet are decoded with a zero id.
+	kc := codec.NewAltKeyCodec(pairCodec, func(b []byte) (int, Pair[string, uint64], error) {
+		n, name, err := StringKey.Decode(b)
+		return n, Join(name, uint64(0)), err
+	})
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(0), "m", kc, Uint64Value)
+	migration := NewKeyMigration(schemaBuilder, NewPrefix(1), "m_migration", NewPrefix(0), StringKey, m,
+		func(name string, id uint64) (Pair[string, uint64], error) { return Join(name, id), nil },
+	)
+	_, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	done, err := migration.Migrate(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, done)
+
+	// during the transition both encodings are decoded.
+	iter, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err := iter.KeyValues()
+	require.NoError(t, err)
+	require.Len(t, kvs, 5)
+
+	for !done {
+		done, err = migration.Migrate(ctx, 2)
+		require.NoError(t, err)
+	}
+
+	iter, err = m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err = iter.KeyValues()
+	require.NoError(t, err)
+	want := make([]KeyValue[Pair[string, uint64], uint64], 5)
+	for i := range want {
+		want[i] = KeyValue[Pair[string, uint64], uint64]{Key: Join(fmt.Sprintf("name-%d", i), uint64(i)), Value: uint64(i)}
+	}
+	require.Equal(t, want, kvs)
+}
+
+func TestKeyMigration_Errors(t *testing.T) {
+	sk, ctx := deps()
+
+	legacySchema := NewSchemaBuilder(sk)
+	legacy := NewMap(legacySchema, NewPrefix(0), "legacy", StringKey, Uint64Value)
+	_, err := legacySchema.Build()
+	require.NoError(t, err)
+	require.NoError(t, legacy.Set(ctx, "1", 1))
+	require.NoError(t, legacy.Set(ctx, "01", 1))
+
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "m", Uint64Key, Uint64Value)
+	migration := NewKeyMigration(schemaBuilder, NewPrefix(2), "m_migration", NewPrefix(0), StringKey, m,
+		func(k string, _ uint64) (uint64, error) { return strconv.ParseUint(k, 10, 64) },
+	)
+	_, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// "01" and "1" are migrated to the same key.
+	_, err = migration.Migrate(ctx, 0)
+	require.ErrorIs(t, err, ErrConflict)
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 	gogotypes "github.com/cosmos/gogoproto/types"
-	"google.golang.org/protobuf/encoding/protojson"
 	protov2 "google.golang.org/protobuf/proto"
 
 	"cosmossdk.io/collections"
@@ -74,6 +73,8 @@ func (c collValue[T, PT]) Decode(b []byte) (value T, err error) {
 	return value, err
 }
 
+// EncodeJSON encodes the value with the JSON encoding of the codec, gogoproto
+// values keeping their gogoproto JSON encoding, e.g. of custom types.
 func (c collValue[T, PT]) EncodeJSON(value T) ([]byte, error) {
 	return c.cdc.MarshalJSON(PT(&value))
 }
@@ -119,12 +120,12 @@ func (c collValue2[T, PT]) Decode(b []byte) (PT, error) {
 }
 
 func (c collValue2[T, PT]) EncodeJSON(value PT) ([]byte, error) {
-	return protojson.Marshal(value)
+	return MarshalJSON(value)
 }
 
 func (c collValue2[T, PT]) DecodeJSON(b []byte) (PT, error) {
 	var value T
-	err := protojson.Unmarshal(b, PT(&value))
+	err := UnmarshalJSON(b, PT(&value))
 	return &value, err
 }
 
@@ -0,0 +1,90 @@
+package protocodec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// MarshalJSON encodes a protobuf message to JSON using its protoreflect
+// representation. Field names are the original protobuf field names,
+// unpopulated fields are emitted, google.protobuf.Any values are resolved
+// against both the protoregistry and the gogoproto registries, and the output
+// is compacted. This makes the encoding byte-identical across binaries and
+// independent of both gogoproto jsonpb and amino JSON.
+func MarshalJSON(msg protov2.Message) ([]byte, error) {
+	bz, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+		Resolver:        typeResolver{},
+	}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// protojson purposefully randomizes its whitespace, compact it.
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, bz); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes the JSON produced by MarshalJSON into msg.
+func UnmarshalJSON(bz []byte, msg protov2.Message) error {
+	return protojson.UnmarshalOptions{
+		Resolver: typeResolver{},
+	}.Unmarshal(bz, msg)
+}
+
+// messageDescriptor returns the descriptor of the message with the given name
+// looking it up in both the protoregistry and gogoproto registries.
+func messageDescriptor(name string) (protoreflect.MessageDescriptor, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("could not find descriptor for %s: %w", name, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message descriptor, got %T", name, desc)
+	}
+	return md, nil
+}
+
+// typeResolver resolves message types from protoregistry.GlobalTypes and
+// falls back to dynamic messages for types only known to gogoproto.
+type typeResolver struct{}
+
+func (typeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(name); err == nil {
+		return mt, nil
+	}
+	md, err := messageDescriptor(string(name))
+	if err != nil {
+		return nil, protoregistry.NotFound
+	}
+	return dynamicpb.NewMessageType(md), nil
+}
+
+func (r typeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (typeResolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (typeResolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
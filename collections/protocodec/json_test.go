@@ -0,0 +1,32 @@
+package protocodec_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/typepb"
+
+	codec "cosmossdk.io/collections/protocodec"
+)
+
+func TestJSON_V2(t *testing.T) {
+	value := &apipb.Api{
+		Name:    "cosmos.bank.v1beta1.Msg",
+		Methods: []*apipb.Method{{Name: "Send", RequestTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+		Version: "1.0",
+		Syntax:  typepb.Syntax_SYNTAX_PROTO3,
+	}
+	valueCodec := codec.CollValueV2[apipb.Api]()
+
+	bz, err := valueCodec.EncodeJSON(value)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"request_type_url":"/cosmos.bank.v1beta1.MsgSend"`)
+	require.NotContains(t, string(bz), " ")
+
+	decoded, err := valueCodec.DecodeJSON(bz)
+	require.NoError(t, err)
+	require.True(t, cmp.Equal(value, decoded, protocmp.Transform()))
+}
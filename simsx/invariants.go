@@ -0,0 +1,58 @@
+package simsx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/invariants"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// HasStateInvariants is implemented by modules declaring relational invariants over their collections.
+type HasStateInvariants interface {
+	StateInvariants() (*invariants.Checker, error)
+}
+
+// CheckStateInvariants checks the state invariants declared by the simulation modules
+// against the provided context and returns the violations found.
+func CheckStateInvariants(ctx context.Context, sm *module.SimulationManager) ([]invariants.Violation, error) {
+	var violations []invariants.Violation
+	for _, m := range sm.Modules {
+		hasInvariants, ok := m.(HasStateInvariants)
+		if !ok {
+			continue
+		}
+		checker, err := hasInvariants.StateInvariants()
+		if err != nil {
+			return nil, err
+		}
+		found, err := checker.Check(ctx)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, found...)
+	}
+	return violations, nil
+}
+
+// CheckStateInvariantsAtHeight checks the state invariants declared by the simulation modules
+// of the app against the committed state at the provided height.
+func CheckStateInvariantsAtHeight(app SimulationApp, height int64) ([]invariants.Violation, error) {
+	ctx, err := app.GetBaseApp().CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	return CheckStateInvariants(ctx, app.SimulationManager())
+}
+
+// AssertStateInvariants is a post run action which fails the test when the state
+// invariants declared by the simulation modules are broken at the last committed height.
+func AssertStateInvariants[T SimulationApp](tb testing.TB, app TestInstance[T], _ []simtypes.Account) {
+	tb.Helper()
+	violations, err := CheckStateInvariantsAtHeight(app.App, app.App.GetBaseApp().LastBlockHeight())
+	require.NoError(tb, err)
+	require.Empty(tb, violations, "broken state invariants:\n%s", invariants.FormatViolations(violations))
+}
@@ -0,0 +1,138 @@
+// Package invariants provides a framework to declare relational invariants
+// over the collections of a module and to check them against a given state.
+//
+// Invariants are declared against the typed collections of a module and
+// validated against its collections.Schema: foreign keys between maps,
+// consistency between an IndexedMap and its indexes, and sum constraints
+// between an Item and the values of a Map. A Checker runs them and reports
+// every violating key, which makes it usable both at a given height and from
+// the simulation framework (see simsx.AssertStateInvariants).
+package invariants
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Violation describes a key of a collection which breaks an invariant.
+type Violation struct {
+	// Module is the name of the module owning the invariant.
+	Module string
+	// Invariant is the name of the broken invariant.
+	Invariant string
+	// Collection is the name of the collection holding the violating key.
+	Collection string
+	// Key is the human-readable representation of the violating key,
+	// it is empty for collections without keys such as an Item.
+	Key string
+	// Reason describes why the key violates the invariant.
+	Reason string
+}
+
+// String implements fmt.Stringer.
+func (v Violation) String() string {
+	if v.Key == "" {
+		return fmt.Sprintf("%s/%s: %s: %s", v.Module, v.Invariant, v.Collection, v.Reason)
+	}
+	return fmt.Sprintf("%s/%s: %s[%s]: %s", v.Module, v.Invariant, v.Collection, v.Key, v.Reason)
+}
+
+// Invariant is a relational invariant over the collections of a module.
+type Invariant interface {
+	// Name is the unique name of the invariant within a module.
+	Name() string
+	// Collections returns the names of the collections the invariant reads.
+	Collections() []string
+	// Check verifies the invariant and returns the violating keys.
+	// The returned error is reserved for failures reading the state.
+	Check(ctx context.Context) ([]Violation, error)
+}
+
+// Table is a collection which can be read and walked by key,
+// it is implemented by collections.Map and collections.IndexedMap.
+type Table[K, V any] interface {
+	Has(ctx context.Context, key K) (bool, error)
+	Get(ctx context.Context, key K) (V, error)
+	Walk(ctx context.Context, ranger collections.Ranger[K], walkFunc func(key K, value V) (stop bool, err error)) error
+	KeyCodec() codec.KeyCodec[K]
+}
+
+// Ref binds a Table to its name in the module schema.
+type Ref[K, V any] struct {
+	Name  string
+	Table Table[K, V]
+}
+
+// Of returns a reference to the table registered in the schema under the provided name.
+func Of[K, V any](name string, table Table[K, V]) Ref[K, V] {
+	return Ref[K, V]{Name: name, Table: table}
+}
+
+// Checker checks the invariants declared for a module.
+type Checker struct {
+	module     string
+	invariants []Invariant
+}
+
+// NewChecker returns a Checker for the invariants of the provided module.
+// It errors if two invariants share a name or if an invariant reads a
+// collection which is not part of the module schema.
+func NewChecker(module string, schema collections.Schema, invariants ...Invariant) (*Checker, error) {
+	known := make(map[string]struct{})
+	for _, coll := range schema.ListCollections() {
+		known[coll.GetName()] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(invariants))
+	for _, inv := range invariants {
+		if _, ok := seen[inv.Name()]; ok {
+			return nil, fmt.Errorf("duplicate invariant %s in module %s", inv.Name(), module)
+		}
+		seen[inv.Name()] = struct{}{}
+
+		for _, name := range inv.Collections() {
+			if _, ok := known[name]; !ok {
+				return nil, fmt.Errorf("invariant %s reads collection %s which is not part of the %s schema", inv.Name(), name, module)
+			}
+		}
+	}
+
+	return &Checker{module: module, invariants: invariants}, nil
+}
+
+// Module returns the name of the module the checker belongs to.
+func (c *Checker) Module() string { return c.module }
+
+// Invariants returns the invariants checked by the checker.
+func (c *Checker) Invariants() []Invariant { return c.invariants }
+
+// Check runs every invariant and returns all the violations found.
+func (c *Checker) Check(ctx context.Context) ([]Violation, error) {
+	var violations []Violation
+	for _, inv := range c.invariants {
+		found, err := inv.Check(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", c.module, inv.Name(), err)
+		}
+		for _, v := range found {
+			v.Module = c.module
+			v.Invariant = inv.Name()
+			violations = append(violations, v)
+		}
+	}
+	return violations, nil
+}
+
+// FormatViolations returns a human-readable report of the provided violations.
+func FormatViolations(violations []Violation) string {
+	var sb strings.Builder
+	for _, v := range violations {
+		sb.WriteString(v.String())
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -0,0 +1,180 @@
+package invariants_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/invariants"
+)
+
+var (
+	accountsPrefix      = collections.NewPrefix(0)
+	balancesPrefix      = collections.NewPrefix(1)
+	supplyPrefix        = collections.NewPrefix(2)
+	tokensPrefix        = collections.NewPrefix(3)
+	tokensByOwnerPrefix = collections.NewPrefix(4)
+	aliasesPrefix       = collections.NewPrefix(5)
+	aliasByOwnerPrefix  = collections.NewPrefix(6)
+)
+
+type tokensIndexes struct {
+	Owner *indexes.Multi[string, uint64, string]
+}
+
+func (i tokensIndexes) IndexesList() []collections.Index[uint64, string] {
+	return []collections.Index[uint64, string]{i.Owner}
+}
+
+type aliasesIndexes struct {
+	Owner *indexes.Unique[string, string, string]
+}
+
+func (i aliasesIndexes) IndexesList() []collections.Index[string, string] {
+	return []collections.Index[string, string]{i.Owner}
+}
+
+func ownerOf[K any](_ K, owner string) (string, error) { return owner, nil }
+
+type testState struct {
+	storeService store.KVStoreService
+	accounts     collections.Map[string, uint64]
+	balances     collections.Map[string, math.Int]
+	supply       collections.Item[math.Int]
+	tokens       *collections.IndexedMap[uint64, string, tokensIndexes]
+	aliases      *collections.IndexedMap[string, string, aliasesIndexes]
+	checker      *invariants.Checker
+}
+
+func newTestState(t *testing.T) (context.Context, *testState) {
+	t.Helper()
+	ctx := coretesting.Context()
+	storeService := coretesting.KVStoreService(ctx, "test")
+	sb := collections.NewSchemaBuilder(storeService)
+	s := &testState{
+		storeService: storeService,
+		accounts:     collections.NewMap(sb, accountsPrefix, "accounts", collections.StringKey, collections.Uint64Value),
+		balances:     collections.NewMap(sb, balancesPrefix, "balances", collections.StringKey, sdk.IntValue),
+		supply:       collections.NewItem(sb, supplyPrefix, "supply", sdk.IntValue),
+		tokens: collections.NewIndexedMap(sb, tokensPrefix, "tokens", collections.Uint64Key, collections.StringValue, tokensIndexes{
+			Owner: indexes.NewMulti(sb, tokensByOwnerPrefix, "tokens_by_owner", collections.StringKey, collections.Uint64Key, ownerOf[uint64]),
+		}),
+		aliases: collections.NewIndexedMap(sb, aliasesPrefix, "aliases", collections.StringKey, collections.StringValue, aliasesIndexes{
+			Owner: indexes.NewUnique(sb, aliasByOwnerPrefix, "alias_by_owner", collections.StringKey, collections.StringKey, ownerOf[string]),
+		}),
+	}
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	accounts := invariants.Of("accounts", s.accounts)
+	balances := invariants.Of("balances", s.balances)
+	tokens := invariants.Of("tokens", s.tokens)
+	aliases := invariants.Of("aliases", s.aliases)
+	s.checker, err = invariants.NewChecker("test", schema,
+		invariants.ForeignKey("balance_account", balances, accounts, func(addr string, _ math.Int) (string, bool, error) {
+			return addr, true, nil
+		}),
+		invariants.ForeignKey("token_owner", tokens, accounts, func(_ uint64, owner string) (string, bool, error) {
+			return owner, owner != "", nil
+		}),
+		invariants.MultiIndex("tokens_by_owner", tokens, "tokens_by_owner", s.tokens.Indexes.Owner, ownerOf[uint64]),
+		invariants.UniqueIndex("alias_by_owner", aliases, "alias_by_owner", s.aliases.Indexes.Owner, ownerOf[string]),
+		invariants.Sum("supply", "supply", s.supply, balances, func(_ string, amount math.Int) (math.Int, error) {
+			return amount, nil
+		}, math.ZeroInt()),
+	)
+	require.NoError(t, err)
+	return ctx, s
+}
+
+func (s *testState) seed(t *testing.T, ctx context.Context) {
+	t.Helper()
+	require.NoError(t, s.accounts.Set(ctx, "alice", 1))
+	require.NoError(t, s.accounts.Set(ctx, "bob", 2))
+	require.NoError(t, s.balances.Set(ctx, "alice", math.NewInt(10)))
+	require.NoError(t, s.balances.Set(ctx, "bob", math.NewInt(5)))
+	require.NoError(t, s.supply.Set(ctx, math.NewInt(15)))
+	require.NoError(t, s.tokens.Set(ctx, 1, "alice"))
+	require.NoError(t, s.tokens.Set(ctx, 2, "alice"))
+	require.NoError(t, s.tokens.Set(ctx, 3, ""))
+	require.NoError(t, s.aliases.Set(ctx, "a", "alice"))
+	require.NoError(t, s.aliases.Set(ctx, "b", "bob"))
+}
+
+func TestChecker(t *testing.T) {
+	ctx, s := newTestState(t)
+	s.seed(t, ctx)
+
+	violations, err := s.checker.Check(ctx)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	// break the foreign keys and the sum
+	require.NoError(t, s.accounts.Remove(ctx, "bob"))
+	require.NoError(t, s.tokens.Set(ctx, 4, "carol"))
+	require.NoError(t, s.supply.Set(ctx, math.NewInt(16)))
+
+	violations, err = s.checker.Check(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []invariants.Violation{
+		{Module: "test", Invariant: "balance_account", Collection: "balances", Key: "bob", Reason: "references missing accounts key bob"},
+		{Module: "test", Invariant: "token_owner", Collection: "tokens", Key: "4", Reason: "references missing accounts key carol"},
+		{Module: "test", Invariant: "supply", Collection: "supply", Reason: "is 16 but the sum of balances is 15"},
+	}, violations)
+	require.Equal(t, "test/supply: supply: is 16 but the sum of balances is 15", violations[2].String())
+}
+
+func TestChecker_Indexes(t *testing.T) {
+	ctx, s := newTestState(t)
+	s.seed(t, ctx)
+
+	// write the primary maps bypassing the indexes.
+	sb := collections.NewSchemaBuilder(s.storeService)
+	rawTokens := collections.NewMap(sb, tokensPrefix, "tokens", collections.Uint64Key, collections.StringValue)
+	rawAliases := collections.NewMap(sb, aliasesPrefix, "aliases", collections.StringKey, collections.StringValue)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, rawTokens.Set(ctx, 1, "bob"))    // index still references alice
+	require.NoError(t, rawTokens.Remove(ctx, 2))        // dangling index entry
+	require.NoError(t, rawAliases.Set(ctx, "c", "bob")) // bob is indexed to alias b
+	require.NoError(t, rawAliases.Remove(ctx, "a"))     // dangling index entry
+
+	violations, err := s.checker.Check(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []invariants.Violation{
+		{Module: "test", Invariant: "tokens_by_owner", Collection: "tokens_by_owner", Key: `("alice", "1")`, Reason: "does not match the tokens value of key 1"},
+		{Module: "test", Invariant: "tokens_by_owner", Collection: "tokens_by_owner", Key: `("alice", "2")`, Reason: "references missing tokens key 2"},
+		{Module: "test", Invariant: "tokens_by_owner", Collection: "tokens", Key: "1", Reason: `missing from tokens_by_owner with key ("bob", "1")`},
+		{Module: "test", Invariant: "alias_by_owner", Collection: "aliases", Key: "c", Reason: "alias_by_owner key bob references b instead"},
+		{Module: "test", Invariant: "alias_by_owner", Collection: "alias_by_owner", Key: "alice", Reason: "references missing aliases key a"},
+	}, violations)
+}
+
+func TestNewChecker_Validation(t *testing.T) {
+	ctx := coretesting.Context()
+	sb := collections.NewSchemaBuilder(coretesting.KVStoreService(ctx, "test"))
+	accounts := collections.NewMap(sb, accountsPrefix, "accounts", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	fk := func(name, to string) invariants.Invariant {
+		return invariants.ForeignKey(name, invariants.Of("accounts", accounts), invariants.Of(to, accounts), func(k string, _ uint64) (string, bool, error) {
+			return k, true, nil
+		})
+	}
+
+	_, err = invariants.NewChecker("test", schema, fk("a", "accounts"), fk("a", "accounts"))
+	require.ErrorContains(t, err, "duplicate invariant a")
+
+	_, err = invariants.NewChecker("test", schema, fk("a", "unknown"))
+	require.ErrorContains(t, err, "collection unknown which is not part of the test schema")
+}
@@ -0,0 +1,240 @@
+package invariants
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+)
+
+// invariant is the Invariant implementation returned by the constructors of this package.
+type invariant struct {
+	name        string
+	collections []string
+	check       func(ctx context.Context) ([]Violation, error)
+}
+
+func (i invariant) Name() string                                   { return i.name }
+func (i invariant) Collections() []string                          { return i.collections }
+func (i invariant) Check(ctx context.Context) ([]Violation, error) { return i.check(ctx) }
+
+// New returns an Invariant reading the provided collections and verified by the check function.
+// It can be used to declare invariants which cannot be expressed by the helpers of this package.
+func New(name string, collections []string, check func(ctx context.Context) ([]Violation, error)) Invariant {
+	return invariant{name: name, collections: collections, check: check}
+}
+
+// ForeignKey declares that the key referenced by every entry of from exists in to.
+// The ref function returns the referenced key, or false if the entry references nothing.
+// An error returned by ref is reported as a violation of the entry.
+func ForeignKey[K, V, RK, RV any](name string, from Ref[K, V], to Ref[RK, RV], ref func(K, V) (RK, bool, error)) Invariant {
+	return New(name, []string{from.Name, to.Name}, func(ctx context.Context) ([]Violation, error) {
+		var violations []Violation
+		err := from.Table.Walk(ctx, nil, func(key K, value V) (bool, error) {
+			refKey, ok, err := ref(key, value)
+			if err != nil {
+				violations = append(violations, violation(from, key, "invalid reference: %s", err))
+				return false, nil
+			}
+			if !ok {
+				return false, nil
+			}
+			has, err := to.Table.Has(ctx, refKey)
+			if err != nil {
+				return true, err
+			}
+			if !has {
+				violations = append(violations, violation(from, key, "references missing %s key %s", to.Name, to.Table.KeyCodec().Stringify(refKey)))
+			}
+			return false, nil
+		})
+		return violations, err
+	})
+}
+
+// MultiIndex declares that the multi index named indexName references exactly
+// the entries of primary, through the same refKey function used to build the index.
+func MultiIndex[R, PK, V any](name string, primary Ref[PK, V], indexName string, index *indexes.Multi[R, PK, V], refKey func(PK, V) (R, error)) Invariant {
+	return New(name, []string{primary.Name, indexName}, func(ctx context.Context) ([]Violation, error) {
+		var (
+			violations []Violation
+			expected   = make(map[string]collections.Pair[R, PK])
+			order      []string
+		)
+		kc := index.KeyCodec()
+		err := primary.Table.Walk(ctx, nil, func(pk PK, value V) (bool, error) {
+			ref, err := refKey(pk, value)
+			if err != nil {
+				violations = append(violations, violation(primary, pk, "invalid reference key: %s", err))
+				return false, nil
+			}
+			key := collections.Join(ref, pk)
+			bz, err := collections.EncodeKeyWithPrefix(nil, kc, key)
+			if err != nil {
+				return true, err
+			}
+			expected[string(bz)] = key
+			order = append(order, string(bz))
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = index.Walk(ctx, nil, func(ref R, pk PK) (bool, error) {
+			key := collections.Join(ref, pk)
+			bz, err := collections.EncodeKeyWithPrefix(nil, kc, key)
+			if err != nil {
+				return true, err
+			}
+			if _, ok := expected[string(bz)]; ok {
+				delete(expected, string(bz))
+				return false, nil
+			}
+			has, err := primary.Table.Has(ctx, pk)
+			if err != nil {
+				return true, err
+			}
+			reason := fmt.Sprintf("references missing %s key %s", primary.Name, primary.Table.KeyCodec().Stringify(pk))
+			if has {
+				reason = fmt.Sprintf("does not match the %s value of key %s", primary.Name, primary.Table.KeyCodec().Stringify(pk))
+			}
+			violations = append(violations, Violation{Collection: indexName, Key: kc.Stringify(key), Reason: reason})
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bz := range order {
+			key, ok := expected[bz]
+			if !ok {
+				continue
+			}
+			violations = append(violations, violation(primary, key.K2(), "missing from %s with key %s", indexName, kc.Stringify(key)))
+		}
+		return violations, nil
+	})
+}
+
+// UniqueIndex declares that the unique index named indexName references exactly
+// the entries of primary, through the same refKey function used to build the index.
+func UniqueIndex[R, PK, V any](name string, primary Ref[PK, V], indexName string, index *indexes.Unique[R, PK, V], refKey func(PK, V) (R, error)) Invariant {
+	return New(name, []string{primary.Name, indexName}, func(ctx context.Context) ([]Violation, error) {
+		var violations []Violation
+		pkc := primary.Table.KeyCodec()
+		indexed := make(map[string]int)
+		err := primary.Table.Walk(ctx, nil, func(pk PK, value V) (bool, error) {
+			ref, err := refKey(pk, value)
+			if err != nil {
+				violations = append(violations, violation(primary, pk, "invalid reference key: %s", err))
+				return false, nil
+			}
+			bz, err := collections.EncodeKeyWithPrefix(nil, pkc, pk)
+			if err != nil {
+				return true, err
+			}
+			indexed[string(bz)] = 0
+
+			got, err := index.MatchExact(ctx, ref)
+			switch {
+			case errors.Is(err, collections.ErrNotFound):
+				violations = append(violations, violation(primary, pk, "missing from %s with key %v", indexName, ref))
+			case err != nil:
+				return true, err
+			default:
+				gotBz, err := collections.EncodeKeyWithPrefix(nil, pkc, got)
+				if err != nil {
+					return true, err
+				}
+				if !bytes.Equal(bz, gotBz) {
+					violations = append(violations, violation(primary, pk, "%s key %v references %s instead", indexName, ref, pkc.Stringify(got)))
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = index.Walk(ctx, nil, func(ref R, pk PK) (bool, error) {
+			bz, err := collections.EncodeKeyWithPrefix(nil, pkc, pk)
+			if err != nil {
+				return true, err
+			}
+			count, ok := indexed[string(bz)]
+			switch {
+			case !ok:
+				violations = append(violations, Violation{
+					Collection: indexName,
+					Key:        fmt.Sprintf("%v", ref),
+					Reason:     fmt.Sprintf("references missing %s key %s", primary.Name, pkc.Stringify(pk)),
+				})
+			case count > 0:
+				violations = append(violations, Violation{
+					Collection: indexName,
+					Key:        fmt.Sprintf("%v", ref),
+					Reason:     fmt.Sprintf("references %s key %s which is already indexed", primary.Name, pkc.Stringify(pk)),
+				})
+			}
+			indexed[string(bz)] = count + 1
+			return false, nil
+		})
+		return violations, err
+	})
+}
+
+// Summable is implemented by numeric types which can be accumulated,
+// such as math.Int and math.LegacyDec.
+type Summable[T any] interface {
+	Add(T) T
+	Equal(T) bool
+}
+
+// Sum declares that the value of the total item equals the sum of the terms
+// returned by term for every entry of terms, starting from zero.
+// A missing total is treated as zero.
+func Sum[T Summable[T], K, V any](name, totalName string, total collections.Item[T], terms Ref[K, V], term func(K, V) (T, error), zero T) Invariant {
+	return New(name, []string{totalName, terms.Name}, func(ctx context.Context) ([]Violation, error) {
+		var violations []Violation
+		sum := zero
+		err := terms.Table.Walk(ctx, nil, func(key K, value V) (bool, error) {
+			t, err := term(key, value)
+			if err != nil {
+				violations = append(violations, violation(terms, key, "invalid term: %s", err))
+				return false, nil
+			}
+			sum = sum.Add(t)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		want, err := total.Get(ctx)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			want = zero
+		case err != nil:
+			return nil, err
+		}
+		if !want.Equal(sum) {
+			violations = append(violations, Violation{
+				Collection: totalName,
+				Reason:     fmt.Sprintf("is %v but the sum of %s is %v", want, terms.Name, sum),
+			})
+		}
+		return violations, nil
+	})
+}
+
+func violation[K, V any](ref Ref[K, V], key K, format string, args ...any) Violation {
+	return Violation{
+		Collection: ref.Name,
+		Key:        ref.Table.KeyCodec().Stringify(key),
+		Reason:     fmt.Sprintf(format, args...),
+	}
+}
@@ -19,6 +19,8 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 
 	registrar.RegisterInterface((*Authorization)(nil), nil)
 	registrar.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
+	registrar.RegisterConcrete(&ConstrainedAuthorization{}, "cosmos-sdk/ConstrainedAuthorization")
+	registrar.RegisterConcrete(&PeriodicAuthorization{}, "cosmos-sdk/PeriodicAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -37,6 +39,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&ConstrainedAuthorization{},
+		&PeriodicAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
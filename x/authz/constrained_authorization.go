@@ -0,0 +1,286 @@
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/tx/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constraintFileResolver resolves the descriptors of the messages constrained
+// by a ConstrainedAuthorization.
+var constraintFileResolver signing.ProtoFileResolver = gogoproto.HybridResolver
+
+// NewConstrainedAuthorization creates a new ConstrainedAuthorization object.
+func NewConstrainedAuthorization(msgTypeURL string, constraints ...FieldConstraint) *ConstrainedAuthorization {
+	return &ConstrainedAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConstrainedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a ConstrainedAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	m, err := reflectMsg(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	for _, c := range a.Constraints {
+		fields, err := resolveFieldPath(m.Descriptor(), c.Path)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		values := fieldValues(m, fields)
+		if len(values) == 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s is not set", c.Path)
+		}
+		for _, v := range values {
+			if err := c.check(fields[len(fields)-1], v); err != nil {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s: %s", c.Path, err)
+			}
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConstrainedAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if len(a.Constraints) == 0 {
+		return errors.New("constraints cannot be empty")
+	}
+
+	desc, err := constraintFileResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(a.Msg, "/")))
+	if err != nil {
+		return fmt.Errorf("unknown msg type %s: %w", a.Msg, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf("%s is not a message", a.Msg)
+	}
+
+	for _, c := range a.Constraints {
+		fields, err := resolveFieldPath(msgDesc, c.Path)
+		if err != nil {
+			return err
+		}
+		if err := c.validate(fields[len(fields)-1]); err != nil {
+			return fmt.Errorf("invalid constraint on field %s: %w", c.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the constraint sets at least one condition and that its
+// conditions apply to the field.
+func (c FieldConstraint) validate(field protoreflect.FieldDescriptor) error {
+	if c.Equals == "" && len(c.AllowedValues) == 0 && c.Min == "" && c.Max == "" {
+		return errors.New("constraint must set equals, allowed values or bounds")
+	}
+	if c.Equals != "" && len(c.AllowedValues) > 0 {
+		return errors.New("equals and allowed values cannot be both set")
+	}
+
+	if c.Min == "" && c.Max == "" {
+		return nil
+	}
+	if !isIntegerField(field) {
+		return fmt.Errorf("bounds cannot be set on %s field", field.Kind())
+	}
+
+	var minimum, maximum math.Int
+	if c.Min != "" {
+		v, ok := math.NewIntFromString(c.Min)
+		if !ok {
+			return fmt.Errorf("invalid min %s", c.Min)
+		}
+		minimum = v
+	}
+	if c.Max != "" {
+		v, ok := math.NewIntFromString(c.Max)
+		if !ok {
+			return fmt.Errorf("invalid max %s", c.Max)
+		}
+		maximum = v
+	}
+	if c.Min != "" && c.Max != "" && minimum.GT(maximum) {
+		return fmt.Errorf("min %s is greater than max %s", c.Min, c.Max)
+	}
+
+	return nil
+}
+
+// check returns an error if the value of the field doesn't satisfy the constraint.
+func (c FieldConstraint) check(field protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	s := formatFieldValue(field, value)
+	if c.Equals != "" && s != c.Equals {
+		return fmt.Errorf("%s is not equal to %s", s, c.Equals)
+	}
+	if len(c.AllowedValues) > 0 && !slices.Contains(c.AllowedValues, s) {
+		return fmt.Errorf("%s is not an allowed value", s)
+	}
+
+	if c.Min == "" && c.Max == "" {
+		return nil
+	}
+	v, ok := math.NewIntFromString(s)
+	if !ok {
+		return fmt.Errorf("%s is not an integer", s)
+	}
+	if c.Min != "" {
+		if minimum, ok := math.NewIntFromString(c.Min); !ok || v.LT(minimum) {
+			return fmt.Errorf("%s is lower than %s", s, c.Min)
+		}
+	}
+	if c.Max != "" {
+		if maximum, ok := math.NewIntFromString(c.Max); !ok || v.GT(maximum) {
+			return fmt.Errorf("%s is greater than %s", s, c.Max)
+		}
+	}
+
+	return nil
+}
+
+// reflectMsg returns the protoreflect representation of the message.
+func reflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	if m, ok := msg.(proto.Message); ok {
+		return m.ProtoReflect(), nil
+	}
+
+	name := gogoproto.MessageName(msg)
+	desc, err := constraintFileResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("unknown msg type %s: %w", name, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	m := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// resolveFieldPath returns the fields along the dot separated path from the
+// message. The path must end at a scalar field and can't go through maps.
+func resolveFieldPath(desc protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, errors.New("field path cannot be empty")
+	}
+
+	names := strings.Split(path, ".")
+	fields := make([]protoreflect.FieldDescriptor, 0, len(names))
+	for i, name := range names {
+		if desc == nil {
+			return nil, fmt.Errorf("field path %s goes through scalar field %s", path, names[i-1])
+		}
+
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("unknown field %s in %s", name, desc.FullName())
+		}
+		if field.IsMap() {
+			return nil, fmt.Errorf("field path %s goes through map field %s", path, name)
+		}
+		fields = append(fields, field)
+		desc = field.Message()
+	}
+	if desc != nil {
+		return nil, fmt.Errorf("field path %s must end at a scalar field", path)
+	}
+
+	return fields, nil
+}
+
+// fieldValues returns the values of the last field along the path from the
+// message, flattening the repeated fields. Unset messages along the path have
+// no values.
+func fieldValues(m protoreflect.Message, fields []protoreflect.FieldDescriptor) []protoreflect.Value {
+	field, rest := fields[0], fields[1:]
+
+	var values []protoreflect.Value
+	if field.IsList() {
+		list := m.Get(field).List()
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, list.Get(i))
+		}
+	} else {
+		if len(rest) > 0 && !m.Has(field) {
+			return nil
+		}
+		values = append(values, m.Get(field))
+	}
+
+	if len(rest) == 0 {
+		return values
+	}
+
+	var res []protoreflect.Value
+	for _, v := range values {
+		res = append(res, fieldValues(v.Message(), rest)...)
+	}
+	return res
+}
+
+// formatFieldValue returns the string representation of a scalar value, which
+// constraints are compared with.
+func formatFieldValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if v := field.Enum().Values().ByNumber(value.Enum()); v != nil {
+			return string(v.Name())
+		}
+		return strconv.FormatInt(int64(value.Enum()), 10)
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.Bytes())
+	default:
+		return value.String()
+	}
+}
+
+// isIntegerField returns whether the field can hold an integer.
+func isIntegerField(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.StringKind:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	delegateMsgType = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	sendMsgType     = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+func TestConstrainedAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization *authz.ConstrainedAuthorization
+		expErr        string
+	}{
+		{
+			"valid",
+			authz.NewConstrainedAuthorization(sendMsgType,
+				authz.FieldConstraint{Path: "to_address", AllowedValues: []string{"cosmos1a", "cosmos1b"}},
+				authz.FieldConstraint{Path: "amount.denom", Equals: "stake"},
+				authz.FieldConstraint{Path: "amount.amount", Min: "1", Max: "100"},
+			),
+			"",
+		},
+		{"empty msg type", authz.NewConstrainedAuthorization("", authz.FieldConstraint{Path: "to_address", Equals: "cosmos1a"}), "msg type cannot be empty"},
+		{"unknown msg type", authz.NewConstrainedAuthorization("/cosmos.Unknown", authz.FieldConstraint{Path: "to_address", Equals: "cosmos1a"}), "unknown msg type"},
+		{"no constraints", authz.NewConstrainedAuthorization(sendMsgType), "constraints cannot be empty"},
+		{"unknown field", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "recipient", Equals: "cosmos1a"}), "unknown field recipient"},
+		{"path ending at a message", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "amount", Max: "100"}), "must end at a scalar field"},
+		{"path through a scalar", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "to_address.value", Equals: "a"}), "goes through scalar field"},
+		{"no condition", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "to_address"}), "must set equals"},
+		{"equals and allowed values", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "to_address", Equals: "cosmos1a", AllowedValues: []string{"cosmos1b"}}), "cannot be both set"},
+		{"invalid bound", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "amount.amount", Max: "1.5"}), "invalid max"},
+		{"min greater than max", authz.NewConstrainedAuthorization(sendMsgType, authz.FieldConstraint{Path: "amount.amount", Min: "10", Max: "1"}), "greater than max"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestConstrainedAuthorizationAccept(t *testing.T) {
+	delegate := func(validator string, amount int64) sdk.Msg {
+		return &stakingtypes.MsgDelegate{ValidatorAddress: validator, Amount: sdk.NewInt64Coin("stake", amount)}
+	}
+	send := func(to string, amount ...sdk.Coin) sdk.Msg {
+		return &banktypes.MsgSend{ToAddress: to, Amount: amount}
+	}
+	onlyValidators := authz.NewConstrainedAuthorization(delegateMsgType,
+		authz.FieldConstraint{Path: "validator_address", AllowedValues: []string{"cosmosvaloper1a", "cosmosvaloper1b"}},
+	)
+	sendLimit := authz.NewConstrainedAuthorization(sendMsgType,
+		authz.FieldConstraint{Path: "to_address", Equals: "cosmos1a"},
+		authz.FieldConstraint{Path: "amount.denom", Equals: "stake"},
+		authz.FieldConstraint{Path: "amount.amount", Max: "100"},
+	)
+
+	testCases := []struct {
+		name          string
+		authorization *authz.ConstrainedAuthorization
+		msg           sdk.Msg
+		expErr        string
+	}{
+		{"allowed validator", onlyValidators, delegate("cosmosvaloper1b", 10), ""},
+		{"not allowed validator", onlyValidators, delegate("cosmosvaloper1c", 10), "not an allowed value"},
+		{"coin amount within bounds", sendLimit, send("cosmos1a", sdk.NewInt64Coin("stake", 100)), ""},
+		{"coin amount out of bounds", sendLimit, send("cosmos1a", sdk.NewInt64Coin("stake", 101)), "greater than 100"},
+		{"other recipient", sendLimit, send("cosmos1b", sdk.NewInt64Coin("stake", 1)), "not equal to cosmos1a"},
+		{"every coin is constrained", sendLimit, send("cosmos1a", sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 1)), "atom is not equal to stake"},
+		{"unset field", sendLimit, send("cosmos1a"), "is not set"},
+		{
+			"integer field bounds",
+			authz.NewConstrainedAuthorization(delegateMsgType, authz.FieldConstraint{Path: "amount.amount", Min: "5"}),
+			delegate("cosmosvaloper1a", 4),
+			"lower than 5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.authorization.ValidateBasic())
+			resp, err := tc.authorization.Accept(context.Background(), tc.msg)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.Nil(t, resp.Updated)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -24,6 +24,17 @@ func ValidateGenesis(data GenesisState) error {
 		}
 
 	}
+	for i, u := range data.PeriodicUsages {
+		if u.Grantee == "" {
+			return fmt.Errorf("periodic usage: %d, missing grantee", i)
+		}
+		if u.Granter == "" {
+			return fmt.Errorf("periodic usage: %d, missing granter", i)
+		}
+		if u.MsgTypeUrl == "" {
+			return fmt.Errorf("periodic usage: %d, missing msg type url", i)
+		}
+	}
 	return nil
 }
 
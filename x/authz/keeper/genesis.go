@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/authz"
 
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +39,26 @@ func (k Keeper) InitGenesis(ctx context.Context, data *authz.GenesisState) error
 			return err
 		}
 	}
+
+	for _, entry := range data.PeriodicUsages {
+		grantee, err := k.addrCdc.StringToBytes(entry.Grantee)
+		if err != nil {
+			return err
+		}
+		granter, err := k.addrCdc.StringToBytes(entry.Granter)
+		if err != nil {
+			return err
+		}
+
+		// ignore the usages of authorizations which were not imported
+		if _, found := k.getGrant(ctx, grantStoreKey(grantee, granter, entry.MsgTypeUrl)); !found {
+			continue
+		}
+
+		if err := k.setPeriodicUsage(ctx, grantee, granter, entry.MsgTypeUrl, entry.Usage); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -63,5 +85,44 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*authz.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	return authz.NewGenesisState(entries), nil
+
+	genState := authz.NewGenesisState(entries)
+	genState.PeriodicUsages, err = k.exportPeriodicUsages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return genState, nil
+}
+
+// exportPeriodicUsages returns the usages of all the periodic authorizations.
+func (k Keeper) exportPeriodicUsages(ctx context.Context) ([]authz.GrantPeriodicUsage, error) {
+	store := runtime.KVStoreAdapter(k.KVStoreService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, PeriodicUsagePrefix)
+	defer iter.Close()
+
+	var usages []authz.GrantPeriodicUsage
+	for ; iter.Valid(); iter.Next() {
+		granter, grantee, msgType := parseGrantStoreKey(iter.Key())
+		granterAddr, err := k.addrCdc.BytesToString(granter)
+		if err != nil {
+			return nil, err
+		}
+		granteeAddr, err := k.addrCdc.BytesToString(grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		var usage authz.PeriodicUsage
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return nil, err
+		}
+
+		usages = append(usages, authz.GrantPeriodicUsage{
+			Granter:    granterAddr,
+			Grantee:    granteeAddr,
+			MsgTypeUrl: msgType,
+			Usage:      usage,
+		})
+	}
+	return usages, nil
 }
@@ -128,6 +128,12 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, err
 			}
 
+			if periodic, ok := authorization.(*authz.PeriodicAuthorization); ok && resp.Accept {
+				if err := k.updatePeriodicUsage(ctx, grantee, granter, periodic, msg); err != nil {
+					return nil, err
+				}
+			}
+
 			if resp.Delete {
 				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
 			} else if resp.Updated != nil {
@@ -168,7 +174,8 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
-// same `sdk.Msg` type, this grant overwrites that.
+// same `sdk.Msg` type, this grant overwrites that. The usage of an overwritten periodic authorization is kept
+// if the new authorization is also periodic, so that re-granting doesn't reset the current period.
 func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
 	msgType := authorization.MsgTypeURL()
 	store := k.KVStoreService.OpenKVStore(ctx)
@@ -207,6 +214,12 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 		return err
 	}
 
+	if _, ok := authorization.(*authz.PeriodicAuthorization); !ok {
+		if err := k.deletePeriodicUsage(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
 	granterAddr, err := k.addrCdc.BytesToString(granter)
 	if err != nil {
 		return err
@@ -256,6 +269,10 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 		return err
 	}
 
+	if err := k.deletePeriodicUsage(ctx, grantee, granter, msgType); err != nil {
+		return err
+	}
+
 	granterAddr, err := k.addrCdc.BytesToString(granter)
 	if err != nil {
 		return err
@@ -508,6 +525,10 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context, limit int) er
 			if err != nil {
 				return err
 			}
+
+			if err := k.deletePeriodicUsage(ctx, grantee, granter, typeURL); err != nil {
+				return err
+			}
 		}
 
 		// limit the amount of iterations to avoid taking too much time
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/authz"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPeriodicUsage returns the usage of the periodic authorization granted to
+// the grantee by the granter for the provided message type.
+func (k Keeper) GetPeriodicUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.PeriodicUsage, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+
+	bz, err := store.Get(periodicUsageStoreKey(grantee, granter, msgType))
+	if err != nil || bz == nil {
+		return authz.PeriodicUsage{}, err
+	}
+
+	var usage authz.PeriodicUsage
+	if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+		return authz.PeriodicUsage{}, err
+	}
+	return usage, nil
+}
+
+// setPeriodicUsage stores the usage of a periodic authorization.
+func (k Keeper) setPeriodicUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string, usage authz.PeriodicUsage) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	skey := periodicUsageStoreKey(grantee, granter, msgType)
+	if len(usage.Spends) == 0 {
+		return store.Delete(skey)
+	}
+
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	return store.Set(skey, bz)
+}
+
+// deletePeriodicUsage deletes the usage of a periodic authorization, if any.
+func (k Keeper) deletePeriodicUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Delete(periodicUsageStoreKey(grantee, granter, msgType))
+}
+
+// updatePeriodicUsage records a message executed with a periodic authorization
+// in its usage, and returns an error if the message exceeds its period limits.
+func (k Keeper) updatePeriodicUsage(ctx context.Context, grantee, granter sdk.AccAddress, authorization *authz.PeriodicAuthorization, msg sdk.Msg) error {
+	if !authorization.HasPeriodLimits() {
+		return nil
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	usage, err := k.GetPeriodicUsage(ctx, grantee, granter, msgType)
+	if err != nil {
+		return err
+	}
+
+	usage, err = authorization.UpdateUsage(usage, k.HeaderService.HeaderInfo(ctx).Time, msg)
+	if err != nil {
+		return err
+	}
+
+	return k.setPeriodicUsage(ctx, grantee, granter, msgType, usage)
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestDispatchPeriodicAuthorization() {
+	require := s.Require()
+	granterAddr, granteeAddr := s.addrs[0], s.addrs[1]
+	granterStrAddr, err := s.addrCdc.BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.addrCdc.BytesToString(s.addrs[2])
+	require.NoError(err)
+	otherStrAddr, err := s.addrCdc.BytesToString(s.addrs[3])
+	require.NoError(err)
+
+	send := func(ctx sdk.Context, to string, amount int64) error {
+		_, err := s.authzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: granterStrAddr,
+			ToAddress:   to,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}})
+		return err
+	}
+
+	period := 24 * time.Hour
+	a, err := authz.NewPeriodicAuthorization(authz.NewGenericAuthorization(bankSendAuthMsgType), period, coins100, 3, []string{recipientStrAddr})
+	require.NoError(err)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, nil))
+
+	start := s.ctx.HeaderInfo().Time
+	ctx := s.ctx
+	require.NoError(send(ctx, recipientStrAddr, 60))
+	require.ErrorIs(send(ctx, otherStrAddr, 10), sdkerrors.ErrUnauthorized)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(time.Hour)})
+	require.ErrorIs(send(ctx, recipientStrAddr, 50), authz.ErrPeriodLimitExceeded)
+	require.NoError(send(ctx, recipientStrAddr, 40))
+
+	s.T().Log("verify the limits apply to a rolling period")
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(period - time.Second)})
+	require.ErrorIs(send(ctx, recipientStrAddr, 10), authz.ErrPeriodLimitExceeded)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(period)})
+	require.NoError(send(ctx, recipientStrAddr, 50))
+	require.NoError(send(ctx, recipientStrAddr, 10))
+	require.ErrorIs(send(ctx, recipientStrAddr, 1), authz.ErrPeriodLimitExceeded)
+
+	usage, err := s.authzKeeper.GetPeriodicUsage(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NoError(err)
+	require.Len(usage.Spends, 3)
+
+	genState, err := s.authzKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genState.PeriodicUsages, 1)
+	require.Equal(usage, genState.PeriodicUsages[0].Usage)
+
+	s.T().Log("verify the usage is kept when re-granting and deleted with the grant")
+	require.NoError(s.authzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, a, nil))
+	require.ErrorIs(send(ctx, recipientStrAddr, 1), authz.ErrPeriodLimitExceeded)
+
+	require.NoError(s.authzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	usage, err = s.authzKeeper.GetPeriodicUsage(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NoError(err)
+	require.Empty(usage.Spends)
+
+	s.T().Log("verify the wrapped authorization is updated")
+	a, err = authz.NewPeriodicAuthorization(banktypes.NewSendAuthorization(coins100, nil, s.addrCdc), period, nil, 2, nil)
+	require.NoError(err)
+	require.NoError(s.authzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, a, nil))
+	require.NoError(send(ctx, otherStrAddr, 30))
+
+	updated, _ := s.authzKeeper.GetAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	periodic, ok := updated.(*authz.PeriodicAuthorization)
+	require.True(ok)
+	inner, err := periodic.GetAuthorization()
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), inner.(*banktypes.SendAuthorization).SpendLimit)
+}
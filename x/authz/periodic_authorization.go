@@ -0,0 +1,226 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	bank "cosmossdk.io/x/bank/types"
+	staking "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                        = &PeriodicAuthorization{}
+	_ gogoprotoany.UnpackInterfacesMessage = &PeriodicAuthorization{}
+)
+
+// NewPeriodicAuthorization creates a new PeriodicAuthorization wrapping the
+// given authorization.
+func NewPeriodicAuthorization(authorization Authorization, period time.Duration, periodSpendLimit sdk.Coins, periodMaxMsgs uint64, allowedRecipients []string) (*PeriodicAuthorization, error) {
+	any, err := gogoprotoany.NewAnyWithCacheWithValue(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeriodicAuthorization{
+		Authorization:     any,
+		Period:            period,
+		PeriodSpendLimit:  periodSpendLimit,
+		PeriodMaxMsgs:     periodMaxMsgs,
+		AllowedRecipients: allowedRecipients,
+	}, nil
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a PeriodicAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	authorization, ok := a.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), a.Authorization.GetCachedValue())
+	}
+	return authorization, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. It checks the recipients of the
+// message and delegates to the wrapped authorization. The period limits are
+// enforced by the keeper through UpdateUsage, as they depend on the usage of
+// the authorization.
+func (a PeriodicAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if len(a.AllowedRecipients) > 0 {
+		recipients, ok := msgRecipients(msg)
+		if !ok {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot determine the recipients of %s", sdk.MsgTypeURL(msg))
+		}
+		for _, recipient := range recipients {
+			if !slices.Contains(a.AllowedRecipients, recipient) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", recipient)
+			}
+		}
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept || resp.Delete || resp.Updated == nil {
+		return resp, err
+	}
+
+	updated, ok := resp.Updated.(Authorization)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), resp.Updated)
+	}
+	any, err := gogoprotoany.NewAnyWithCacheWithValue(updated)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	a.Authorization = any
+	resp.Updated = &a
+	return resp, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicAuthorization) ValidateBasic() error {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := authorization.(*PeriodicAuthorization); ok {
+		return errors.New("periodic authorizations cannot be nested")
+	}
+
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return errors.New("period must be positive")
+	}
+
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period spend limit %s", a.PeriodSpendLimit)
+	}
+
+	if a.PeriodSpendLimit.Empty() && a.PeriodMaxMsgs == 0 && len(a.AllowedRecipients) == 0 {
+		return errors.New("periodic authorization must set a period spend limit, a period max msgs or allowed recipients")
+	}
+
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed recipient %s: %s", recipient, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a PeriodicAuthorization) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// HasPeriodLimits returns true if the authorization limits the amount spent
+// or the number of messages executed per period.
+func (a PeriodicAuthorization) HasPeriodLimits() bool {
+	return !a.PeriodSpendLimit.Empty() || a.PeriodMaxMsgs > 0
+}
+
+// UpdateUsage records a message executed at the given time in the usage of
+// the authorization. The spends which are out of the rolling period ending at
+// that time are pruned first, so that the limits apply to the messages
+// executed within the last period only. It returns an error if the message
+// exceeds the period spend limit or the period max msgs.
+func (a PeriodicAuthorization) UpdateUsage(usage PeriodicUsage, now time.Time, msg sdk.Msg) (PeriodicUsage, error) {
+	periodStart := now.Add(-a.Period)
+	spends := make([]PeriodicSpend, 0, len(usage.Spends)+1)
+	spent := sdk.NewCoins()
+	for _, spend := range usage.Spends {
+		if !spend.Time.After(periodStart) {
+			continue
+		}
+		spends = append(spends, spend)
+		spent = spent.Add(spend.Amount...)
+	}
+
+	if a.PeriodMaxMsgs > 0 && uint64(len(spends)) >= a.PeriodMaxMsgs {
+		return PeriodicUsage{}, ErrPeriodLimitExceeded.Wrapf("%d messages already executed within the period", len(spends))
+	}
+
+	var amount sdk.Coins
+	if !a.PeriodSpendLimit.Empty() {
+		var ok bool
+		amount, ok = msgSpendAmount(msg)
+		if !ok {
+			return PeriodicUsage{}, sdkerrors.ErrUnauthorized.Wrapf("cannot determine the amount spent by %s", sdk.MsgTypeURL(msg))
+		}
+
+		spent = spent.Add(amount...)
+		if !spent.IsAllLTE(a.PeriodSpendLimit) {
+			return PeriodicUsage{}, ErrPeriodLimitExceeded.Wrapf("spending %s within the period exceeds the period spend limit %s", spent, a.PeriodSpendLimit)
+		}
+	}
+
+	spends = append(spends, PeriodicSpend{Time: now, Amount: amount})
+	return PeriodicUsage{Spends: spends}, nil
+}
+
+// msgSpendAmount returns the amount of coins spent by a message, if it can be
+// determined.
+func msgSpendAmount(msg sdk.Msg) (sdk.Coins, bool) {
+	switch m := msg.(type) {
+	case *bank.MsgSend:
+		return m.Amount, true
+	case *bank.MsgMultiSend:
+		amount := sdk.NewCoins()
+		for _, input := range m.Inputs {
+			amount = amount.Add(input.Coins...)
+		}
+		return amount, true
+	case *staking.MsgDelegate:
+		return sdk.NewCoins(m.Amount), true
+	case interface{ GetAmount() sdk.Coins }:
+		return m.GetAmount(), true
+	default:
+		return nil, false
+	}
+}
+
+// msgRecipients returns the addresses a message sends coins to, if it can be
+// determined.
+func msgRecipients(msg sdk.Msg) ([]string, bool) {
+	switch m := msg.(type) {
+	case *bank.MsgSend:
+		return []string{m.ToAddress}, true
+	case *bank.MsgMultiSend:
+		recipients := make([]string, 0, len(m.Outputs))
+		for _, output := range m.Outputs {
+			recipients = append(recipients, output.Address)
+		}
+		return recipients, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,82 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPeriodicAuthorizationValidateBasic(t *testing.T) {
+	generic := authz.NewGenericAuthorization(banktypes.SendAuthorization{}.MsgTypeURL())
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	nested, err := authz.NewPeriodicAuthorization(generic, time.Hour, limit, 0, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		authorization authz.Authorization
+		period        time.Duration
+		limit         sdk.Coins
+		maxMsgs       uint64
+		recipients    []string
+		expErr        string
+	}{
+		{"valid", generic, time.Hour, limit, 2, []string{"cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"}, ""},
+		{"nested periodic authorization", nested, time.Hour, limit, 0, nil, "cannot be nested"},
+		{"invalid wrapped authorization", authz.NewGenericAuthorization(""), time.Hour, limit, 0, nil, "msg type cannot be empty"},
+		{"zero period", generic, 0, limit, 0, nil, "period must be positive"},
+		{"no limits", generic, time.Hour, nil, 0, nil, "must set"},
+		{"invalid recipient", generic, time.Hour, nil, 0, []string{"invalid"}, "invalid allowed recipient"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := authz.NewPeriodicAuthorization(tc.authorization, tc.period, tc.limit, tc.maxMsgs, tc.recipients)
+			require.NoError(t, err)
+			err = a.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, banktypes.SendAuthorization{}.MsgTypeURL(), a.MsgTypeURL())
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestPeriodicAuthorizationUpdateUsage(t *testing.T) {
+	generic := authz.NewGenericAuthorization(banktypes.SendAuthorization{}.MsgTypeURL())
+	a, err := authz.NewPeriodicAuthorization(generic, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, nil)
+	require.NoError(t, err)
+
+	send := func(amount int64) sdk.Msg {
+		return &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", amount))}
+	}
+	now := time.Now().UTC()
+
+	usage, err := a.UpdateUsage(authz.PeriodicUsage{}, now, send(70))
+	require.NoError(t, err)
+	require.Len(t, usage.Spends, 1)
+
+	_, err = a.UpdateUsage(usage, now.Add(time.Hour-time.Nanosecond), send(31))
+	require.ErrorIs(t, err, authz.ErrPeriodLimitExceeded)
+
+	// the first spend is out of the period once a full period has elapsed
+	usage, err = a.UpdateUsage(usage, now.Add(time.Hour), send(100))
+	require.NoError(t, err)
+	require.Len(t, usage.Spends, 1)
+
+	// other denoms are not allowed by the period spend limit
+	_, err = a.UpdateUsage(authz.PeriodicUsage{}, now, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))})
+	require.ErrorIs(t, err, authz.ErrPeriodLimitExceeded)
+
+	// the amount spent must be known to apply the period spend limit
+	_, err = a.UpdateUsage(authz.PeriodicUsage{}, now, generic)
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker is called every block, it executes the due scheduled transfers. At most
+// MaxScheduledTransfersPerBlock transfers are executed, the remaining ones are executed
+// in the next blocks in the order of their next execution time.
+func (k BaseKeeper) EndBlocker(ctx context.Context) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	maxTransfers := k.GetParams(ctx).MaxScheduledTransfersPerBlockOrDefault()
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](now)
+
+	// due transfers are collected first as executing them updates the queue.
+	var due []uint64
+	err := k.ScheduledTransferQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		due = append(due, key.K2())
+		return len(due) >= int(maxTransfers), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range due {
+		st, err := k.ScheduledTransfers.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.executeScheduledTransfer(ctx, st, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, administered by the creator.
+// The denom creation fee is sent from the creator to the community pool.
+func (k BaseKeeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.addrCdc.BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.FactoryDenoms.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.SendCoinsFromAccountToModule(ctx, creator, types.ProtocolPoolModuleName, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay denom creation fee")
+		}
+	}
+
+	if err := k.FactoryDenoms.Set(ctx, denom, types.FactoryDenom{Denom: denom, Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	return denom, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+	)
+}
+
+// GetFactoryDenom returns the factory denom with the given name.
+func (k BaseKeeper) GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error) {
+	fd, err := k.FactoryDenoms.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FactoryDenom{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s does not exist", denom)
+	}
+	return fd, err
+}
+
+// GetAllFactoryDenoms returns all the factory denoms.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error) {
+	iter, err := k.FactoryDenoms.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// MintFactoryDenom mints amt coins of a factory denom to the recipient, the
+// admin must be the admin of the denom.
+func (k BaseKeeper) MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, amt sdk.Coin) error {
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+	adminStr, err := k.factoryDenomAdmin(ctx, admin, amt.Denom)
+	if err != nil {
+		return err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	coins := sdk.NewCoins(amt)
+	if err := k.mintCoinsRestrictionFn(ctx, coins); err != nil {
+		return err
+	}
+	if err := k.addCoins(ctx, recipient, coins); err != nil {
+		return err
+	}
+	k.setSupply(ctx, k.GetSupply(ctx, amt.Denom).Add(amt))
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, adminStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// ChangeFactoryDenomAdmin transfers the administration of a factory denom to newAdmin.
+// An empty newAdmin renounces the administration of the denom.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	if _, err := k.factoryDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+	if newAdmin != "" {
+		if _, err := k.addrCdc.StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	fd, err := k.FactoryDenoms.Get(ctx, denom)
+	if err != nil {
+		return err
+	}
+	fd.Admin = newAdmin
+	if err := k.FactoryDenoms.Set(ctx, denom, fd); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeDenomAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetFactoryDenomMetadata sets the metadata of the factory denom metadata.Base.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if _, err := k.factoryDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// RegisterDenomSendRestriction registers a send restriction under the given name, so
+// that admins of factory denoms can apply it to transfers of their denoms with
+// SetFactoryDenomSendRestriction. The restriction is called with the coins of the
+// denom being sent only. It must be registered when building the application.
+func (k BaseKeeper) RegisterDenomSendRestriction(name string, restriction types.SendRestrictionFn) {
+	if name == "" {
+		panic("send restriction name cannot be empty")
+	}
+	if _, ok := k.denomSendRestrictions[name]; ok {
+		panic("send restriction " + name + " is already registered")
+	}
+	k.denomSendRestrictions[name] = restriction
+}
+
+// SetFactoryDenomSendRestriction applies the send restriction registered under name to
+// the transfers of a factory denom. An empty name removes the send restriction of the denom.
+func (k BaseKeeper) SetFactoryDenomSendRestriction(ctx context.Context, admin sdk.AccAddress, denom, name string) error {
+	if _, err := k.factoryDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+	if _, ok := k.denomSendRestrictions[name]; name != "" && !ok {
+		return errorsmod.Wrapf(types.ErrUnknownRestriction, "%s", name)
+	}
+
+	fd, err := k.FactoryDenoms.Get(ctx, denom)
+	if err != nil {
+		return err
+	}
+	fd.SendRestriction = name
+	if err := k.FactoryDenoms.Set(ctx, denom, fd); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomSendRestriction,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeySendRestriction, name),
+	)
+}
+
+// factoryDenomAdmin checks that admin is the admin of the factory denom and returns its address.
+func (k BaseKeeper) factoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom string) (string, error) {
+	fd, err := k.GetFactoryDenom(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	adminStr, err := k.addrCdc.BytesToString(admin)
+	if err != nil {
+		return "", err
+	}
+	if fd.Admin == "" || fd.Admin != adminStr {
+		return "", errorsmod.Wrapf(types.ErrUnauthorizedDenom, "%s is not the admin of %s", adminStr, denom)
+	}
+	return adminStr, nil
+}
+
+// factoryDenomSendRestriction is the SendRestrictionFn applying the send restrictions
+// of the factory denoms being sent.
+func (k BaseKeeper) factoryDenomSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+		fd, err := k.FactoryDenoms.Get(ctx, coin.Denom)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if fd.SendRestriction == "" {
+			continue
+		}
+
+		restriction, ok := k.denomSendRestrictions[fd.SendRestriction]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownRestriction, "%s of denom %s", fd.SendRestriction, coin.Denom)
+		}
+		toAddr, err = restriction(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
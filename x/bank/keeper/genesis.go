@@ -57,6 +57,54 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, hold := range genState.Holds {
+		bz, err := k.addrCdc.StringToBytes(hold.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range hold.Amount {
+			err := k.BaseViewKeeper.Holds.Set(ctx, collections.Join3(sdk.AccAddress(bz), hold.Reason, coin.Denom), coin.Amount)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, fd := range genState.FactoryDenoms {
+		if err := k.FactoryDenoms.Set(ctx, fd.Denom, fd); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ScheduledTransferSeq.Set(ctx, genState.NextScheduledTransferId); err != nil {
+		return err
+	}
+	for _, st := range genState.ScheduledTransfers {
+		if err := k.setScheduledTransfer(ctx, st); err != nil {
+			return err
+		}
+	}
+
+	for _, sp := range genState.SendPolicies {
+		if err := k.SetSendPolicy(ctx, sp); err != nil {
+			return err
+		}
+	}
+
+	// holds are only valid if they are backed by the account balances.
+	for _, hold := range genState.Holds {
+		bz, err := k.addrCdc.StringToBytes(hold.Address)
+		if err != nil {
+			return err
+		}
+
+		balances := k.GetAllBalances(ctx, bz)
+		if held := k.HeldCoins(ctx, bz); !balances.IsAllGTE(held) {
+			return fmt.Errorf("held coins %s of %s exceed its balance %s", held, hold.Address, balances)
+		}
+	}
 	return nil
 }
 
@@ -74,5 +122,22 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.Holds = k.GetAllHolds(ctx)
+	rv.FactoryDenoms, err = k.GetAllFactoryDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv.ScheduledTransfers, err = k.GetAllScheduledTransfers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv.NextScheduledTransferId, err = k.ScheduledTransferSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv.SendPolicies, err = k.GetAllSendPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return rv, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
@@ -124,3 +126,80 @@ func (suite *KeeperTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInitExportGenesisHolds() {
+	balances, supply := suite.getTestBalancesAndSupply()
+	holds := []types.Hold{
+		types.NewHold(balances[0].Address, "escrow", sdk.NewCoins(sdk.NewInt64Coin("testcoin1", 30), sdk.NewInt64Coin("testcoin2", 4))),
+		types.NewHold(balances[0].Address, "order", sdk.NewCoins(sdk.NewInt64Coin("testcoin1", 2))),
+	}
+
+	g := types.DefaultGenesisState()
+	g.Balances = balances
+	g.Supply = supply
+	g.Holds = holds
+	suite.Require().NoError(g.Validate())
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, g))
+
+	exported, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(holds, exported.Holds)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisHoldsExceedBalance() {
+	balances, supply := suite.getTestBalancesAndSupply()
+
+	g := types.DefaultGenesisState()
+	g.Balances = balances
+	g.Supply = supply
+	g.Holds = []types.Hold{
+		types.NewHold(balances[1].Address, "escrow", sdk.NewCoins(sdk.NewInt64Coin("testcoin3", 6))),
+		types.NewHold(balances[1].Address, "order", sdk.NewCoins(sdk.NewInt64Coin("testcoin3", 6))),
+	}
+	suite.Require().ErrorContains(suite.bankKeeper.InitGenesis(suite.ctx, g), "exceed its balance")
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesisFactoryDenoms() {
+	balances, _ := suite.getTestBalancesAndSupply()
+	factoryDenoms := []types.FactoryDenom{
+		{Denom: "factory/" + balances[0].Address + "/coin", Admin: balances[1].Address, SendRestriction: "frozen"},
+		{Denom: "factory/" + balances[1].Address + "/coin"},
+	}
+
+	g := types.DefaultGenesisState()
+	g.FactoryDenoms = factoryDenoms
+	suite.Require().NoError(g.Validate())
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, g))
+
+	exported, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(factoryDenoms, exported.FactoryDenoms)
+}
+
+func (suite *KeeperTestSuite) TestInitExportGenesisScheduledTransfers() {
+	balances, _ := suite.getTestBalancesAndSupply()
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	scheduledTransfers := []types.ScheduledTransfer{
+		types.NewScheduledTransfer(1, balances[0].Address, balances[1].Address, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), start, 0, nil),
+		types.NewScheduledTransfer(3, balances[1].Address, balances[0].Address, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)), start, time.Hour, &end),
+	}
+
+	g := types.DefaultGenesisState()
+	g.ScheduledTransfers = scheduledTransfers
+	suite.Require().Error(g.Validate())
+	g.NextScheduledTransferId = 4
+	suite.Require().NoError(g.Validate())
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, g))
+
+	exported, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(scheduledTransfers, exported.ScheduledTransfers)
+	suite.Require().Equal(uint64(4), exported.NextScheduledTransferId)
+
+	addr, err := suite.addrCdc.StringToBytes(balances[0].Address)
+	suite.Require().NoError(err)
+	id, err := suite.bankKeeper.CreateScheduledTransfer(suite.ctx, addr, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)), start, 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), id)
+}
@@ -356,3 +356,196 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// Holds implements the Query/Holds gRPC method
+func (k BaseKeeper) Holds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.addrCdc.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	resp := &types.QueryHoldsResponse{}
+	if req.Reason != "" {
+		if held := k.GetHold(ctx, addr, req.Reason); !held.Empty() {
+			resp.Holds = append(resp.Holds, types.NewHold(req.Address, req.Reason, held))
+		}
+		return resp, nil
+	}
+
+	coins, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.Holds,
+		req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, string, string], value math.Int) (types.Hold, error) {
+			return types.NewHold(req.Address, key.K2(), sdk.NewCoins(sdk.NewCoin(key.K3(), value))), nil
+		},
+		query.WithCollectionPaginationTriplePrefix[sdk.AccAddress, string, string](addr),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	// merge the denoms held for the same reason, which are adjacent.
+	for _, hold := range coins {
+		if n := len(resp.Holds); n > 0 && resp.Holds[n-1].Reason == hold.Reason {
+			resp.Holds[n-1].Amount = resp.Holds[n-1].Amount.Add(hold.Amount...)
+			continue
+		}
+		resp.Holds = append(resp.Holds, hold)
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// FactoryDenom implements the Query/FactoryDenom gRPC method
+func (k BaseKeeper) FactoryDenom(ctx context.Context, req *types.QueryFactoryDenomRequest) (*types.QueryFactoryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	fd, err := k.GetFactoryDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFactoryDenomResponse{FactoryDenom: fd}, nil
+}
+
+// FactoryDenomsByCreator implements the Query/FactoryDenomsByCreator gRPC method
+func (k BaseKeeper) FactoryDenomsByCreator(ctx context.Context, req *types.QueryFactoryDenomsByCreatorRequest) (*types.QueryFactoryDenomsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := k.addrCdc.StringToBytes(req.Creator); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	// factory denoms are keyed by name, so the denoms of a creator share the factory/{creator}/ prefix.
+	prefix := types.FactoryDenomPrefix + "/" + req.Creator + "/"
+	factoryDenoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.FactoryDenoms,
+		req.Pagination,
+		func(_ string, fd types.FactoryDenom) (types.FactoryDenom, error) {
+			return fd, nil
+		},
+		func(o *query.CollectionsPaginateOptions[string]) { o.Prefix = &prefix },
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFactoryDenomsByCreatorResponse{FactoryDenoms: factoryDenoms, Pagination: pageRes}, nil
+}
+
+// ScheduledTransfer implements the Query/ScheduledTransfer gRPC method
+func (k BaseKeeper) ScheduledTransfer(ctx context.Context, req *types.QueryScheduledTransferRequest) (*types.QueryScheduledTransferResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	st, err := k.GetScheduledTransfer(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryScheduledTransferResponse{ScheduledTransfer: st}, nil
+}
+
+// ScheduledTransfersBySender implements the Query/ScheduledTransfersBySender gRPC method
+func (k BaseKeeper) ScheduledTransfersBySender(ctx context.Context, req *types.QueryScheduledTransfersBySenderRequest) (*types.QueryScheduledTransfersBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.addrCdc.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	scheduledTransfers, pageRes, err := k.paginateScheduledTransfers(ctx, k.ScheduledTransferSenderIndex, addr, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryScheduledTransfersBySenderResponse{ScheduledTransfers: scheduledTransfers, Pagination: pageRes}, nil
+}
+
+// ScheduledTransfersByRecipient implements the Query/ScheduledTransfersByRecipient gRPC method
+func (k BaseKeeper) ScheduledTransfersByRecipient(ctx context.Context, req *types.QueryScheduledTransfersByRecipientRequest) (*types.QueryScheduledTransfersByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.addrCdc.StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	scheduledTransfers, pageRes, err := k.paginateScheduledTransfers(ctx, k.ScheduledTransferRecipientIndex, addr, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryScheduledTransfersByRecipientResponse{ScheduledTransfers: scheduledTransfers, Pagination: pageRes}, nil
+}
+
+// paginateScheduledTransfers paginates the scheduled transfers referenced by an
+// address index, in the order of their ids.
+func (k BaseKeeper) paginateScheduledTransfers(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+	addr sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]types.ScheduledTransfer, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.ScheduledTransfer, error) {
+			return k.ScheduledTransfers.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+}
+
+// SendPolicy implements the Query/SendPolicy gRPC method
+func (k BaseKeeper) SendPolicy(ctx context.Context, req *types.QuerySendPolicyRequest) (*types.QuerySendPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sp, err := k.GetSendPolicy(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySendPolicyResponse{SendPolicy: sp}, nil
+}
+
+// SendPolicies implements the Query/SendPolicies gRPC method
+func (k BaseKeeper) SendPolicies(ctx context.Context, req *types.QuerySendPoliciesRequest) (*types.QuerySendPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sendPolicies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.SendPolicies,
+		req.Pagination,
+		func(_ string, sp types.SendPolicy) (types.SendPolicy, error) {
+			return sp, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySendPoliciesResponse{SendPolicies: sendPolicies, Pagination: pageRes}, nil
+}
@@ -1043,3 +1043,122 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryHolds() {
+	ctx := suite.ctx
+	addr := accAddrs[0]
+	addrStr, err := suite.addrCdc.BytesToString(addr)
+	suite.Require().NoError(err)
+
+	_, err = suite.queryClient.Holds(ctx, &types.QueryHoldsRequest{})
+	suite.Require().Error(err)
+
+	suite.mockFundAccount(addr)
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, addr, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	suite.Require().NoError(suite.bankKeeper.PlaceHold(ctx, addr, "escrow", sdk.NewCoins(newFooCoin(10), newBarCoin(20))))
+	suite.Require().NoError(suite.bankKeeper.PlaceHold(ctx, addr, "order", sdk.NewCoins(newFooCoin(30))))
+
+	res, err := suite.queryClient.Holds(ctx, &types.QueryHoldsRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Hold{
+		types.NewHold(addrStr, "escrow", sdk.NewCoins(newFooCoin(10), newBarCoin(20))),
+		types.NewHold(addrStr, "order", sdk.NewCoins(newFooCoin(30))),
+	}, res.Holds)
+
+	res, err = suite.queryClient.Holds(ctx, &types.QueryHoldsRequest{Address: addrStr, Reason: "order"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Hold{types.NewHold(addrStr, "order", sdk.NewCoins(newFooCoin(30)))}, res.Holds)
+
+	res, err = suite.queryClient.Holds(ctx, &types.QueryHoldsRequest{Address: addrStr, Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Hold{types.NewHold(addrStr, "escrow", sdk.NewCoins(newBarCoin(20)))}, res.Holds)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// held coins are excluded from the spendable balances.
+	spendable, err := suite.queryClient.SpendableBalances(ctx, types.NewQuerySpendableBalancesRequest(addrStr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(30)), spendable.Balances)
+}
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	ctx := suite.ctx
+	creatorStr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	otherStr, err := suite.addrCdc.BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	var denoms []string
+	for _, subdenom := range []string{"a", "b"} {
+		denom, err := suite.bankKeeper.CreateDenom(ctx, accAddrs[0], subdenom)
+		suite.Require().NoError(err)
+		denoms = append(denoms, denom)
+	}
+	_, err = suite.bankKeeper.CreateDenom(ctx, accAddrs[1], "a")
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.FactoryDenom(ctx, &types.QueryFactoryDenomRequest{Denom: denoms[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FactoryDenom{Denom: denoms[0], Admin: creatorStr}, res.FactoryDenom)
+
+	_, err = suite.queryClient.FactoryDenom(ctx, &types.QueryFactoryDenomRequest{Denom: "factory/" + otherStr + "/b"})
+	suite.Require().Error(err)
+
+	byCreator, err := suite.queryClient.FactoryDenomsByCreator(ctx, &types.QueryFactoryDenomsByCreatorRequest{Creator: creatorStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FactoryDenom{
+		{Denom: denoms[0], Admin: creatorStr},
+		{Denom: denoms[1], Admin: creatorStr},
+	}, byCreator.FactoryDenoms)
+
+	byCreator, err = suite.queryClient.FactoryDenomsByCreator(ctx, &types.QueryFactoryDenomsByCreatorRequest{Creator: creatorStr, Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(byCreator.FactoryDenoms, 1)
+	suite.Require().NotNil(byCreator.Pagination.NextKey)
+
+	_, err = suite.queryClient.FactoryDenomsByCreator(ctx, &types.QueryFactoryDenomsByCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryScheduledTransfers() {
+	ctx := suite.ctx
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	senderStr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	recipientStr, err := suite.addrCdc.BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	var ids []uint64
+	for _, recipient := range []sdk.AccAddress{accAddrs[1], accAddrs[2], accAddrs[1]} {
+		id, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], recipient, sdk.NewCoins(newFooCoin(10)), start, time.Hour, nil)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+	_, err = suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[2], accAddrs[3], sdk.NewCoins(newFooCoin(10)), start, 0, nil)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ScheduledTransfer(ctx, &types.QueryScheduledTransferRequest{Id: ids[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewScheduledTransfer(ids[0], senderStr, recipientStr, sdk.NewCoins(newFooCoin(10)), start, time.Hour, nil), res.ScheduledTransfer)
+
+	_, err = suite.queryClient.ScheduledTransfer(ctx, &types.QueryScheduledTransferRequest{Id: 100})
+	suite.Require().Error(err)
+
+	bySender, err := suite.queryClient.ScheduledTransfersBySender(ctx, &types.QueryScheduledTransfersBySenderRequest{Sender: senderStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(bySender.ScheduledTransfers, 3)
+
+	bySender, err = suite.queryClient.ScheduledTransfersBySender(ctx, &types.QueryScheduledTransfersBySenderRequest{Sender: senderStr, Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(bySender.ScheduledTransfers, 2)
+	suite.Require().NotNil(bySender.Pagination.NextKey)
+
+	byRecipient, err := suite.queryClient.ScheduledTransfersByRecipient(ctx, &types.QueryScheduledTransfersByRecipientRequest{Recipient: recipientStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(byRecipient.ScheduledTransfers, 2)
+	suite.Require().Equal(ids[0], byRecipient.ScheduledTransfers[0].Id)
+	suite.Require().Equal(ids[2], byRecipient.ScheduledTransfers[1].Id)
+
+	_, err = suite.queryClient.ScheduledTransfersByRecipient(ctx, &types.QueryScheduledTransfersByRecipientRequest{Recipient: "invalid"})
+	suite.Require().Error(err)
+}
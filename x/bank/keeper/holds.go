@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetHold returns the coins held in an account for the given reason.
+func (k BaseViewKeeper) GetHold(ctx context.Context, addr sdk.AccAddress, reason string) sdk.Coins {
+	held := sdk.NewCoins()
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, string](addr, reason)
+	err := k.Holds.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, string], amt math.Int) (stop bool, err error) {
+		held = held.Add(sdk.NewCoin(key.K3(), amt))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return held
+}
+
+// HeldCoins returns the coins held in an account for any reason. Held coins are
+// part of the account balance but are not spendable.
+func (k BaseViewKeeper) HeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	rng := collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](addr)
+	err := k.Holds.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, string], amt math.Int) (stop bool, err error) {
+		held = held.Add(sdk.NewCoin(key.K3(), amt))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return held
+}
+
+// GetAllHolds returns all the holds from the store, one per account and reason.
+func (k BaseViewKeeper) GetAllHolds(ctx context.Context) []types.Hold {
+	holds := make([]types.Hold, 0)
+	err := k.Holds.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, string], amt math.Int) (stop bool, err error) {
+		addrStr, err := k.addrCdc.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		coin := sdk.NewCoin(key.K3(), amt)
+		// entries are ordered by address and reason, so the denoms of a hold are adjacent.
+		if n := len(holds); n > 0 && holds[n-1].Address == addrStr && holds[n-1].Reason == key.K2() {
+			holds[n-1].Amount = holds[n-1].Amount.Add(coin)
+			return false, nil
+		}
+		holds = append(holds, types.NewHold(addrStr, key.K2(), sdk.NewCoins(coin)))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return holds
+}
+
+// PlaceHold holds amt coins in an account for the given reason. Held coins remain
+// part of the account balance but can not be sent, delegated or burned until the
+// hold is released with ReleaseHold. Several holds for the same reason add up.
+// An error is returned if the account does not have enough spendable coins.
+func (k BaseKeeper) PlaceHold(ctx context.Context, addr sdk.AccAddress, reason string, amt sdk.Coins) error {
+	if err := types.ValidateHoldReason(reason); err != nil {
+		return err
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !spendable.IsAllGTE(amt) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, amt)
+	}
+
+	held := k.GetHold(ctx, addr, reason).Add(amt...)
+	for _, coin := range amt {
+		if err := k.BaseViewKeeper.Holds.Set(ctx, collections.Join3(addr, reason, coin.Denom), held.AmountOf(coin.Denom)); err != nil {
+			return err
+		}
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeHoldPlaced, addr, reason, amt)
+}
+
+// ReleaseHold releases amt coins held in an account for the given reason, making
+// them spendable again. An error is returned if less than amt coins are held for
+// the reason.
+func (k BaseKeeper) ReleaseHold(ctx context.Context, addr sdk.AccAddress, reason string, amt sdk.Coins) error {
+	if err := types.ValidateHoldReason(reason); err != nil {
+		return err
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	held := k.GetHold(ctx, addr, reason)
+	remaining, hasNeg := held.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientHold, "held amount %s for reason %s is smaller than %s", held, reason, amt)
+	}
+
+	for _, coin := range amt {
+		key := collections.Join3(addr, reason, coin.Denom)
+		amount := remaining.AmountOf(coin.Denom)
+		if !amount.IsPositive() {
+			if err := k.BaseViewKeeper.Holds.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.BaseViewKeeper.Holds.Set(ctx, key, amount); err != nil {
+			return err
+		}
+	}
+
+	return k.emitHoldEvent(ctx, types.EventTypeHoldReleased, addr, reason, amt)
+}
+
+func (k BaseKeeper) emitHoldEvent(ctx context.Context, eventType string, addr sdk.AccAddress, reason string, amt sdk.Coins) error {
+	addrStr, err := k.addrCdc.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	return k.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyHolder, addrStr),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
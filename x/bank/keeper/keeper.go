@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -50,6 +51,27 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	PlaceHold(ctx context.Context, addr sdk.AccAddress, reason string, amt sdk.Coins) error
+	ReleaseHold(ctx context.Context, addr sdk.AccAddress, reason string, amt sdk.Coins) error
+
+	CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error)
+	MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, amt sdk.Coin) error
+	ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	SetFactoryDenomSendRestriction(ctx context.Context, admin sdk.AccAddress, denom, name string) error
+	RegisterDenomSendRestriction(name string, restriction types.SendRestrictionFn)
+
+	CreateScheduledTransfer(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, start time.Time, interval time.Duration, endTime *time.Time) (uint64, error)
+	CancelScheduledTransfer(ctx context.Context, sender sdk.AccAddress, id uint64) error
+	GetScheduledTransfer(ctx context.Context, id uint64) (types.ScheduledTransfer, error)
+
+	GetSendPolicy(ctx context.Context, denom string) (types.SendPolicy, error)
+	SetSendPolicy(ctx context.Context, policy types.SendPolicy) error
+	DeleteSendPolicy(ctx context.Context, denom string) error
+
+	EndBlocker(ctx context.Context) error
+
 	types.QueryServer
 }
 
@@ -62,6 +84,9 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	mintCoinsRestrictionFn types.MintingRestrictionFn
 	addrCdc                address.Codec
+
+	// named send restrictions which admins of factory denoms can apply to their denoms.
+	denomSendRestrictions map[string]types.SendRestrictionFn
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -81,7 +106,8 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *que
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The send restrictions of factory
+// denoms are applied through the first send restriction of the keeper.
 func NewBaseKeeper(
 	env appmodule.Environment,
 	cdc codec.BinaryCodec,
@@ -94,14 +120,18 @@ func NewBaseKeeper(
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return BaseKeeper{
+	k := BaseKeeper{
 		Environment:            env,
 		BaseSendKeeper:         NewBaseSendKeeper(env, cdc, ak, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
 		addrCdc:                addrCdc,
+		denomSendRestrictions:  make(map[string]types.SendRestrictionFn),
 	}
+	k.AppendSendRestriction(k.factoryDenomSendRestriction)
+	k.AppendSendRestriction(k.sendPolicyRestriction)
+	return k
 }
 
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
@@ -117,8 +147,8 @@ func (k BaseKeeper) WithMintCoinsRestriction(check types.MintingRestrictionFn) B
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
-// address to a ModuleAccount address. If any of the delegation amounts are negative,
-// an error is returned.
+// address to a ModuleAccount address. Held coins can not be delegated. If any of
+// the delegation amounts are negative, an error is returned.
 func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
@@ -130,6 +160,7 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 	}
 
 	balances := sdk.NewCoins()
+	held := k.HeldCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
@@ -138,6 +169,11 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
 			)
 		}
+		if heldAmt := held.AmountOf(coin.Denom); balance.Amount.Sub(heldAmt).LT(coin.Amount) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s of %s is held", sdk.NewCoin(coin.Denom, heldAmt), balance,
+			)
+		}
 
 		balances = balances.Add(balance)
 		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
@@ -2478,3 +2478,245 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc0Str, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, burnerAcc.GetAddress()).Return(burnerAcc).AnyTimes()
+
+	err = suite.bankKeeper.PlaceHold(ctx, accAddrs[0], "", sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrInvalidHoldReason)
+	err = suite.bankKeeper.PlaceHold(ctx, accAddrs[0], "esc\x00row", sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrInvalidHoldReason)
+	err = suite.bankKeeper.PlaceHold(ctx, accAddrs[0], strings.Repeat("a", 129), sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrInvalidHoldReason)
+
+	require.NoError(suite.bankKeeper.PlaceHold(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(60))))
+	require.Equal(sdk.NewCoins(newFooCoin(60)), suite.bankKeeper.GetHold(ctx, accAddrs[0], "escrow"))
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(50)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(40), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], fooDenom))
+
+	// only spendable coins can be held.
+	err = suite.bankKeeper.PlaceHold(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(50)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.PlaceHold(ctx, accAddrs[0], "order", sdk.NewCoins(newBarCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(20)), suite.bankKeeper.HeldCoins(ctx, accAddrs[0]))
+
+	// held coins can neither be sent nor delegated.
+	require.Error(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(40))))
+	err = suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], burnerAcc.GetAddress(), sdk.NewCoins(newBarCoin(31)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], burnerAcc.GetAddress(), sdk.NewCoins(newBarCoin(30))))
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]).IsZero())
+
+	err = suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(70)))
+	require.ErrorIs(err, banktypes.ErrInsufficientHold)
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(40)), suite.bankKeeper.GetHold(ctx, accAddrs[0], "escrow"))
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "escrow", sdk.NewCoins(newFooCoin(40))))
+	require.True(suite.bankKeeper.GetHold(ctx, accAddrs[0], "escrow").IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(60)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	require.Equal([]banktypes.Hold{banktypes.NewHold(acc0Str, "order", sdk.NewCoins(newBarCoin(20)))}, suite.bankKeeper.GetAllHolds(ctx))
+}
+
+func (suite *KeeperTestSuite) TestScheduledTransfers() {
+	require := suite.Require()
+	now := suite.bankKeeper.HeaderService.HeaderInfo(suite.ctx).Time.Round(0).UTC()
+	ctx := suite.ctx
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	params := suite.bankKeeper.GetParams(ctx)
+	params.MaxScheduledTransfersPerBlock = 2
+	params.MaxScheduledTransfersPerSender = 3
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	_, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), now.Add(-time.Hour), 0, nil)
+	require.ErrorIs(err, banktypes.ErrInvalidScheduledTransfer)
+	_, err = suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[4], sdk.NewCoins(newFooCoin(10)), now, 0, nil)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	endTime := now.Add(3 * time.Hour)
+	oneOff, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), now.Add(time.Hour), 0, nil)
+	require.NoError(err)
+	recurring, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(20)), now.Add(time.Hour), time.Hour, &endTime)
+	require.NoError(err)
+	capped, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(5)), now.Add(time.Hour), 0, nil)
+	require.NoError(err)
+	require.Equal([]uint64{0, 1, 2}, []uint64{oneOff, recurring, capped})
+
+	// a sender can not have more than MaxScheduledTransfersPerSender pending transfers.
+	_, err = suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(5)), now.Add(time.Hour), 0, nil)
+	require.ErrorIs(err, banktypes.ErrTooManyScheduledTransfers)
+
+	// nothing is due yet.
+	require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	require.Equal(newFooCoin(100), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+
+	// only two transfers are executed per block.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	require.Equal(newFooCoin(20), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom))
+	_, err = suite.bankKeeper.GetScheduledTransfer(ctx, oneOff)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+	st, err := suite.bankKeeper.GetScheduledTransfer(ctx, recurring)
+	require.NoError(err)
+	require.Equal(now.Add(2*time.Hour), st.NextExecution)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour + time.Second)})
+	require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	require.Equal(newFooCoin(15), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	_, err = suite.bankKeeper.GetScheduledTransfer(ctx, capped)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+
+	// the recurring transfer stops after its end time.
+	for _, d := range []time.Duration{2 * time.Hour, 3 * time.Hour} {
+		ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(d)})
+		require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	}
+	require.Equal(newFooCoin(60), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom))
+	_, err = suite.bankKeeper.GetScheduledTransfer(ctx, recurring)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+
+	// a failed execution is skipped.
+	now = now.Add(3 * time.Hour)
+	failing, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1000)), now, time.Hour, nil)
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	require.Equal(newFooCoin(25), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	st, err = suite.bankKeeper.GetScheduledTransfer(ctx, failing)
+	require.NoError(err)
+	require.Equal(now.Add(time.Hour), st.NextExecution)
+
+	// only the sender can cancel a scheduled transfer.
+	err = suite.bankKeeper.CancelScheduledTransfer(ctx, accAddrs[1], failing)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	require.NoError(suite.bankKeeper.CancelScheduledTransfer(ctx, accAddrs[0], failing))
+	err = suite.bankKeeper.CancelScheduledTransfer(ctx, accAddrs[0], failing)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+}
+
+func (suite *KeeperTestSuite) TestScheduledTransfersUnsetParams() {
+	require := suite.Require()
+	now := suite.bankKeeper.HeaderService.HeaderInfo(suite.ctx).Time.Round(0).UTC()
+	ctx := suite.ctx
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	// params stored before the scheduled transfer params were added
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.Params{DefaultSendEnabled: true}))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	id, err := suite.bankKeeper.CreateScheduledTransfer(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), now, 0, nil)
+	require.NoError(err)
+
+	// the default maximum applies and the due transfer is executed
+	require.NoError(suite.bankKeeper.EndBlocker(ctx))
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	_, err = suite.bankKeeper.GetScheduledTransfer(ctx, id)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSendPolicies() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc0Str, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1Str, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2Str, err := suite.addrCdc.BytesToString(accAddrs[2])
+	require.NoError(err)
+	acc3Str, err := suite.addrCdc.BytesToString(accAddrs[3])
+	require.NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(1000), newBarCoin(1000))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	for _, addr := range accAddrs[1:] {
+		suite.authKeeper.EXPECT().GetAccount(ctx, addr).Return(nil).AnyTimes()
+	}
+
+	require.NoError(suite.bankKeeper.SetSendPolicy(ctx, banktypes.SendPolicy{
+		Denom:        fooDenom,
+		Allowlist:    []string{acc0Str, acc1Str, acc3Str},
+		Denylist:     []string{acc3Str},
+		TaxRate:      math.LegacyNewDecWithPrec(1, 1),
+		TaxRecipient: acc2Str,
+		MaxAmount:    math.NewInt(500),
+	}))
+
+	// the recipient must be allowlisted and not denylisted.
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[4], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(501)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+
+	// the sender pays the tax on top of the transfer, other denoms are not affected.
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	require.Equal(sdk.NewCoins(newFooCoin(890), newBarCoin(900)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// the sender must be able to pay for the tax.
+	require.NoError(suite.bankKeeper.SetSendPolicy(ctx, banktypes.SendPolicy{Denom: fooDenom, TaxRate: math.LegacyNewDecWithPrec(1, 1), TaxRecipient: acc2Str}))
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(850)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	require.NoError(suite.bankKeeper.DeleteSendPolicy(ctx, fooDenom))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[4], sdk.NewCoins(newFooCoin(850))))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// transfers to module accounts are only restricted by the sender and the max amount,
+	// and are not taxed.
+	require.NoError(suite.bankKeeper.SetSendPolicy(ctx, banktypes.SendPolicy{
+		Denom:        fooDenom,
+		Allowlist:    []string{acc0Str},
+		TaxRate:      math.LegacyNewDecWithPrec(1, 1),
+		TaxRecipient: acc2Str,
+		MaxAmount:    math.NewInt(20),
+	}))
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, holder).Return(holderAcc).Times(2)
+	err = suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holder, sdk.NewCoins(newFooCoin(21)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], holder, sdk.NewCoins(newFooCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), sdk.NewCoins(suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom)))
+
+	// transfers from module accounts are neither restricted nor taxed.
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress())
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, holder, accAddrs[3], sdk.NewCoins(newFooCoin(20))))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// a restricted sender can not move the denom into a module account.
+	require.NoError(suite.bankKeeper.SetSendPolicy(ctx, banktypes.SendPolicy{Denom: fooDenom, Denylist: []string{acc3Str}}))
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, holder).Return(holderAcc)
+	err = suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[3], holder, sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+
+	// transfers between user accounts remain restricted.
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[3], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	require.ErrorIs(err, banktypes.ErrSendPolicyViolation)
+}
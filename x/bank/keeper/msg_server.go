@@ -197,3 +197,184 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient := sender
+	if msg.MintToAddress != "" {
+		recipient, err = base.addrCdc.StringToBytes(msg.MintToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	if err := k.MintFactoryDenom(ctx, sender, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.ChangeFactoryDenomAdmin(ctx, sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomMetadata(ctx, sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) SetDenomSendRestriction(ctx context.Context, msg *types.MsgSetDenomSendRestriction) (*types.MsgSetDenomSendRestrictionResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomSendRestriction(ctx, sender, msg.Denom, msg.SendRestriction); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomSendRestrictionResponse{}, nil
+}
+
+func (k msgServer) CreateScheduledTransfer(ctx context.Context, msg *types.MsgCreateScheduledTransfer) (*types.MsgCreateScheduledTransferResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	recipient, err := base.addrCdc.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	id, err := k.Keeper.CreateScheduledTransfer(ctx, sender, recipient, msg.Amount, msg.StartTime, msg.Interval, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateScheduledTransferResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelScheduledTransfer(ctx context.Context, msg *types.MsgCancelScheduledTransfer) (*types.MsgCancelScheduledTransferResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.CancelScheduledTransfer(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduledTransferResponse{}, nil
+}
+
+func (k msgServer) SetSendPolicies(ctx context.Context, msg *types.MsgSetSendPolicies) (*types.MsgSetSendPoliciesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, sp := range msg.SendPolicies {
+		if _, alreadySeen := seen[sp.Denom]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", sp.Denom)
+		}
+
+		seen[sp.Denom] = true
+
+		if err := sp.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, denom := range msg.RemoveFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid RemoveFor denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %q is both set and removed", denom)
+		}
+	}
+
+	for _, sp := range msg.SendPolicies {
+		if err := k.SetSendPolicy(ctx, sp); err != nil {
+			return nil, err
+		}
+	}
+	for _, denom := range msg.RemoveFor {
+		if err := k.DeleteSendPolicy(ctx, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetSendPoliciesResponse{}, nil
+}
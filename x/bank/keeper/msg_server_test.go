@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -436,3 +443,178 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgFactoryDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, other := accAddrs[0], accAddrs[1]
+	creatorStr, err := suite.addrCdc.BytesToString(creator)
+	require.NoError(err)
+	otherStr, err := suite.addrCdc.BytesToString(other)
+	require.NoError(err)
+
+	poolAcc := authtypes.NewEmptyModuleAccount(banktypes.ProtocolPoolModuleName)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = fee
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	suite.mockFundAccount(creator)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, creator, fee))
+	suite.authKeeper.EXPECT().GetAccount(ctx, creator).Return(authtypes.NewBaseAccountWithAddress(creator)).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, banktypes.ProtocolPoolModuleName).Return(poolAcc).AnyTimes()
+
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorStr, "coin"))
+	require.NoError(err)
+	denom := "factory/" + creatorStr + "/coin"
+	require.Equal(denom, res.NewTokenDenom)
+	require.Equal(fee, suite.bankKeeper.GetAllBalances(ctx, poolAcc.GetAddress()))
+
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorStr, "coin"))
+	require.ErrorIs(err, banktypes.ErrDenomExists)
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorStr, "sub/coin"))
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+	// the creation fee can no longer be paid.
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorStr, "other"))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// only the admin can mint.
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(otherStr, sdk.NewInt64Coin(denom, 100), ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenom)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(creatorStr, sdk.NewInt64Coin(denom, 100), otherStr))
+	require.NoError(err)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(creatorStr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), ""))
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, other, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetSupply(ctx, denom))
+
+	metadata := banktypes.Metadata{
+		Name:       "Coin",
+		Symbol:     "COIN",
+		Base:       denom,
+		Display:    denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+	}
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(otherStr, metadata))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenom)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(creatorStr, metadata))
+	require.NoError(err)
+	got, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(metadata, got)
+
+	// the admin hands the denom over, which can then be renounced.
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(creatorStr, denom, otherStr))
+	require.NoError(err)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(creatorStr, sdk.NewInt64Coin(denom, 1), ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenom)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(otherStr, sdk.NewInt64Coin(denom, 1), ""))
+	require.NoError(err)
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(otherStr, denom, ""))
+	require.NoError(err)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(otherStr, sdk.NewInt64Coin(denom, 1), ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenom)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetDenomSendRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator := accAddrs[0]
+	creatorStr, err := suite.addrCdc.BytesToString(creator)
+	require.NoError(err)
+
+	suite.bankKeeper.RegisterDenomSendRestriction("frozen", func(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		return nil, fmt.Errorf("%s is frozen", amt)
+	})
+	suite.bankKeeper.RegisterDenomSendRestriction("redirect", func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return accAddrs[2], nil
+	})
+
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(creatorStr, "coin"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(creatorStr, sdk.NewInt64Coin(denom, 100), ""))
+	require.NoError(err)
+	suite.authKeeper.EXPECT().GetAccount(ctx, creator).Return(authtypes.NewBaseAccountWithAddress(creator)).AnyTimes()
+
+	_, err = suite.msgServer.SetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(creatorStr, denom, "unknown"))
+	require.ErrorIs(err, banktypes.ErrUnknownRestriction)
+
+	_, err = suite.msgServer.SetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(creatorStr, denom, "frozen"))
+	require.NoError(err)
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, creator, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))), "is frozen")
+
+	_, err = suite.msgServer.SetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(creatorStr, denom, "redirect"))
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, creator, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	require.Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(ctx, accAddrs[2], denom))
+
+	_, err = suite.msgServer.SetDenomSendRestriction(ctx, banktypes.NewMsgSetDenomSendRestriction(creatorStr, denom, ""))
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, creator, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	require.Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], denom))
+}
+
+func (suite *KeeperTestSuite) TestMsgScheduledTransfer() {
+	ctx := suite.ctx
+	require := suite.Require()
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	senderStr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	recipientStr, err := suite.addrCdc.BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = suite.msgServer.CreateScheduledTransfer(ctx, banktypes.NewMsgCreateScheduledTransfer(senderStr, recipientStr, sdk.NewCoins(), start, 0, nil))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = suite.msgServer.CreateScheduledTransfer(ctx, banktypes.NewMsgCreateScheduledTransfer(senderStr, recipientStr, sdk.NewCoins(newFooCoin(10)), start, 0, &start))
+	require.ErrorIs(err, banktypes.ErrInvalidScheduledTransfer)
+	_, err = suite.msgServer.CreateScheduledTransfer(ctx, banktypes.NewMsgCreateScheduledTransfer(senderStr, "invalid", sdk.NewCoins(newFooCoin(10)), start, 0, nil))
+	require.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	res, err := suite.msgServer.CreateScheduledTransfer(ctx, banktypes.NewMsgCreateScheduledTransfer(senderStr, recipientStr, sdk.NewCoins(newFooCoin(10)), start, time.Hour, nil))
+	require.NoError(err)
+
+	_, err = suite.msgServer.CancelScheduledTransfer(ctx, banktypes.NewMsgCancelScheduledTransfer(recipientStr, res.Id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelScheduledTransfer(ctx, banktypes.NewMsgCancelScheduledTransfer(senderStr, res.Id))
+	require.NoError(err)
+	_, err = suite.bankKeeper.GetScheduledTransfer(ctx, res.Id)
+	require.ErrorIs(err, banktypes.ErrScheduledTransferNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetSendPolicies() {
+	ctx := suite.ctx
+	require := suite.Require()
+	authority := suite.bankKeeper.GetAuthority()
+	addrStr, err := suite.addrCdc.BytesToString(accAddrs[0])
+	require.NoError(err)
+	fooPolicy := banktypes.SendPolicy{Denom: fooDenom, Denylist: []string{addrStr}}
+	barPolicy := banktypes.SendPolicy{Denom: barDenom, MaxAmount: math.NewInt(10)}
+
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(addrStr, []banktypes.SendPolicy{fooPolicy}, nil))
+	require.ErrorIs(err, banktypes.ErrInvalidSigner)
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{fooPolicy, fooPolicy}, nil))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{{Denom: fooDenom, Allowlist: []string{"invalid"}}}, nil))
+	require.ErrorIs(err, banktypes.ErrInvalidSendPolicy)
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{fooPolicy}, []string{fooDenom}))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(authority, []banktypes.SendPolicy{fooPolicy, barPolicy}, nil))
+	require.NoError(err)
+
+	res, err := suite.queryClient.SendPolicy(ctx, &banktypes.QuerySendPolicyRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(fooPolicy.Denylist, res.SendPolicy.Denylist)
+
+	_, err = suite.msgServer.SetSendPolicies(ctx, banktypes.NewMsgSetSendPolicies(authority, nil, []string{fooDenom}))
+	require.NoError(err)
+	_, err = suite.queryClient.SendPolicy(ctx, &banktypes.QuerySendPolicyRequest{Denom: fooDenom})
+	require.Error(err)
+
+	all, err := suite.queryClient.SendPolicies(ctx, &banktypes.QuerySendPoliciesRequest{})
+	require.NoError(err)
+	require.Len(all.SendPolicies, 1)
+	require.Equal(barDenom, all.SendPolicies[0].Denom)
+	require.True(barPolicy.MaxAmount.Equal(all.SendPolicies[0].MaxAmount))
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateScheduledTransfer schedules a transfer of amount coins from the sender to the
+// recipient, first executed at start and then every interval until endTime, if any.
+// A zero interval schedules a one-off transfer. The coins are not reserved: an execution
+// fails if the sender can not pay for it when it is due. It returns the id of the
+// scheduled transfer.
+func (k BaseKeeper) CreateScheduledTransfer(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, start time.Time, interval time.Duration, endTime *time.Time) (uint64, error) {
+	if err := types.ValidateScheduledTransfer(amount, start, interval, endTime); err != nil {
+		return 0, err
+	}
+	if now := k.HeaderService.HeaderInfo(ctx).Time; start.Before(now) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidScheduledTransfer, "start time %s is before block time %s", start, now)
+	}
+	if k.BlockedAddr(recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	// the number of pending transfers of a sender is capped so that a single
+	// account can not flood the execution queue.
+	maxTransfers := k.GetParams(ctx).MaxScheduledTransfersPerSenderOrDefault()
+	count, err := k.countScheduledTransfers(ctx, sender, maxTransfers)
+	if err != nil {
+		return 0, err
+	}
+	if count >= maxTransfers {
+		return 0, errorsmod.Wrapf(types.ErrTooManyScheduledTransfers, "sender already has %d scheduled transfers, the maximum is %d", count, maxTransfers)
+	}
+
+	senderStr, err := k.addrCdc.BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+	recipientStr, err := k.addrCdc.BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.ScheduledTransferSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	st := types.NewScheduledTransfer(id, senderStr, recipientStr, amount, start, interval, endTime)
+	if err := k.setScheduledTransfer(ctx, st); err != nil {
+		return 0, err
+	}
+
+	return id, k.emitScheduledTransferEvent(ctx, types.EventTypeCreateScheduledTransfer, st)
+}
+
+// CancelScheduledTransfer removes a scheduled transfer, the sender must be the sender
+// of the transfer.
+func (k BaseKeeper) CancelScheduledTransfer(ctx context.Context, sender sdk.AccAddress, id uint64) error {
+	st, err := k.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	senderStr, err := k.addrCdc.BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	if st.Sender != senderStr {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of scheduled transfer %d", senderStr, id)
+	}
+
+	if err := k.removeScheduledTransfer(ctx, st); err != nil {
+		return err
+	}
+
+	return k.emitScheduledTransferEvent(ctx, types.EventTypeCancelScheduledTransfer, st)
+}
+
+// countScheduledTransfers returns the number of pending scheduled transfers of the
+// sender, counting at most limit transfers.
+func (k BaseKeeper) countScheduledTransfers(ctx context.Context, sender sdk.AccAddress, limit uint32) (uint32, error) {
+	var count uint32
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](sender)
+	err := k.ScheduledTransferSenderIndex.Walk(ctx, rng, func(collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+		count++
+		return count >= limit, nil
+	})
+	return count, err
+}
+
+// GetScheduledTransfer returns the scheduled transfer with the given id.
+func (k BaseKeeper) GetScheduledTransfer(ctx context.Context, id uint64) (types.ScheduledTransfer, error) {
+	st, err := k.ScheduledTransfers.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ScheduledTransfer{}, errorsmod.Wrapf(types.ErrScheduledTransferNotFound, "id %d", id)
+	}
+	return st, err
+}
+
+// GetAllScheduledTransfers returns all the pending scheduled transfers.
+func (k BaseKeeper) GetAllScheduledTransfers(ctx context.Context) ([]types.ScheduledTransfer, error) {
+	iter, err := k.ScheduledTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// executeScheduledTransfer sends the coins of a due scheduled transfer, and then either
+// reschedules it or removes it if it was its last execution. A failed send does not
+// stop the transfer, the execution is skipped and a failure event is emitted.
+func (k BaseKeeper) executeScheduledTransfer(ctx context.Context, st types.ScheduledTransfer, now time.Time) error {
+	sender, err := k.addrCdc.StringToBytes(st.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addrCdc.StringToBytes(st.Recipient)
+	if err != nil {
+		return err
+	}
+
+	// the send is branched so that a failure leaves no partial state changes.
+	sendErr := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		if err := k.IsSendEnabledCoins(ctx, st.Amount...); err != nil {
+			return err
+		}
+		return k.SendCoins(ctx, sender, recipient, st.Amount)
+	})
+	if sendErr != nil {
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeScheduledTransferFailed,
+			event.NewAttribute(types.AttributeKeyScheduledTransferID, strconv.FormatUint(st.Id, 10)),
+			event.NewAttribute(types.AttributeKeyError, sendErr.Error()),
+		); err != nil {
+			return err
+		}
+	} else if err := k.emitScheduledTransferEvent(ctx, types.EventTypeExecuteScheduledTransfer, st); err != nil {
+		return err
+	}
+
+	if err := k.removeScheduledTransfer(ctx, st); err != nil {
+		return err
+	}
+	if !st.IsRecurring() {
+		return nil
+	}
+
+	// executions missed while the queue was backlogged are skipped, the transfer
+	// is executed at most once per block.
+	next := st.NextExecution.Add(st.Interval)
+	if !next.After(now) {
+		missed := now.Sub(next)/st.Interval + 1
+		next = next.Add(missed * st.Interval)
+	}
+	if st.EndTime != nil && next.After(*st.EndTime) {
+		return k.emitScheduledTransferEvent(ctx, types.EventTypeScheduledTransferComplete, st)
+	}
+
+	st.NextExecution = next
+	return k.setScheduledTransfer(ctx, st)
+}
+
+// setScheduledTransfer stores a scheduled transfer along with its queue and index entries.
+func (k BaseKeeper) setScheduledTransfer(ctx context.Context, st types.ScheduledTransfer) error {
+	sender, err := k.addrCdc.StringToBytes(st.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addrCdc.StringToBytes(st.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledTransfers.Set(ctx, st.Id, st); err != nil {
+		return err
+	}
+	if err := k.ScheduledTransferQueue.Set(ctx, collections.Join(st.NextExecution, st.Id)); err != nil {
+		return err
+	}
+	if err := k.ScheduledTransferSenderIndex.Set(ctx, collections.Join(sdk.AccAddress(sender), st.Id)); err != nil {
+		return err
+	}
+	return k.ScheduledTransferRecipientIndex.Set(ctx, collections.Join(sdk.AccAddress(recipient), st.Id))
+}
+
+// removeScheduledTransfer deletes a scheduled transfer along with its queue and index entries.
+func (k BaseKeeper) removeScheduledTransfer(ctx context.Context, st types.ScheduledTransfer) error {
+	sender, err := k.addrCdc.StringToBytes(st.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.addrCdc.StringToBytes(st.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledTransfers.Remove(ctx, st.Id); err != nil {
+		return err
+	}
+	if err := k.ScheduledTransferQueue.Remove(ctx, collections.Join(st.NextExecution, st.Id)); err != nil {
+		return err
+	}
+	if err := k.ScheduledTransferSenderIndex.Remove(ctx, collections.Join(sdk.AccAddress(sender), st.Id)); err != nil {
+		return err
+	}
+	return k.ScheduledTransferRecipientIndex.Remove(ctx, collections.Join(sdk.AccAddress(recipient), st.Id))
+}
+
+func (k BaseKeeper) emitScheduledTransferEvent(ctx context.Context, eventType string, st types.ScheduledTransfer) error {
+	return k.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyScheduledTransferID, strconv.FormatUint(st.Id, 10)),
+		event.NewAttribute(types.AttributeKeySender, st.Sender),
+		event.NewAttribute(types.AttributeKeyRecipient, st.Recipient),
+		event.NewAttribute(sdk.AttributeKeyAmount, st.Amount.String()),
+	)
+}
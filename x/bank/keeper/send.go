@@ -128,7 +128,11 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
+		fee, maxScheduledTransfers, maxSenderTransfers := params.DenomCreationFee, params.MaxScheduledTransfersPerBlock, params.MaxScheduledTransfersPerSender
 		params = types.NewParams(params.DefaultSendEnabled)
+		params.DenomCreationFee = fee
+		params.MaxScheduledTransfersPerBlock = maxScheduledTransfers
+		params.MaxScheduledTransfersPerSender = maxSenderTransfers
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSendPolicy returns the send policy of a denom.
+func (k BaseKeeper) GetSendPolicy(ctx context.Context, denom string) (types.SendPolicy, error) {
+	sp, err := k.BaseViewKeeper.SendPolicies.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SendPolicy{}, errorsmod.Wrapf(types.ErrInvalidSendPolicy, "denom %s has no send policy", denom)
+	}
+	return sp, err
+}
+
+// SetSendPolicy sets the send policy of a denom, replacing its current policy if any.
+func (k BaseKeeper) SetSendPolicy(ctx context.Context, policy types.SendPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return k.BaseViewKeeper.SendPolicies.Set(ctx, policy.Denom, policy)
+}
+
+// DeleteSendPolicy removes the send policy of a denom.
+func (k BaseKeeper) DeleteSendPolicy(ctx context.Context, denom string) error {
+	return k.BaseViewKeeper.SendPolicies.Remove(ctx, denom)
+}
+
+// GetAllSendPolicies returns the send policies of all the denoms.
+func (k BaseKeeper) GetAllSendPolicies(ctx context.Context) ([]types.SendPolicy, error) {
+	iter, err := k.BaseViewKeeper.SendPolicies.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// sendPolicyRestriction is the send restriction enforcing the send policies of the
+// transferred denoms. The transfer tax is charged to the sender on top of the transferred
+// amount, it is not charged on transfers from or to the tax recipient.
+//
+// Transfers from module accounts are exempt from the send policies, so that rewards,
+// unbondings or refunds are neither blocked nor taxed. Transfers to module accounts are
+// only checked against the sender restrictions and the max amount, so that restricted
+// holders can not move a denom through a module while fee deduction, bonding or deposits
+// of allowed holders are not taxed.
+func (k BaseKeeper) sendPolicyRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	checkedAccounts, toModule := false, false
+	for _, coin := range amt {
+		sp, err := k.BaseViewKeeper.SendPolicies.Get(ctx, coin.Denom)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		// the accounts are only looked up when a policy applies to the transfer.
+		if !checkedAccounts {
+			if k.isModuleAccount(ctx, fromAddr) {
+				return toAddr, nil
+			}
+			toModule = k.isModuleAccount(ctx, toAddr)
+			checkedAccounts = true
+		}
+
+		fromStr, err := k.addrCdc.BytesToString(fromAddr)
+		if err != nil {
+			return nil, err
+		}
+		toStr, err := k.addrCdc.BytesToString(toAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sp.IsAllowed(fromStr) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s is not allowed to send %s", fromStr, coin.Denom)
+		}
+		if !toModule && !sp.IsAllowed(toStr) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s is not allowed to receive %s", toStr, coin.Denom)
+		}
+		if sp.ExceedsMaxAmount(coin.Amount) {
+			return nil, errorsmod.Wrapf(types.ErrSendPolicyViolation, "%s exceeds the max transfer amount %s%s", coin, sp.MaxAmount, coin.Denom)
+		}
+		if toModule {
+			continue
+		}
+
+		tax := sp.Tax(coin.Amount)
+		if !tax.IsPositive() || fromStr == sp.TaxRecipient || toStr == sp.TaxRecipient {
+			continue
+		}
+		if err := k.chargeTransferTax(ctx, fromAddr, fromStr, sp.TaxRecipient, sdk.NewCoin(coin.Denom, tax), coin); err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
+
+// isModuleAccount returns whether the address is the address of a module account.
+func (k BaseKeeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// chargeTransferTax sends the tax of a transfer from the sender to the tax recipient. The
+// sender must be able to pay for both the transfer and its tax.
+func (k BaseKeeper) chargeTransferTax(ctx context.Context, fromAddr sdk.AccAddress, fromStr, taxRecipientStr string, tax, transferred sdk.Coin) error {
+	if spendable := k.SpendableCoin(ctx, fromAddr, tax.Denom); spendable.Amount.LT(transferred.Amount.Add(tax.Amount)) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s plus the transfer tax %s", spendable, transferred, tax)
+	}
+
+	taxRecipient, err := k.addrCdc.StringToBytes(taxRecipientStr)
+	if err != nil {
+		return err
+	}
+	if err := k.subUnlockedCoins(ctx, fromAddr, sdk.NewCoins(tax)); err != nil {
+		return err
+	}
+	if err := k.addCoins(ctx, taxRecipient, sdk.NewCoins(tax)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferTax,
+		event.NewAttribute(types.AttributeKeyRecipient, taxRecipientStr),
+		event.NewAttribute(types.AttributeKeySender, fromStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, tax.String()),
+	)
+}
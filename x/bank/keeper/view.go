@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -32,6 +33,10 @@ type ViewKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
+	GetHold(ctx context.Context, addr sdk.AccAddress, reason string) sdk.Coins
+	GetAllHolds(ctx context.Context) []types.Hold
+	HeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 }
@@ -68,6 +73,16 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	Holds         collections.Map[collections.Triple[sdk.AccAddress, string, string], math.Int]
+	FactoryDenoms collections.Map[string, types.FactoryDenom]
+
+	ScheduledTransferSeq            collections.Sequence
+	ScheduledTransfers              collections.Map[uint64, types.ScheduledTransfer]
+	ScheduledTransferQueue          collections.KeySet[collections.Pair[time.Time, uint64]]
+	ScheduledTransferSenderIndex    collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	ScheduledTransferRecipientIndex collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+
+	SendPolicies collections.Map[string, types.SendPolicy]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -83,6 +98,16 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey.WithName("denom"), codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.NamedPairKeyCodec("address", sdk.AccAddressKey, "denom", collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Holds:         collections.NewMap(sb, types.HoldsPrefix, "holds", collections.NamedTripleKeyCodec("address", sdk.AccAddressKey, "reason", collections.StringKey, "denom", collections.StringKey), sdk.IntValue),
+		FactoryDenoms: collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denoms", collections.StringKey.WithName("denom"), codec.CollValue[types.FactoryDenom](cdc)),
+
+		ScheduledTransferSeq:            collections.NewSequence(sb, types.ScheduledTransferSeqKey, "scheduled_transfer_seq"),
+		ScheduledTransfers:              collections.NewMap(sb, types.ScheduledTransfersPrefix, "scheduled_transfers", collections.Uint64Key.WithName("id"), codec.CollValue[types.ScheduledTransfer](cdc)),
+		ScheduledTransferQueue:          collections.NewKeySet(sb, types.ScheduledTransferQueuePrefix, "scheduled_transfer_queue", collections.NamedPairKeyCodec("next_execution", sdk.TimeKey, "id", collections.Uint64Key)),
+		ScheduledTransferSenderIndex:    collections.NewKeySet(sb, types.ScheduledTransfersBySenderPrefix, "scheduled_transfers_by_sender", collections.NamedPairKeyCodec("sender", sdk.AccAddressKey, "id", collections.Uint64Key)),
+		ScheduledTransferRecipientIndex: collections.NewKeySet(sb, types.ScheduledTransfersByRecipientPrefix, "scheduled_transfers_by_recipient", collections.NamedPairKeyCodec("recipient", sdk.AccAddressKey, "id", collections.Uint64Key)),
+
+		SendPolicies: collections.NewMap(sb, types.SendPoliciesPrefix, "send_policies", collections.StringKey.WithName("denom"), codec.CollValue[types.SendPolicy](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -174,19 +199,19 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address. These are the coins held by the account, plus, for vesting
+// accounts, the locked coins of the concrete vesting account type.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := k.HeldCoins(ctx, addr)
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(k.HeaderService.HeaderInfo(ctx).Time)
+			return vacc.LockedCoins(k.HeaderService.HeaderInfo(ctx).Time).Add(held...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return held
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -15,6 +15,14 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom")
+	legacy.RegisterAminoMsg(registrar, &MsgMint{}, "cosmos-sdk/MsgMint")
+	legacy.RegisterAminoMsg(registrar, &MsgChangeDenomAdmin{}, "cosmos-sdk/MsgChangeDenomAdmin")
+	legacy.RegisterAminoMsg(registrar, &MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(registrar, &MsgSetDenomSendRestriction{}, "cosmos-sdk/MsgSetDenomSendRestriction")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateScheduledTransfer{}, "cosmos-sdk/MsgCreateScheduledTransfer")
+	legacy.RegisterAminoMsg(registrar, &MsgCancelScheduledTransfer{}, "cosmos-sdk/MsgCancelScheduledTransfer")
+	legacy.RegisterAminoMsg(registrar, &MsgSetSendPolicies{}, "cosmos-sdk/MsgSetSendPolicies")
 
 	registrar.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	registrar.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -27,6 +35,14 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgChangeDenomAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgSetDenomSendRestriction{},
+		&MsgCreateScheduledTransfer{},
+		&MsgCancelScheduledTransfer{},
+		&MsgSetSendPolicies{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -4,13 +4,25 @@ import "cosmossdk.io/errors"
 
 // x/bank module sentinel errors
 var (
-	ErrNoInputs              = errors.Register(ModuleName, 2, "no inputs to send transaction")
-	ErrNoOutputs             = errors.Register(ModuleName, 3, "no outputs to send transaction")
-	ErrInputOutputMismatch   = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
-	ErrSendDisabled          = errors.Register(ModuleName, 5, "send transactions are disabled")
-	ErrDenomMetadataNotFound = errors.Register(ModuleName, 6, "client denom metadata not found")
-	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
-	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
-	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
-	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrNoInputs                  = errors.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs                 = errors.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch       = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled              = errors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrDenomMetadataNotFound     = errors.Register(ModuleName, 6, "client denom metadata not found")
+	ErrInvalidKey                = errors.Register(ModuleName, 7, "invalid key")
+	ErrDuplicateEntry            = errors.Register(ModuleName, 8, "duplicate entry")
+	ErrMultipleSenders           = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidSigner             = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrInvalidHold               = errors.Register(ModuleName, 11, "invalid hold")
+	ErrInsufficientHold          = errors.Register(ModuleName, 12, "insufficient held coins")
+	ErrInvalidFactoryDenom       = errors.Register(ModuleName, 13, "invalid factory denom")
+	ErrDenomExists               = errors.Register(ModuleName, 14, "denom already exists")
+	ErrUnauthorizedDenom         = errors.Register(ModuleName, 15, "unauthorized denom admin")
+	ErrUnknownRestriction        = errors.Register(ModuleName, 16, "unknown send restriction")
+	ErrInvalidScheduledTransfer  = errors.Register(ModuleName, 17, "invalid scheduled transfer")
+	ErrScheduledTransferNotFound = errors.Register(ModuleName, 18, "scheduled transfer not found")
+	ErrInvalidSendPolicy         = errors.Register(ModuleName, 19, "invalid send policy")
+	ErrSendPolicyViolation       = errors.Register(ModuleName, 20, "send policy violation")
+	ErrInvalidHoldReason         = errors.Register(ModuleName, 21, "invalid hold reason")
+	ErrTooManyScheduledTransfers = errors.Register(ModuleName, 22, "too many scheduled transfers")
 )
@@ -17,6 +17,36 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	// hold events name and attributes
+	EventTypeHoldPlaced   = "hold_placed"
+	EventTypeHoldReleased = "hold_released"
+
+	AttributeKeyHolder = "holder"
+	AttributeKeyReason = "reason"
+
+	// factory denom events name and attributes
+	EventTypeCreateDenom             = "create_denom"
+	EventTypeChangeDenomAdmin        = "change_denom_admin"
+	EventTypeSetDenomSendRestriction = "set_denom_send_restriction"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyNewAdmin        = "new_admin"
+	AttributeKeySendRestriction = "send_restriction"
+
+	// scheduled transfer events name and attributes
+	EventTypeCreateScheduledTransfer   = "create_scheduled_transfer"
+	EventTypeCancelScheduledTransfer   = "cancel_scheduled_transfer"
+	EventTypeExecuteScheduledTransfer  = "execute_scheduled_transfer"
+	EventTypeScheduledTransferFailed   = "scheduled_transfer_failed"
+	EventTypeScheduledTransferComplete = "scheduled_transfer_complete"
+
+	AttributeKeyScheduledTransferID = "scheduled_transfer_id"
+	AttributeKeyError               = "error"
+
+	// send policy events name
+	EventTypeTransferTax = "transfer_tax"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
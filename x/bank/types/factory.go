@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the first component of the denoms created with MsgCreateDenom.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory/{creator}/{subdenom} denom, it errors if the
+// resulting denom is not a valid factory denom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if strings.Contains(subdenom, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom %s cannot contain '/'", subdenom)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// ParseFactoryDenom splits a factory/{creator}/{subdenom} denom into its creator and subdenom.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+	if _, err := GetFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom reports whether the denom is namespaced as a factory denom.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// Validate checks that the denom is a factory denom with a valid creator, and that
+// the admin, if any, is a valid address.
+func (fd FactoryDenom) Validate() error {
+	creator, _, err := ParseFactoryDenom(fd.Denom)
+	if err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator address: %s", err)
+	}
+	if fd.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(fd.Admin); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid admin address: %s", err)
+		}
+	}
+	return nil
+}
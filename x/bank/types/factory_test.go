@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+
+	denom, err := GetFactoryDenom(creator, "coin")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/coin", denom)
+	require.True(t, IsFactoryDenom(denom))
+
+	gotCreator, subdenom, err := ParseFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "coin", subdenom)
+
+	_, err = GetFactoryDenom(creator, "sub/coin")
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+	_, err = GetFactoryDenom(creator, strings.Repeat("a", MaxSubdenomLength+1))
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+	_, err = GetFactoryDenom(creator, "coin!")
+	require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+
+	for _, denom := range []string{"stake", "factory/" + creator, "ibc/" + creator + "/coin", "factory/" + creator + "/a/b"} {
+		_, _, err = ParseFactoryDenom(denom)
+		require.ErrorIs(t, err, ErrInvalidFactoryDenom, denom)
+	}
+
+	require.NoError(t, FactoryDenom{Denom: denom}.Validate())
+	require.NoError(t, FactoryDenom{Denom: denom, Admin: creator}.Validate())
+	require.Error(t, FactoryDenom{Denom: denom, Admin: "invalid"}.Validate())
+	require.Error(t, FactoryDenom{Denom: "factory/invalid/coin"}.Validate())
+}
@@ -24,6 +24,10 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenHolds := make(map[string]bool)
+	seenFactoryDenoms := make(map[string]bool)
+	seenScheduledTransfers := make(map[uint64]bool)
+	seenSendPolicies := make(map[string]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -63,6 +67,59 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, hold := range gs.Holds {
+		key := hold.Address + "/" + hold.Reason
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold for address %s and reason %s", hold.Address, hold.Reason)
+		}
+
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		seenHolds[key] = true
+	}
+
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", fd.Denom)
+		}
+
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
+	for _, st := range gs.ScheduledTransfers {
+		if seenScheduledTransfers[st.Id] {
+			return fmt.Errorf("duplicate scheduled transfer %d", st.Id)
+		}
+
+		if st.Id >= gs.NextScheduledTransferId {
+			return fmt.Errorf("scheduled transfer id %d must be lower than the next scheduled transfer id %d", st.Id, gs.NextScheduledTransferId)
+		}
+
+		if err := st.Validate(); err != nil {
+			return err
+		}
+
+		seenScheduledTransfers[st.Id] = true
+	}
+
+	for _, sp := range gs.SendPolicies {
+		if seenSendPolicies[sp.Denom] {
+			return fmt.Errorf("duplicate send policy for denom %s", sp.Denom)
+		}
+
+		if err := sp.Validate(); err != nil {
+			return err
+		}
+
+		seenSendPolicies[sp.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
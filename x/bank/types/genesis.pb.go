@@ -40,6 +40,16 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// holds defines the coins held in accounts, which are not spendable until released.
+	Holds []Hold `protobuf:"bytes,6,rep,name=holds,proto3" json:"holds"`
+	// factory_denoms defines the denoms created with MsgCreateDenom.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,7,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// scheduled_transfers defines the pending scheduled transfers.
+	ScheduledTransfers []ScheduledTransfer `protobuf:"bytes,8,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers"`
+	// next_scheduled_transfer_id is the id assigned to the next scheduled transfer.
+	NextScheduledTransferId uint64 `protobuf:"varint,9,opt,name=next_scheduled_transfer_id,json=nextScheduledTransferId,proto3" json:"next_scheduled_transfer_id,omitempty"`
+	// send_policies defines the send policies of the denoms.
+	SendPolicies []SendPolicy `protobuf:"bytes,10,rep,name=send_policies,json=sendPolicies,proto3" json:"send_policies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +120,41 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledTransfers() []ScheduledTransfer {
+	if m != nil {
+		return m.ScheduledTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledTransferId() uint64 {
+	if m != nil {
+		return m.NextScheduledTransferId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSendPolicies() []SendPolicy {
+	if m != nil {
+		return m.SendPolicies
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +205,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x4f, 0x14, 0x41,
+	0x18, 0xc6, 0x6f, 0xf9, 0x73, 0xc0, 0x70, 0x60, 0x1c, 0x48, 0x1c, 0x10, 0xf7, 0x0e, 0x0a, 0x73,
+	0x21, 0x61, 0x57, 0xc0, 0xc4, 0x04, 0x13, 0x13, 0x0f, 0x45, 0x2d, 0x0c, 0x84, 0xb3, 0xb2, 0xd9,
+	0xcc, 0xee, 0x0c, 0xc7, 0x86, 0xbd, 0x99, 0xcd, 0xbe, 0x83, 0xb2, 0xdf, 0xc0, 0xd2, 0xda, 0x8a,
+	0xd2, 0x58, 0x51, 0xf0, 0x01, 0x6c, 0x4c, 0x28, 0x89, 0x95, 0xb1, 0x50, 0x03, 0x05, 0x7e, 0x0c,
+	0xb3, 0x33, 0x7b, 0xc7, 0x0a, 0x6b, 0x69, 0x73, 0x77, 0x99, 0xe7, 0x79, 0x7f, 0xef, 0xf3, 0xce,
+	0xcc, 0x0d, 0x9a, 0x0f, 0x24, 0x74, 0x25, 0xb8, 0x3e, 0x15, 0x7b, 0xee, 0x9b, 0x65, 0x9f, 0x2b,
+	0xba, 0xec, 0x76, 0xb8, 0xe0, 0x10, 0x82, 0x13, 0x27, 0x52, 0x49, 0x3c, 0x65, 0x2c, 0x4e, 0x66,
+	0x71, 0x72, 0xcb, 0xec, 0x74, 0x47, 0x76, 0xa4, 0xd6, 0xdd, 0xec, 0x97, 0xb1, 0xce, 0xda, 0x7d,
+	0x1a, 0xf0, 0x3e, 0x2d, 0x90, 0xa1, 0xb8, 0xa6, 0x17, 0xba, 0x69, 0xae, 0xd1, 0x67, 0x8c, 0xee,
+	0x19, 0x70, 0xde, 0xd7, 0x48, 0x37, 0x69, 0x37, 0x14, 0xd2, 0xd5, 0x9f, 0x66, 0x69, 0xe1, 0x4b,
+	0x15, 0xd5, 0x9e, 0x99, 0xa8, 0x6d, 0x45, 0x15, 0xc7, 0x8f, 0x50, 0x35, 0xa6, 0x09, 0xed, 0x02,
+	0xb1, 0x1a, 0x56, 0x73, 0x7c, 0xe5, 0xb6, 0x53, 0x12, 0xdd, 0xd9, 0xd2, 0x96, 0xd6, 0xd8, 0xc9,
+	0x8f, 0x7a, 0xe5, 0xe3, 0xc5, 0xd1, 0xa2, 0xb5, 0x9d, 0x57, 0xe1, 0x75, 0x34, 0xea, 0xd3, 0x88,
+	0x8a, 0x80, 0x03, 0x19, 0x68, 0x0c, 0x36, 0xc7, 0x57, 0xe6, 0x4a, 0x09, 0x2d, 0x63, 0x2a, 0x22,
+	0xfa, 0x85, 0x38, 0x45, 0x55, 0xd8, 0x8f, 0xe3, 0x28, 0x25, 0x83, 0x1a, 0x31, 0x73, 0x89, 0x00,
+	0xde, 0x47, 0xac, 0xcb, 0x50, 0xb4, 0x36, 0xb2, 0xfa, 0x4f, 0x3f, 0xeb, 0xcd, 0x4e, 0xa8, 0x76,
+	0xf7, 0x7d, 0x27, 0x90, 0xdd, 0x7c, 0xe8, 0xfc, 0x6b, 0x09, 0xd8, 0x9e, 0xab, 0xd2, 0x98, 0x83,
+	0x2e, 0x80, 0x0f, 0x17, 0x47, 0x8b, 0xb5, 0x88, 0x77, 0x68, 0x90, 0x7a, 0xd9, 0xb6, 0x42, 0x9e,
+	0xdf, 0x34, 0xc4, 0x9b, 0x68, 0x92, 0x71, 0x21, 0xbb, 0x5e, 0x97, 0x2b, 0xca, 0xa8, 0xa2, 0x64,
+	0x48, 0x47, 0xb8, 0x53, 0x3a, 0xc5, 0xcb, 0xdc, 0x54, 0x1c, 0x63, 0x42, 0xd7, 0xf7, 0x14, 0x4c,
+	0x51, 0x0d, 0xb8, 0x60, 0x1e, 0x17, 0xd4, 0x8f, 0x38, 0x23, 0xc3, 0x1a, 0xd7, 0x28, 0xc5, 0xb5,
+	0xb9, 0x60, 0x4f, 0x8d, 0xaf, 0x35, 0x97, 0x11, 0xbf, 0x1f, 0x2f, 0xdd, 0xb8, 0x1c, 0xa3, 0x71,
+	0xcf, 0xb9, 0xff, 0xc0, 0x34, 0x19, 0x87, 0x4b, 0x2b, 0x5e, 0x43, 0xc3, 0xbb, 0x32, 0x62, 0x40,
+	0xaa, 0x57, 0x77, 0xab, 0xc0, 0x7e, 0x2e, 0x23, 0x56, 0x8c, 0x69, 0x4a, 0x70, 0x1b, 0x4d, 0xee,
+	0xd0, 0x40, 0xc9, 0x24, 0xf5, 0x74, 0x6e, 0x20, 0x23, 0x1a, 0x32, 0x5f, 0x0a, 0xd9, 0x30, 0xd6,
+	0x27, 0x99, 0xf3, 0xaf, 0x99, 0x77, 0x0a, 0x02, 0x60, 0x1f, 0x4d, 0x41, 0xb0, 0xcb, 0xd9, 0x7e,
+	0xc4, 0x99, 0xa7, 0x12, 0x2a, 0x60, 0x87, 0x27, 0x40, 0x46, 0x35, 0xf9, 0x6e, 0xf9, 0xe8, 0x3d,
+	0xff, 0xab, 0xdc, 0x5e, 0xc4, 0x63, 0xb8, 0xaa, 0x02, 0x7e, 0x88, 0x66, 0x05, 0x3f, 0x50, 0xde,
+	0xf5, 0x46, 0x5e, 0xc8, 0xc8, 0x58, 0xc3, 0x6a, 0x0e, 0x6d, 0xdf, 0xca, 0x1c, 0xd7, 0xc8, 0x2f,
+	0x18, 0xde, 0x44, 0x13, 0xfa, 0x50, 0x62, 0x19, 0x85, 0x41, 0xc8, 0x81, 0x20, 0x1d, 0xad, 0xfe,
+	0xcf, 0x53, 0xd9, 0xca, 0x8c, 0x69, 0x31, 0x53, 0x0d, 0x7a, 0xcb, 0x21, 0x87, 0x85, 0xcf, 0x16,
+	0x1a, 0xc9, 0xaf, 0x34, 0x5e, 0x41, 0x23, 0x94, 0xb1, 0x84, 0x83, 0xf9, 0x0f, 0x8d, 0xb5, 0xc8,
+	0xd7, 0xe3, 0xa5, 0xe9, 0x9c, 0xfc, 0xd8, 0x28, 0x6d, 0x95, 0x84, 0xa2, 0xb3, 0xdd, 0x33, 0xe2,
+	0xb7, 0x68, 0x58, 0x5f, 0x46, 0x32, 0x70, 0xf5, 0x08, 0xff, 0xd7, 0x85, 0x37, 0xfd, 0xd6, 0x46,
+	0xdf, 0x1d, 0xd6, 0x2b, 0xbf, 0x0f, 0xeb, 0x95, 0xd6, 0xea, 0xc9, 0x99, 0x6d, 0x9d, 0x9e, 0xd9,
+	0xd6, 0xaf, 0x33, 0xdb, 0x7a, 0x7f, 0x6e, 0x57, 0x4e, 0xcf, 0xed, 0xca, 0xb7, 0x73, 0xbb, 0xf2,
+	0x3a, 0x7f, 0x52, 0x80, 0xed, 0x39, 0xa1, 0x74, 0x0f, 0xcc, 0xd3, 0xa3, 0x3b, 0xf8, 0x55, 0xfd,
+	0x8c, 0xac, 0xfe, 0x19, 0x00, 0x13, 0x66, 0x12, 0x8b, 0x04, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +267,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendPolicies) > 0 {
+		for iNdEx := len(m.SendPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextScheduledTransferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledTransferId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for iNdEx := len(m.ScheduledTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +484,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTransfers) > 0 {
+		for _, e := range m.ScheduledTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledTransferId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledTransferId))
+	}
+	if len(m.SendPolicies) > 0 {
+		for _, e := range m.SendPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +737,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTransfers = append(m.ScheduledTransfers, ScheduledTransfer{})
+			if err := m.ScheduledTransfers[len(m.ScheduledTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTransferId", wireType)
+			}
+			m.NextScheduledTransferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledTransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendPolicies = append(m.SendPolicies, SendPolicy{})
+			if err := m.SendPolicies[len(m.SendPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -46,6 +47,117 @@ func TestGenesisStateValidate(t *testing.T) {
 			false,
 		},
 		{"empty genesisState", GenesisState{}, false},
+		{
+			"valid holds",
+			GenesisState{
+				Holds: []Hold{
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "escrow", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate holds",
+			GenesisState{
+				Holds: []Hold{
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "escrow", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "escrow", sdk.Coins{sdk.NewInt64Coin("uatom", 2)}),
+				},
+			},
+			true,
+		},
+		{
+			"hold without reason",
+			GenesisState{
+				Holds: []Hold{
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			true,
+		},
+		{
+			"duplicate factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/coin"},
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/coin"},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate send policies",
+			GenesisState{
+				SendPolicies: []SendPolicy{{Denom: "uatom"}, {Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"invalid send policy",
+			GenesisState{
+				SendPolicies: []SendPolicy{{Denom: "uatom", Denylist: []string{"invalid"}}},
+			},
+			true,
+		},
+		{
+			"invalid factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{{Denom: "coin"}},
+			},
+			true,
+		},
+		{
+			"valid scheduled transfers",
+			GenesisState{
+				ScheduledTransfers: []ScheduledTransfer{
+					NewScheduledTransfer(0, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), 0, nil),
+					NewScheduledTransfer(1, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), time.Hour, nil),
+				},
+				NextScheduledTransferId: 2,
+			},
+			false,
+		},
+		{
+			"duplicate scheduled transfers",
+			GenesisState{
+				ScheduledTransfers: []ScheduledTransfer{
+					NewScheduledTransfer(0, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), 0, nil),
+					NewScheduledTransfer(0, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), 0, nil),
+				},
+				NextScheduledTransferId: 1,
+			},
+			true,
+		},
+		{
+			"scheduled transfer id not lower than next id",
+			GenesisState{
+				ScheduledTransfers: []ScheduledTransfer{
+					NewScheduledTransfer(1, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), 0, nil),
+				},
+				NextScheduledTransferId: 1,
+			},
+			true,
+		},
+		{
+			"one-off scheduled transfer with end time",
+			GenesisState{
+				ScheduledTransfers: []ScheduledTransfer{
+					NewScheduledTransfer(0, "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, time.Unix(0, 0), 0, &time.Time{}),
+				},
+				NextScheduledTransferId: 1,
+			},
+			true,
+		},
+		{
+			"hold without amount",
+			GenesisState{
+				Holds: []Hold{
+					NewHold("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "escrow", nil),
+				},
+			},
+			true,
+		},
 		{
 			"invalid params ",
 			GenesisState{
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHold creates a new Hold object.
+func NewHold(address, reason string, amount sdk.Coins) Hold {
+	return Hold{
+		Address: address,
+		Reason:  reason,
+		Amount:  amount,
+	}
+}
+
+// reHoldReason matches the hold reasons. Like denoms, they start with a letter
+// followed by letters, digits or '/', ':', '.', '_', '-', and are at most 128
+// characters long, so that they can be used in non terminal store keys.
+var reHoldReason = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]{0,127}$`)
+
+// ValidateHoldReason checks that a hold reason is not empty and is made of the
+// allowed characters.
+func ValidateHoldReason(reason string) error {
+	if reason == "" {
+		return errorsmod.Wrap(ErrInvalidHoldReason, "reason cannot be empty")
+	}
+	if !reHoldReason.MatchString(reason) {
+		return errorsmod.Wrapf(ErrInvalidHoldReason, "invalid reason %q", reason)
+	}
+	return nil
+}
+
+// Validate checks for address, reason and amount correctness.
+func (h Hold) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return err
+	}
+
+	if err := ValidateHoldReason(h.Reason); err != nil {
+		return err
+	}
+
+	if h.Amount.Empty() {
+		return errorsmod.Wrap(ErrInvalidHold, "amount cannot be empty")
+	}
+
+	return h.Amount.Validate()
+}
@@ -24,6 +24,10 @@ const (
 	// It should be synced with the mint module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/0e34478eb7420b69869ed50f129fc274a97a9b06/x/mint/types/keys.go#L13
 	MintModuleName = "mint"
+
+	// ProtocolPoolModuleName duplicates the protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
+	// It should be synced with the protocolpool module's name if it is ever changed.
+	ProtocolPoolModuleName = "protocolpool"
 )
 
 // KVStore keys
@@ -39,6 +43,26 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// HoldsPrefix is the prefix for the coins held in accounts, keyed by address, reason and denom.
+	HoldsPrefix = collections.NewPrefix(6)
+
+	// FactoryDenomsPrefix is the prefix for the denoms created with MsgCreateDenom.
+	FactoryDenomsPrefix = collections.NewPrefix(7)
+
+	// ScheduledTransferSeqKey is the key for the sequence of scheduled transfer ids.
+	ScheduledTransferSeqKey = collections.NewPrefix(8)
+	// ScheduledTransfersPrefix is the prefix for the scheduled transfers, keyed by id.
+	ScheduledTransfersPrefix = collections.NewPrefix(9)
+	// ScheduledTransferQueuePrefix is the prefix for the scheduled transfer ids, keyed by next execution time.
+	ScheduledTransferQueuePrefix = collections.NewPrefix(10)
+	// ScheduledTransfersBySenderPrefix is the prefix for the scheduled transfer ids, keyed by sender.
+	ScheduledTransfersBySenderPrefix = collections.NewPrefix(11)
+	// ScheduledTransfersByRecipientPrefix is the prefix for the scheduled transfer ids, keyed by recipient.
+	ScheduledTransfersByRecipientPrefix = collections.NewPrefix(12)
+
+	// SendPoliciesPrefix is the prefix for the send policies, keyed by denom.
+	SendPoliciesPrefix = collections.NewPrefix(13)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	coretransaction "cosmossdk.io/core/transaction"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +12,14 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgMint{}
+	_ coretransaction.Msg = &MsgChangeDenomAdmin{}
+	_ coretransaction.Msg = &MsgSetDenomMetadata{}
+	_ coretransaction.Msg = &MsgSetDenomSendRestriction{}
+	_ coretransaction.Msg = &MsgCreateScheduledTransfer{}
+	_ coretransaction.Msg = &MsgCancelScheduledTransfer{}
+	_ coretransaction.Msg = &MsgSetSendPolicies{}
 )
 
 // NewMsgSend constructs a msg to send coins from one account to another.
@@ -30,3 +40,55 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetSendPolicies constructs a message to set or remove the send policies of denoms.
+func NewMsgSetSendPolicies(authority string, sendPolicies []SendPolicy, removeFor []string) *MsgSetSendPolicies {
+	return &MsgSetSendPolicies{
+		Authority:    authority,
+		SendPolicies: sendPolicies,
+		RemoveFor:    removeFor,
+	}
+}
+
+// NewMsgCreateDenom constructs a msg to create the factory/{sender}/{subdenom} denom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint constructs a msg to mint coins of a factory denom.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{Sender: sender, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgChangeDenomAdmin constructs a msg to change the admin of a factory denom.
+func NewMsgChangeDenomAdmin(sender, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomMetadata constructs a msg to set the metadata of a factory denom.
+func NewMsgSetDenomMetadata(sender string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender, Metadata: metadata}
+}
+
+// NewMsgSetDenomSendRestriction constructs a msg to set the send restriction of a factory denom.
+func NewMsgSetDenomSendRestriction(sender, denom, sendRestriction string) *MsgSetDenomSendRestriction {
+	return &MsgSetDenomSendRestriction{Sender: sender, Denom: denom, SendRestriction: sendRestriction}
+}
+
+// NewMsgCreateScheduledTransfer constructs a msg to schedule a transfer of coins. A zero
+// interval schedules a one-off transfer.
+func NewMsgCreateScheduledTransfer(sender, recipient string, amount sdk.Coins, startTime time.Time, interval time.Duration, endTime *time.Time) *MsgCreateScheduledTransfer {
+	return &MsgCreateScheduledTransfer{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		StartTime: startTime,
+		Interval:  interval,
+		EndTime:   endTime,
+	}
+}
+
+// NewMsgCancelScheduledTransfer constructs a msg to cancel a scheduled transfer.
+func NewMsgCancelScheduledTransfer(sender string, id uint64) *MsgCancelScheduledTransfer {
+	return &MsgCancelScheduledTransfer{Sender: sender, Id: id}
+}
@@ -10,20 +10,48 @@ import (
 // DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
 var DefaultDefaultSendEnabled = true
 
+// DefaultMaxScheduledTransfersPerBlock is the value that MaxScheduledTransfersPerBlock will have from DefaultParams().
+var DefaultMaxScheduledTransfersPerBlock uint32 = 100
+
+// DefaultMaxScheduledTransfersPerSender is the value that MaxScheduledTransfersPerSender will have from DefaultParams().
+var DefaultMaxScheduledTransfersPerSender uint32 = 10
+
 // NewParams creates a new parameter configuration for the bank module
 func NewParams(defaultSendEnabled bool) Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: defaultSendEnabled,
+		SendEnabled:                    nil,
+		DefaultSendEnabled:             defaultSendEnabled,
+		MaxScheduledTransfersPerBlock:  DefaultMaxScheduledTransfersPerBlock,
+		MaxScheduledTransfersPerSender: DefaultMaxScheduledTransfersPerSender,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:                    nil,
+		DefaultSendEnabled:             DefaultDefaultSendEnabled,
+		MaxScheduledTransfersPerBlock:  DefaultMaxScheduledTransfersPerBlock,
+		MaxScheduledTransfersPerSender: DefaultMaxScheduledTransfersPerSender,
+	}
+}
+
+// MaxScheduledTransfersPerBlockOrDefault returns MaxScheduledTransfersPerBlock, or its
+// default value when it is not set.
+func (p Params) MaxScheduledTransfersPerBlockOrDefault() uint32 {
+	if p.MaxScheduledTransfersPerBlock == 0 {
+		return DefaultMaxScheduledTransfersPerBlock
 	}
+	return p.MaxScheduledTransfersPerBlock
+}
+
+// MaxScheduledTransfersPerSenderOrDefault returns MaxScheduledTransfersPerSender, or its
+// default value when it is not set.
+func (p Params) MaxScheduledTransfersPerSenderOrDefault() uint32 {
+	if p.MaxScheduledTransfersPerSender == 0 {
+		return DefaultMaxScheduledTransfersPerSender
+	}
+	return p.MaxScheduledTransfersPerSender
 }
 
 // Validate all bank module parameters
@@ -31,6 +59,9 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
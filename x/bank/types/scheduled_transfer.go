@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewScheduledTransfer creates a new ScheduledTransfer object.
+func NewScheduledTransfer(id uint64, sender, recipient string, amount sdk.Coins, nextExecution time.Time, interval time.Duration, endTime *time.Time) ScheduledTransfer {
+	return ScheduledTransfer{
+		Id:            id,
+		Sender:        sender,
+		Recipient:     recipient,
+		Amount:        amount,
+		NextExecution: nextExecution,
+		Interval:      interval,
+		EndTime:       endTime,
+	}
+}
+
+// IsRecurring reports whether the transfer is executed more than once.
+func (st ScheduledTransfer) IsRecurring() bool {
+	return st.Interval > 0
+}
+
+// Validate checks for addresses, amount, interval and end time correctness.
+func (st ScheduledTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(st.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(st.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	return ValidateScheduledTransfer(st.Amount, st.NextExecution, st.Interval, st.EndTime)
+}
+
+// ValidateScheduledTransfer checks that the amount is valid and not empty, and that
+// the interval and end time describe a one-off or a recurring transfer.
+func ValidateScheduledTransfer(amount sdk.Coins, start time.Time, interval time.Duration, endTime *time.Time) error {
+	if amount.Empty() || !amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+	if interval < 0 {
+		return errorsmod.Wrapf(ErrInvalidScheduledTransfer, "interval %s cannot be negative", interval)
+	}
+	if endTime != nil {
+		if interval == 0 {
+			return errorsmod.Wrap(ErrInvalidScheduledTransfer, "end time can only be set on recurring transfers")
+		}
+		if endTime.Before(start) {
+			return errorsmod.Wrapf(ErrInvalidScheduledTransfer, "end time %s is before start time %s", endTime, start)
+		}
+	}
+	return nil
+}
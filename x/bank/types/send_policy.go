@@ -0,0 +1,76 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the denom and addresses of the policy are valid, that the tax
+// rate is a fraction with a recipient, and that the max amount is not negative.
+func (sp SendPolicy) Validate() error {
+	if err := sdk.ValidateDenom(sp.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidSendPolicy, err.Error())
+	}
+
+	if err := validateAddressList(sp.Allowlist); err != nil {
+		return errorsmod.Wrapf(err, "invalid allowlist of denom %s", sp.Denom)
+	}
+	if err := validateAddressList(sp.Denylist); err != nil {
+		return errorsmod.Wrapf(err, "invalid denylist of denom %s", sp.Denom)
+	}
+
+	if !sp.TaxRate.IsNil() && !sp.TaxRate.IsZero() {
+		if sp.TaxRate.IsNegative() || sp.TaxRate.GT(math.LegacyOneDec()) {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "tax rate %s of denom %s must be between 0 and 1", sp.TaxRate, sp.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(sp.TaxRecipient); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "invalid tax recipient of denom %s: %s", sp.Denom, err)
+		}
+	}
+
+	if !sp.MaxAmount.IsNil() && sp.MaxAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSendPolicy, "max amount %s of denom %s cannot be negative", sp.MaxAmount, sp.Denom)
+	}
+
+	return nil
+}
+
+// Tax returns the tax charged on a transfer of amount coins of the denom.
+func (sp SendPolicy) Tax(amount math.Int) math.Int {
+	if sp.TaxRate.IsNil() || sp.TaxRate.IsZero() {
+		return math.ZeroInt()
+	}
+	return sp.TaxRate.MulInt(amount).TruncateInt()
+}
+
+// ExceedsMaxAmount reports whether a transfer of amount coins of the denom is above
+// the max amount of the policy.
+func (sp SendPolicy) ExceedsMaxAmount(amount math.Int) bool {
+	return !sp.MaxAmount.IsNil() && sp.MaxAmount.IsPositive() && amount.GT(sp.MaxAmount)
+}
+
+// IsAllowed reports whether the address can send and receive the denom.
+func (sp SendPolicy) IsAllowed(addr string) bool {
+	if slices.Contains(sp.Denylist, addr) {
+		return false
+	}
+	return len(sp.Allowlist) == 0 || slices.Contains(sp.Allowlist, addr)
+}
+
+func validateAddressList(addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrap(ErrInvalidSendPolicy, err.Error())
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestSendPolicy(t *testing.T) {
+	addr1 := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+	addr2 := "cosmos1tygms3xhhs3yv487phx3dw4a95jn7t7lpm470r"
+
+	testCases := []struct {
+		name     string
+		policy   SendPolicy
+		expError bool
+	}{
+		{"empty policy", SendPolicy{Denom: "stake"}, false},
+		{"invalid denom", SendPolicy{Denom: "1"}, true},
+		{"lists", SendPolicy{Denom: "stake", Allowlist: []string{addr1, addr2}, Denylist: []string{addr2}}, false},
+		{"invalid allowlist address", SendPolicy{Denom: "stake", Allowlist: []string{"invalid"}}, true},
+		{"duplicate denylist address", SendPolicy{Denom: "stake", Denylist: []string{addr1, addr1}}, true},
+		{"tax", SendPolicy{Denom: "stake", TaxRate: math.LegacyNewDecWithPrec(1, 2), TaxRecipient: addr1}, false},
+		{"tax without recipient", SendPolicy{Denom: "stake", TaxRate: math.LegacyNewDecWithPrec(1, 2)}, true},
+		{"tax above one", SendPolicy{Denom: "stake", TaxRate: math.LegacyNewDec(2), TaxRecipient: addr1}, true},
+		{"negative tax", SendPolicy{Denom: "stake", TaxRate: math.LegacyNewDec(-1), TaxRecipient: addr1}, true},
+		{"max amount", SendPolicy{Denom: "stake", MaxAmount: math.NewInt(10)}, false},
+		{"negative max amount", SendPolicy{Denom: "stake", MaxAmount: math.NewInt(-10)}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expError {
+				require.ErrorIs(t, err, ErrInvalidSendPolicy)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	sp := SendPolicy{Denom: "stake", Allowlist: []string{addr1, addr2}, Denylist: []string{addr2}, TaxRate: math.LegacyNewDecWithPrec(15, 3), MaxAmount: math.NewInt(1000)}
+	require.True(t, sp.IsAllowed(addr1))
+	require.False(t, sp.IsAllowed(addr2))
+	require.False(t, sp.IsAllowed("cosmos1other"))
+	require.True(t, SendPolicy{Denylist: []string{addr2}}.IsAllowed(addr1))
+	require.Equal(t, math.NewInt(1), sp.Tax(math.NewInt(100)))
+	require.Equal(t, math.ZeroInt(), SendPolicy{}.Tax(math.NewInt(100)))
+	require.False(t, sp.ExceedsMaxAmount(math.NewInt(1000)))
+	require.True(t, sp.ExceedsMaxAmount(math.NewInt(1001)))
+	require.False(t, SendPolicy{}.ExceedsMaxAmount(math.NewInt(1001)))
+}
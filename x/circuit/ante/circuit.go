@@ -14,6 +14,20 @@ type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
 
+// MsgCircuitBreaker is a CircuitBreaker which can also decide on the message
+// itself, checking the trips scoped to its signers or field values. When the
+// circuit breaker of the decorator implements it, the messages are checked with
+// CheckMsgAllowed in the ante handler and with IsMsgAllowed in ValidateTx.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+	// CheckMsgAllowed returns whether the message is allowed, without counting
+	// it against the rate thresholds.
+	CheckMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+	// IsMsgAllowed returns whether the message is allowed, counting it against
+	// the rate thresholds in finalize mode.
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -30,14 +44,24 @@ func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
 // - or error early if a nested authz grant is found.
 // The circuit AnteHandler handles this with baseapp's service router: https://github.com/cosmos/cosmos-sdk/issues/18632.
 func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	if err := cbd.ValidateTx(ctx, tx); err != nil {
+	// the messages are counted against the rate thresholds by the message
+	// router check of baseapp, which runs after the ante handler
+	if err := cbd.validateTx(ctx, tx, false); err != nil {
 		return ctx, err
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// ValidateTx checks that the messages of the transaction are allowed. It is
+// the transaction validation of apps without the message router check of
+// baseapp, such as server/v2 apps, so the messages are also counted against
+// the rate thresholds in finalize mode.
 func (cbd CircuitBreakerDecorator) ValidateTx(ctx context.Context, tx transaction.Tx) error {
+	return cbd.validateTx(ctx, tx, true)
+}
+
+func (cbd CircuitBreakerDecorator) validateTx(ctx context.Context, tx transaction.Tx, recordRate bool) error {
 	// loop through all the messages and check if the message type is allowed
 	msgs, err := tx.GetMessages()
 	if err != nil {
@@ -45,7 +69,7 @@ func (cbd CircuitBreakerDecorator) ValidateTx(ctx context.Context, tx transactio
 	}
 
 	for _, msg := range msgs {
-		isAllowed, err := cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+		isAllowed, err := cbd.isAllowed(ctx, msg, recordRate)
 		if err != nil {
 			return err
 		}
@@ -56,3 +80,15 @@ func (cbd CircuitBreakerDecorator) ValidateTx(ctx context.Context, tx transactio
 	}
 	return nil
 }
+
+func (cbd CircuitBreakerDecorator) isAllowed(ctx context.Context, msg sdk.Msg, recordRate bool) (bool, error) {
+	cb, ok := cbd.circuitKeeper.(MsgCircuitBreaker)
+	switch {
+	case !ok:
+		return cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+	case recordRate:
+		return cb.IsMsgAllowed(ctx, msg)
+	default:
+		return cb.CheckMsgAllowed(ctx, msg)
+	}
+}
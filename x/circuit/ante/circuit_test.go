@@ -3,20 +3,28 @@ package ante_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
+	coretesting "cosmossdk.io/core/testing"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/circuit"
 	"cosmossdk.io/x/circuit/ante"
+	"cosmossdk.io/x/circuit/keeper"
 	cbtypes "cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type fixture struct {
@@ -91,3 +99,53 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+func TestCircuitBreakerDecoratorMsgCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, circuit.AppModule{})
+	ac := addresscodec.NewBech32Codec("cosmos")
+	storeKey := storetypes.NewKVStoreKey(cbtypes.StoreKey)
+	authority, err := ac.BytesToString(authtypes.NewModuleAddress(cbtypes.GovModuleName))
+	require.NoError(t, err)
+	k := keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(storeKey), coretesting.NewNopLogger()), encCfg.Codec, authority, ac)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	decorator := ante.NewCircuitBreakerDecorator(&k)
+
+	const (
+		granter1 = "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+		granter2 = "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"
+	)
+	msgURL := sdk.MsgTypeURL(&cbtypes.MsgAuthorizeCircuitBreaker{})
+	newTx := func(granter string) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&cbtypes.MsgAuthorizeCircuitBreaker{Granter: granter, Grantee: granter2}))
+		return txBuilder.GetTx()
+	}
+	anteHandle := func(ctx sdk.Context, tx sdk.Tx) error {
+		_, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// the trips scoped to some senders are checked
+	require.NoError(t, k.Trips.Set(ctx, collections.Join(msgURL, uint64(1)), cbtypes.Trip{Id: 1, MsgTypeUrl: msgURL, Senders: []string{granter1}}))
+	require.EqualError(t, anteHandle(ctx, newTx(granter1)), "tx type not allowed")
+	require.EqualError(t, decorator.ValidateTx(ctx, newTx(granter1)), "tx type not allowed")
+	require.NoError(t, anteHandle(ctx, newTx(granter2)))
+
+	// the msgs are only counted against the rate thresholds by ValidateTx in
+	// finalize mode, the ante handler leaving it to the msg service router
+	require.NoError(t, k.Params.Set(ctx, cbtypes.Params{
+		RateThresholds: []cbtypes.RateThreshold{{MsgTypeUrl: msgURL, MaxMsgs: 2, Window: time.Minute, TripDuration: time.Minute}},
+	}))
+	finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, anteHandle(finalizeCtx, newTx(granter2)))
+		require.NoError(t, decorator.ValidateTx(ctx, newTx(granter2)))
+	}
+	require.NoError(t, decorator.ValidateTx(finalizeCtx, newTx(granter2)))
+	require.NoError(t, decorator.ValidateTx(finalizeCtx, newTx(granter2)))
+	require.EqualError(t, decorator.ValidateTx(finalizeCtx, newTx(granter2)), "tx type not allowed")
+	require.EqualError(t, anteHandle(ctx, newTx(granter2)), "tx type not allowed")
+}
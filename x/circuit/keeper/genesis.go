@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 
 	"cosmossdk.io/x/circuit/types"
 )
@@ -37,9 +40,25 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	var trips []types.Trip
+	err = k.Trips.Walk(ctx, nil, func(_ collections.Pair[string, uint64], trip types.Trip) (stop bool, err error) {
+		trips = append(trips, trip)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		Trips:              trips,
+		Params:             params,
 	}, nil
 }
 
@@ -63,5 +82,17 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 		}
 	}
 
-	return nil
+	var lastTripID uint64
+	for _, trip := range genState.Trips {
+		if err := k.Trips.Set(ctx, collections.Join(trip.MsgTypeUrl, trip.Id), trip); err != nil {
+			return err
+		}
+		lastTripID = max(lastTripID, trip.Id)
+	}
+	// trip ids are the sequence plus one, see addTrip
+	if err := k.TripSequence.Set(ctx, lastTripID); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, genState.Params)
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -73,9 +74,25 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	url := "test_url"
 
+	expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		Trips: []types.Trip{
+			{
+				Id:           3,
+				MsgTypeUrl:   url,
+				ExpiresAt:    &expiresAt,
+				Senders:      []string{addr},
+				FieldFilters: []types.FieldFilter{{Path: "amount.denom", Values: []string{"stake"}}},
+				TrippedBy:    addr,
+			},
+		},
+		Params: types.Params{
+			RateThresholds: []types.RateThreshold{
+				{MsgTypeUrl: url, MaxMsgs: 10, Window: time.Minute, TripDuration: time.Hour},
+			},
+		},
 	}
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
@@ -92,4 +109,11 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.Trips, exportedGenesisState.Trips)
+	s.Require().Equal(genesisState.Params, exportedGenesisState.Params)
+
+	// new trips get ids after the imported ones
+	seq, err := s.keeper.TripSequence.Peek(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), seq)
 }
@@ -1,21 +1,28 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the circuit module's keeper.
 type Keeper struct {
 	appmodule.Environment
 
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	addressCodec address.Codec
 	authority    []byte
 
@@ -24,10 +31,18 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// Trips contains the trips of the circuit breaker by Msg URL and trip id
+	Trips collections.Map[collections.Pair[string, uint64], types.Trip]
+	// TripSequence is the sequence of the trip ids
+	TripSequence collections.Sequence
+	// Params contains the module parameters
+	Params collections.Item[types.Params]
+	// RateWindows contains the current window of each rate threshold by Msg URL
+	RateWindows collections.Map[string, types.RateWindow]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string, addressCodec address.Codec) Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.Codec, authority string, addressCodec address.Codec) Keeper {
 	auth, err := addressCodec.StringToBytes(authority)
 	if err != nil {
 		panic(err)
@@ -53,6 +68,22 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		Trips: collections.NewMap(
+			sb,
+			types.TripsPrefix,
+			"trips",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.Trip](cdc),
+		),
+		TripSequence: collections.NewSequence(sb, types.TripSequencePrefix, "trip_sequence"),
+		Params:       collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		RateWindows: collections.NewMap(
+			sb,
+			types.RateWindowsPrefix,
+			"rate_windows",
+			collections.StringKey,
+			codec.CollValue[types.RateWindow](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -68,8 +99,202 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// IsAllowed returns false when msg URL is found in the DisableList or is
+// disabled by an unexpired trip which is not scoped to some senders or field
+// values, else true.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
-	return !has, err
+	if err != nil || has {
+		return !has, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	allowed := true
+	err = k.walkTrips(ctx, msgURL, func(trip types.Trip) (bool, error) {
+		if trip.IsExpired(now) || trip.IsScoped() {
+			return false, nil
+		}
+		allowed = false
+		return true, nil
+	})
+	return allowed, err
+}
+
+// IsMsgAllowed returns false when the msg is disabled by the DisableList or by
+// an unexpired trip matching its signers and field values, else true. In
+// finalize mode, it also counts the msg in the window of the rate threshold of
+// its URL, and trips the circuit breaker for the URL once the threshold is
+// reached.
+func (k *Keeper) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	allowed, err := k.CheckMsgAllowed(ctx, msg)
+	if err != nil || !allowed {
+		return allowed, err
+	}
+
+	// only the msgs executed in blocks count against the rate thresholds
+	if k.TransactionService.ExecMode(ctx) != transaction.ExecModeFinalize {
+		return true, nil
+	}
+
+	return true, k.recordRate(ctx, sdk.MsgTypeURL(msg), k.HeaderService.HeaderInfo(ctx).Time)
+}
+
+// CheckMsgAllowed is like IsMsgAllowed but never counts the msg against the
+// rate thresholds.
+func (k *Keeper) CheckMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	msgURL := sdk.MsgTypeURL(msg)
+	has, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || has {
+		return !has, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	allowed := true
+	err = k.walkTrips(ctx, msgURL, func(trip types.Trip) (bool, error) {
+		if trip.IsExpired(now) {
+			return false, nil
+		}
+		match, err := k.matchTrip(msg, trip)
+		if err != nil || !match {
+			return err != nil, err
+		}
+		allowed = false
+		return true, nil
+	})
+	return allowed, err
+}
+
+// matchTrip returns whether the scope of the trip matches the msg.
+func (k *Keeper) matchTrip(msg sdk.Msg, trip types.Trip) (bool, error) {
+	if !trip.IsScoped() {
+		return true, nil
+	}
+
+	signers, m, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return false, err
+	}
+
+	if len(trip.Senders) > 0 {
+		match := false
+		for _, sender := range trip.Senders {
+			addr, err := k.addressCodec.StringToBytes(sender)
+			if err != nil {
+				return false, err
+			}
+			for _, signer := range signers {
+				match = match || bytes.Equal(addr, signer)
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	for _, filter := range trip.FieldFilters {
+		match, err := filter.Match(m)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// recordRate counts a msg in the current window of the rate threshold of its
+// URL, if any. Once max_msgs msgs are counted within the window, the circuit
+// breaker is tripped for the URL for the trip duration of the threshold.
+func (k *Keeper) recordRate(ctx context.Context, msgURL string, now time.Time) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	threshold, ok := params.RateThreshold(msgURL)
+	if !ok {
+		return nil
+	}
+
+	window, err := k.RateWindows.Get(ctx, msgURL)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err != nil || !now.Before(window.Start.Add(threshold.Window)) {
+		window = types.RateWindow{Start: now}
+	}
+
+	window.Count++
+	if window.Count < threshold.MaxMsgs {
+		return k.RateWindows.Set(ctx, msgURL, window)
+	}
+
+	if err := k.RateWindows.Remove(ctx, msgURL); err != nil {
+		return err
+	}
+	expiresAt := now.Add(threshold.TripDuration)
+	trip, err := k.addTrip(ctx, types.Trip{MsgTypeUrl: msgURL, ExpiresAt: &expiresAt})
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		"trip_circuit_breaker",
+		event.NewAttribute("msg_url", msgURL),
+		event.NewAttribute("trip_id", strconv.FormatUint(trip.Id, 10)),
+	)
+}
+
+// addTrip assigns the next trip id to the trip and saves it.
+func (k *Keeper) addTrip(ctx context.Context, trip types.Trip) (types.Trip, error) {
+	id, err := k.TripSequence.Next(ctx)
+	if err != nil {
+		return trip, err
+	}
+	// trip ids start at 1 so that an unset id is never valid
+	trip.Id = id + 1
+
+	return trip, k.Trips.Set(ctx, collections.Join(trip.MsgTypeUrl, trip.Id), trip)
+}
+
+// walkTrips iterates over the trips of the msg URL until cb returns true.
+func (k *Keeper) walkTrips(ctx context.Context, msgURL string, cb func(trip types.Trip) (bool, error)) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](msgURL)
+	return k.Trips.Walk(ctx, rng, func(_ collections.Pair[string, uint64], trip types.Trip) (bool, error) {
+		return cb(trip)
+	})
+}
+
+// PruneExpiredTrips removes the trips which are expired at the current block
+// time.
+func (k *Keeper) PruneExpiredTrips(ctx context.Context) error {
+	now := k.HeaderService.HeaderInfo(ctx).Time
+
+	var expired []types.Trip
+	err := k.Trips.Walk(ctx, nil, func(_ collections.Pair[string, uint64], trip types.Trip) (bool, error) {
+		if trip.IsExpired(now) {
+			expired = append(expired, trip)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, trip := range expired {
+		if err := k.Trips.Remove(ctx, collections.Join(trip.MsgTypeUrl, trip.Id)); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			"expire_circuit_breaker_trip",
+			event.NewAttribute("msg_url", trip.MsgTypeUrl),
+			event.NewAttribute("trip_id", strconv.FormatUint(trip.Id, 10)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
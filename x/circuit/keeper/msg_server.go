@@ -5,7 +5,11 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
@@ -95,15 +99,34 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	if msg.Duration < 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duration cannot be negative")
+	}
+	for _, sender := range msg.Senders {
+		if _, err := srv.addressCodec.StringToBytes(sender); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %s: %s", sender, err)
+		}
+	}
+
+	// the circuit breaker is tripped with a trip instead of the disable list
+	// when it expires or is scoped to some senders or field values
+	var expiresAt *time.Time
+	if msg.Duration > 0 {
+		t := srv.HeaderService.HeaderInfo(ctx).Time.Add(msg.Duration)
+		expiresAt = &t
+	}
+	useTrips := expiresAt != nil || len(msg.Senders) > 0 || len(msg.FieldFilters) > 0
+
+	var tripIDs []uint64
 	msgTypeUrls := types.MsgTypeURLValidation(msg.MsgTypeUrls)
 	for _, msgTypeURL := range msgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+		// check if the message is in the list of disabled messages
+		disabled, err := srv.DisableList.Has(ctx, msgTypeURL)
 		if err != nil {
 			return nil, err
 		}
 
-		if !isAllowed {
+		if disabled {
 			return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
 		}
 
@@ -119,24 +142,46 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
 		}
 
-		if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+		if !useTrips {
+			if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := srv.validateFieldFilters(msgTypeURL, msg.FieldFilters); err != nil {
 			return nil, err
 		}
 
+		trip, err := srv.addTrip(ctx, types.Trip{
+			MsgTypeUrl:   msgTypeURL,
+			ExpiresAt:    expiresAt,
+			Senders:      msg.Senders,
+			FieldFilters: msg.FieldFilters,
+			TrippedBy:    msg.Authority,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tripIDs = append(tripIDs, trip.Id)
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"trip_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
 		event.NewAttribute("msg_url", urls),
-	); err != nil {
+	}
+	if len(tripIDs) > 0 {
+		attrs = append(attrs, event.NewAttribute("trip_ids", joinTripIDs(tripIDs)))
+	}
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("trip_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgTripCircuitBreakerResponse{
 		Success: true,
+		TripIds: tripIDs,
 	}, nil
 }
 
@@ -156,29 +201,24 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		return nil, err
 	}
 
-	// check if msgURL is empty
-	if len(msgTypeUrls) == 0 {
+	// check if msgURL and trip ids are empty
+	if len(msgTypeUrls) == 0 && len(msg.TripIds) == 0 {
+		trippedUrls, err := srv.trippedMsgURLs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		switch {
 		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
 			// if the sender is a super admin or the module authority, will remove all disabled msgs
-			err := srv.DisableList.Walk(ctx, nil, func(msgUrl string) (stop bool, err error) {
-				msgTypeUrls = append(msgTypeUrls, msgUrl)
-				return false, nil
-			})
-			if err != nil {
-				return nil, err
-			}
+			msgTypeUrls = trippedUrls
 
 		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
 			// if the sender has permission for some messages, will remove all disabled msgs that in the perms.LimitTypeUrls
-			err := srv.DisableList.Walk(ctx, nil, func(msgUrl string) (stop bool, err error) {
+			for _, msgUrl := range trippedUrls {
 				if slices.Contains(perms.LimitTypeUrls, msgUrl) {
 					msgTypeUrls = append(msgTypeUrls, msgUrl)
 				}
-				return false, nil
-			})
-			if err != nil {
-				return nil, err
 			}
 		default:
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
@@ -186,46 +226,199 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	}
 
 	for _, msgTypeURL := range msgTypeUrls {
-		// check if the message is in the list of allowed messages
-		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+		// check if the message is disabled by the disable list or a trip
+		tripped, err := srv.isTripped(ctx, msgTypeURL)
 		if err != nil {
 			return nil, err
 		}
 
-		if isAllowed {
+		if !tripped {
 			return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
 		}
 
-		switch {
-		case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
-			// if the sender is a super admin or the module authority, no need to check perms
-		case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
-			// if the sender has permission for some messages, check if the sender has permission for this specific message
-			if !hasPermissionForMsg(perms, msgTypeURL) {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for message %s", msgTypeURL)
-			}
-		default:
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+		if err := checkResetPermission(perms, address, srv.GetAuthority(), msgTypeURL); err != nil {
+			return nil, err
 		}
 
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+		if err = srv.Trips.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](msgTypeURL)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range msg.TripIds {
+		trip, err := srv.getTrip(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkResetPermission(perms, address, srv.GetAuthority(), trip.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+
+		if err = srv.Trips.Remove(ctx, collections.Join(trip.MsgTypeUrl, trip.Id)); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msgTypeUrls, ",")
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"reset_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
 		event.NewAttribute("msg_url", urls),
-	); err != nil {
+	}
+	if len(msg.TripIds) > 0 {
+		attrs = append(attrs, event.NewAttribute("trip_ids", joinTripIDs(msg.TripIds)))
+	}
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("reset_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// UpdateParams updates the module parameters. Only the module authority can
+// update them.
+func (srv msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(address, srv.GetAuthority()) {
+		expected, err := srv.addressCodec.BytesToString(srv.GetAuthority())
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", expected, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := srv.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	// the rate windows restart with the new thresholds
+	if err := srv.RateWindows.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// isTripped returns true if the message is in the disable list or has trips.
+func (srv msgServer) isTripped(ctx context.Context, msgTypeURL string) (bool, error) {
+	has, err := srv.DisableList.Has(ctx, msgTypeURL)
+	if err != nil || has {
+		return has, err
+	}
+
+	hasTrips := false
+	err = srv.walkTrips(ctx, msgTypeURL, func(types.Trip) (bool, error) {
+		hasTrips = true
+		return true, nil
+	})
+	return hasTrips, err
+}
+
+// trippedMsgURLs returns the sorted message URLs which are in the disable list
+// or have trips.
+func (srv msgServer) trippedMsgURLs(ctx context.Context) ([]string, error) {
+	var urls []string
+	err := srv.DisableList.Walk(ctx, nil, func(msgUrl string) (stop bool, err error) {
+		urls = append(urls, msgUrl)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.Trips.Walk(ctx, nil, func(key collections.Pair[string, uint64], _ types.Trip) (bool, error) {
+		urls = append(urls, key.K1())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(urls)
+	return slices.Compact(urls), nil
+}
+
+// getTrip returns the trip with the given id.
+func (srv msgServer) getTrip(ctx context.Context, id uint64) (types.Trip, error) {
+	var (
+		trip  types.Trip
+		found bool
+	)
+	err := srv.Trips.Walk(ctx, nil, func(_ collections.Pair[string, uint64], t types.Trip) (bool, error) {
+		if t.Id == id {
+			trip, found = t, true
+		}
+		return found, nil
+	})
+	if err != nil {
+		return trip, err
+	}
+	if !found {
+		return trip, errorsmod.Wrapf(sdkerrors.ErrNotFound, "trip %d", id)
+	}
+	return trip, nil
+}
+
+// validateFieldFilters checks that the field filters apply to the message.
+func (srv msgServer) validateFieldFilters(msgTypeURL string, filters []types.FieldFilter) error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	desc, err := srv.cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msgTypeURL, "/")))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown message %s", msgTypeURL)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a message", msgTypeURL)
+	}
+
+	for _, filter := range filters {
+		if err := filter.ValidateFor(msgDesc); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkResetPermission returns an error if the account can't reset the message.
+func checkResetPermission(perms types.Permissions, address, authority []byte, msgTypeURL string) error {
+	switch {
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, authority):
+		// if the sender is a super admin or the module authority, no need to check perms
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		// if the sender has permission for some messages, check if the sender has permission for this specific message
+		if !hasPermissionForMsg(perms, msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for message %s", msgTypeURL)
+		}
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+	}
+	return nil
+}
+
+// joinTripIDs returns the comma separated trip ids.
+func joinTripIDs(ids []uint64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(strs, ",")
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	return slices.Contains(perms.LimitTypeUrls, msg)
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -73,3 +75,36 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// Trips returns the trips of the circuit breaker, optionally for a msg url
+func (qs QueryServer) Trips(ctx context.Context, req *types.QueryTripsRequest) (*types.TripsResponse, error) {
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, uint64]])
+	if req.MsgTypeUrl != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, uint64](req.MsgTypeUrl))
+	}
+
+	trips, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Trips,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], trip types.Trip) (types.Trip, error) {
+			return trip, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripsResponse{Trips: trips, Pagination: pageRes}, nil
+}
+
+// Params returns the module parameters
+func (qs QueryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.ParamsResponse, error) {
+	params, err := qs.keeper.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
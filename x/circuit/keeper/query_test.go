@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -98,3 +100,48 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryTrips(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	trips := []types.Trip{
+		{Id: 1, MsgTypeUrl: f.mockMsgURL},
+		{Id: 2, MsgTypeUrl: msgSend, Senders: []string{addresses[0]}},
+	}
+	for _, trip := range trips {
+		require.NoError(t, f.keeper.Trips.Set(f.ctx, collections.Join(trip.MsgTypeUrl, trip.Id), trip))
+	}
+
+	res, err := qs.Trips(f.ctx, &types.QueryTripsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Trips, 2)
+
+	res, err = qs.Trips(f.ctx, &types.QueryTripsRequest{MsgTypeUrl: msgSend})
+	require.NoError(t, err)
+	require.Equal(t, trips[1:], res.Trips)
+}
+
+func TestQueryParams(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	res, err := qs.Params(f.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.Params{}, res.Params)
+
+	params := types.Params{
+		RateThresholds: []types.RateThreshold{
+			{MsgTypeUrl: msgSend, MaxMsgs: 10, Window: time.Minute, TripDuration: time.Hour},
+		},
+	}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	res, err = qs.Params(f.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const msgAuthorizeURL = "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker"
+
+func withBlockTime(ctx context.Context, t time.Time) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Time: t})
+}
+
+func TestTripCircuitBreakerWithDuration(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := withBlockTime(ft.ctx, now)
+
+	res, err := srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Duration: time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, res.TripIds)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("trip_ids", "1"),
+		),
+		lastEvent(ctx),
+	)
+
+	// the message is not in the disable list but is disabled by the trip
+	has, err := ft.keeper.DisableList.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+	allowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// the trip expires after the duration
+	ctx = withBlockTime(ctx, now.Add(time.Hour))
+	allowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, ft.keeper.PruneExpiredTrips(ctx))
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.ErrorContains(t, err, "is not disabled")
+
+	// a negative duration is rejected
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, Duration: -time.Hour})
+	require.Error(t, err)
+}
+
+func TestScopedTrip(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	// unknown fields can't be filtered
+	_, err = srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{
+		Authority:    authority,
+		MsgTypeUrls:  []string{msgAuthorizeURL},
+		FieldFilters: []types.FieldFilter{{Path: "permissions.unknown", Values: []string{"1"}}},
+	})
+	require.ErrorContains(t, err, "unknown field")
+
+	res, err := srv.TripCircuitBreaker(ft.ctx, &types.MsgTripCircuitBreaker{
+		Authority:    authority,
+		MsgTypeUrls:  []string{msgAuthorizeURL},
+		Senders:      []string{addresses[1]},
+		FieldFilters: []types.FieldFilter{{Path: "permissions.level", Values: []string{"LEVEL_ALL_MSGS"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TripIds, 1)
+
+	// the trip doesn't disable the whole message type
+	allowed, err := ft.keeper.IsAllowed(ft.ctx, msgAuthorizeURL)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	testCases := []struct {
+		name    string
+		granter string
+		level   types.Permissions_Level
+		allowed bool
+	}{
+		{"matching sender and field", addresses[1], types.Permissions_LEVEL_ALL_MSGS, false},
+		{"other sender", addresses[2], types.Permissions_LEVEL_ALL_MSGS, true},
+		{"other field value", addresses[1], types.Permissions_LEVEL_SUPER_ADMIN, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &types.MsgAuthorizeCircuitBreaker{
+				Granter:     tc.granter,
+				Grantee:     addresses[3],
+				Permissions: &types.Permissions{Level: tc.level},
+			}
+			allowed, err := ft.keeper.IsMsgAllowed(ft.ctx, msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+		})
+	}
+
+	// resetting the trip by id allows the message again
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority, TripIds: res.TripIds})
+	require.NoError(t, err)
+	allowed, err = ft.keeper.IsMsgAllowed(ft.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     addresses[1],
+		Grantee:     addresses[3],
+		Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS},
+	})
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	_, err = srv.ResetCircuitBreaker(ft.ctx, &types.MsgResetCircuitBreaker{Authority: authority, TripIds: res.TripIds})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestRateThresholdTrip(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	params := types.Params{
+		RateThresholds: []types.RateThreshold{
+			{MsgTypeUrl: msgAuthorizeURL, MaxMsgs: 2, Window: time.Minute, TripDuration: 10 * time.Minute},
+		},
+	}
+
+	// only the authority can update the params
+	_, err = srv.UpdateParams(ft.ctx, &types.MsgUpdateParams{Authority: addresses[1], Params: params})
+	require.ErrorContains(t, err, "invalid authority")
+	_, err = srv.UpdateParams(ft.ctx, &types.MsgUpdateParams{Authority: authority, Params: types.Params{
+		RateThresholds: []types.RateThreshold{{MsgTypeUrl: msgAuthorizeURL, Window: time.Minute, TripDuration: time.Minute}},
+	}})
+	require.ErrorContains(t, err, "positive max msgs")
+	_, err = srv.UpdateParams(ft.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	msg := &types.MsgAuthorizeCircuitBreaker{Granter: addresses[1], Grantee: addresses[2]}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	isMsgAllowed := func(blockTime time.Time) bool {
+		ctx := sdk.UnwrapSDKContext(withBlockTime(ft.ctx, blockTime)).WithExecMode(sdk.ExecModeFinalize)
+		allowed, err := ft.keeper.IsMsgAllowed(ctx, msg)
+		require.NoError(t, err)
+		return allowed
+	}
+
+	// the msgs are not counted outside of finalize mode
+	for i := 0; i < 3; i++ {
+		allowed, err := ft.keeper.IsMsgAllowed(withBlockTime(ft.ctx, now), msg)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	has, err := ft.keeper.RateWindows.Has(ft.ctx, msgAuthorizeURL)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the first window ends before reaching the threshold
+	require.True(t, isMsgAllowed(now))
+	now = now.Add(time.Minute)
+	require.True(t, isMsgAllowed(now))
+
+	// the threshold is reached within the second window
+	require.True(t, isMsgAllowed(now.Add(time.Second)))
+	require.False(t, isMsgAllowed(now.Add(2*time.Second)))
+
+	ctx := withBlockTime(ft.ctx, now.Add(time.Second))
+	trips, err := keeper.NewQueryServer(ft.keeper).Trips(ctx, &types.QueryTripsRequest{MsgTypeUrl: msgAuthorizeURL})
+	require.NoError(t, err)
+	require.Len(t, trips.Trips, 1)
+	require.Empty(t, trips.Trips[0].TrippedBy)
+	require.Equal(t, now.Add(time.Second+10*time.Minute), *trips.Trips[0].ExpiresAt)
+
+	// the trip expires after the trip duration
+	require.True(t, isMsgAllowed(now.Add(time.Second+10*time.Minute)))
+}
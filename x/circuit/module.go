@@ -31,6 +31,7 @@ var (
 
 	_ appmodule.AppModule                        = AppModule{}
 	_ appmodule.HasGenesis                       = AppModule{}
+	_ appmodule.HasEndBlocker                    = AppModule{}
 	_ appmodule.HasRegisterInterfaces            = AppModule{}
 	_ appmodulev2.HasTxValidator[transaction.Tx] = AppModule{}
 )
@@ -118,6 +119,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock implements appmodule.HasEndBlocker. It removes the expired trips.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.PruneExpiredTrips(ctx)
+}
+
 // TxValidator implements appmodule.HasTxValidator.
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	validator := ante.NewCircuitBreakerDecorator(&am.keeper)
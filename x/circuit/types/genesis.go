@@ -3,7 +3,9 @@ package types
 import "fmt"
 
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 func (gs *GenesisState) Validate() error {
@@ -20,7 +22,19 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	ids := make(map[uint64]struct{}, len(gs.Trips))
+	for _, trip := range gs.Trips {
+		if _, ok := ids[trip.Id]; ok {
+			return fmt.Errorf("duplicate trip id %d", trip.Id)
+		}
+		ids[trip.Id] = struct{}{}
+
+		if err := trip.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return gs.Params.Validate()
 }
 
 func CheckPermission(account *GenesisAccountPermissions) error {
@@ -19,4 +19,8 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripsPrefix             = collections.NewPrefix(3)
+	TripSequencePrefix      = collections.NewPrefix(4)
+	ParamsPrefix            = collections.NewPrefix(5)
+	RateWindowsPrefix       = collections.NewPrefix(6)
 )
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultParams returns the default parameters of the circuit module, which
+// don't set any rate threshold.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.RateThresholds))
+	for _, t := range p.RateThresholds {
+		if t.MsgTypeUrl == "" {
+			return errors.New("rate threshold has an empty msg type url")
+		}
+		if _, ok := seen[t.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate rate threshold for %s", t.MsgTypeUrl)
+		}
+		seen[t.MsgTypeUrl] = struct{}{}
+
+		if t.MaxMsgs == 0 {
+			return fmt.Errorf("rate threshold for %s must have positive max msgs", t.MsgTypeUrl)
+		}
+		if t.Window <= 0 {
+			return fmt.Errorf("rate threshold for %s must have a positive window", t.MsgTypeUrl)
+		}
+		if t.TripDuration <= 0 {
+			return fmt.Errorf("rate threshold for %s must have a positive trip duration", t.MsgTypeUrl)
+		}
+	}
+	return nil
+}
+
+// RateThreshold returns the rate threshold of the Msg type URL, if any.
+func (p Params) RateThreshold(msgTypeURL string) (RateThreshold, bool) {
+	for _, t := range p.RateThresholds {
+		if t.MsgTypeUrl == msgTypeURL {
+			return t, true
+		}
+	}
+	return RateThreshold{}, false
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IsExpired returns true if the trip has an expiration time which is not after
+// the given time.
+func (t Trip) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(now)
+}
+
+// IsScoped returns true if the trip only applies to some senders or Msg field
+// values.
+func (t Trip) IsScoped() bool {
+	return len(t.Senders) > 0 || len(t.FieldFilters) > 0
+}
+
+// Validate performs basic validation of the trip.
+func (t Trip) Validate() error {
+	if t.Id == 0 {
+		return errors.New("trip id cannot be zero")
+	}
+	if t.MsgTypeUrl == "" {
+		return fmt.Errorf("trip %d has an empty msg type url", t.Id)
+	}
+	for _, sender := range t.Senders {
+		if sender == "" {
+			return fmt.Errorf("trip %d has an empty sender", t.Id)
+		}
+	}
+	for _, f := range t.FieldFilters {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("trip %d: %w", t.Id, err)
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of the filter.
+func (f FieldFilter) Validate() error {
+	if f.Path == "" {
+		return errors.New("field filter path cannot be empty")
+	}
+	if len(f.Values) == 0 {
+		return fmt.Errorf("field filter on %s has no values", f.Path)
+	}
+	return nil
+}
+
+// ValidateFor checks that the filter applies to a scalar field of the Msg
+// described by desc.
+func (f FieldFilter) ValidateFor(desc protoreflect.MessageDescriptor) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	_, err := resolveFieldPath(desc, f.Path)
+	return err
+}
+
+// Match returns true if one of the values of the filtered field of the Msg is
+// in the filter values.
+func (f FieldFilter) Match(m protoreflect.Message) (bool, error) {
+	fields, err := resolveFieldPath(m.Descriptor(), f.Path)
+	if err != nil {
+		return false, err
+	}
+
+	field := fields[len(fields)-1]
+	for _, v := range fieldValues(m, fields) {
+		if slices.Contains(f.Values, formatFieldValue(field, v)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveFieldPath returns the fields along the dot separated path from the
+// message. The path must end at a scalar field and can't go through maps.
+func resolveFieldPath(desc protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	fields := make([]protoreflect.FieldDescriptor, 0, len(names))
+	for i, name := range names {
+		if desc == nil {
+			return nil, fmt.Errorf("field path %s goes through scalar field %s", path, names[i-1])
+		}
+
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("unknown field %s in %s", name, desc.FullName())
+		}
+		if field.IsMap() {
+			return nil, fmt.Errorf("field path %s goes through map field %s", path, name)
+		}
+		fields = append(fields, field)
+		desc = field.Message()
+	}
+	if desc != nil {
+		return nil, fmt.Errorf("field path %s must end at a scalar field", path)
+	}
+
+	return fields, nil
+}
+
+// fieldValues returns the values of the last field along the path from the
+// message, flattening the repeated fields. Unset messages along the path have
+// no values.
+func fieldValues(m protoreflect.Message, fields []protoreflect.FieldDescriptor) []protoreflect.Value {
+	field, rest := fields[0], fields[1:]
+
+	var values []protoreflect.Value
+	if field.IsList() {
+		list := m.Get(field).List()
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, list.Get(i))
+		}
+	} else {
+		if len(rest) > 0 && !m.Has(field) {
+			return nil
+		}
+		values = append(values, m.Get(field))
+	}
+
+	if len(rest) == 0 {
+		return values
+	}
+
+	var res []protoreflect.Value
+	for _, v := range values {
+		res = append(res, fieldValues(v.Message(), rest)...)
+	}
+	return res
+}
+
+// formatFieldValue returns the string representation of a scalar value, which
+// filter values are compared with.
+func formatFieldValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if v := field.Enum().Values().ByNumber(value.Enum()); v != nil {
+			return string(v.Name())
+		}
+		return strconv.FormatInt(int64(value.Enum()), 10)
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.Bytes())
+	default:
+		return value.String()
+	}
+}
@@ -0,0 +1,28 @@
+package distribution
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = AppModule{}
+
+// BeforeEpochStart is a noop
+func (am AppModule) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd starts an auto-compounding round at the end of the epochs of the
+// AutoCompoundEpochIdentifier param.
+func (am AppModule) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := am.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return am.keeper.StartAutoCompoundRound(ctx)
+}
@@ -39,3 +39,24 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 
 	return nil
 }
+
+// EndBlocker starts an auto-compounding round every AutoCompoundBlockInterval blocks
+// and processes the round in progress, if any.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if params.AutoCompoundBlockInterval > 0 && height%int64(params.AutoCompoundBlockInterval) == 0 {
+		if err := k.StartAutoCompoundRound(ctx); err != nil {
+			return err
+		}
+	}
+
+	return k.processAutoCompoundRound(ctx)
+}
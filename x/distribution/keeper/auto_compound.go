@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a
+// delegation, it can only be enabled for an existing delegation.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if enabled {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		// opting in would have no effect as no round is run
+		if !params.AutoCompoundEnabled() {
+			return types.ErrAutoCompoundDisabled
+		}
+		if _, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr); err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.ErrNoDelegationExists
+			}
+			return err
+		}
+		if err := k.AutoCompoundDelegations.Set(ctx, key); err != nil {
+			return err
+		}
+	} else if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	delAddrStr, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+		event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// GetDelegatorAutoCompoundValidators returns the validators of the delegations of a
+// delegator with auto-compounding enabled.
+func (k Keeper) GetDelegatorAutoCompoundValidators(ctx context.Context, delAddr sdk.AccAddress) ([]sdk.ValAddress, error) {
+	var validators []sdk.ValAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	err := k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		validators = append(validators, key.K2())
+		return false, nil
+	})
+	return validators, err
+}
+
+// StartAutoCompoundRound starts a round auto-compounding all the delegations with
+// auto-compounding enabled, the round is then processed over the next EndBlocks. It
+// is a no-op if auto-compounding is disabled or if a round is already in progress.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.AutoCompoundEnabled() {
+		return nil
+	}
+
+	if inProgress, err := k.AutoCompoundCursor.Has(ctx); err != nil || inProgress {
+		return err
+	}
+
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+
+	first, err := iter.Key()
+	if err != nil {
+		return err
+	}
+	return k.AutoCompoundCursor.Set(ctx, first)
+}
+
+// processAutoCompoundRound auto-compounds the next delegations of the round in
+// progress, at most MaxAutoCompoundsPerBlock of them.
+func (k Keeper) processAutoCompoundRound(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxAutoCompoundsPerBlock == 0 {
+		return nil
+	}
+
+	// the keys are collected first, the set may be modified when compounding.
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).StartInclusive(cursor)
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys := make([]collections.Pair[sdk.AccAddress, sdk.ValAddress], 0, params.MaxAutoCompoundsPerBlock)
+	for ; iter.Valid() && len(keys) < int(params.MaxAutoCompoundsPerBlock); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	var next *collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	if iter.Valid() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		next = &key
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		if err := k.autoCompound(ctx, key.K1(), key.K2()); err != nil {
+			return err
+		}
+	}
+
+	if next == nil {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+	return k.AutoCompoundCursor.Set(ctx, *next)
+}
+
+// autoCompound withdraws the rewards of a delegation and delegates the staking denom
+// rewards to its validator. The compounding is branched so that a failure leaves no
+// partial state changes, it is skipped and a failure event is emitted.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delAddrStr, err := k.addrCdc.BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	compoundErr := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		// the rewards must be withdrawn to the delegator to be delegated from its account.
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+		if !withdrawAddr.Equals(delAddr) {
+			return fmt.Errorf("withdraw address %s is not the delegator", withdrawAddr)
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			return nil
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		return k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+			event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+			event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		)
+	})
+	if compoundErr == nil {
+		return nil
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompoundFailed,
+		event.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+		event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+		event.NewAttribute(types.AttributeKeyError, compoundErr.Error()),
+	)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	disttypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+	addrCdc := address.NewBech32Codec(sdk.Bech32MainPrefix)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(addrCdc)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := addrCdc.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// params stored before auto-compounding was added are migrated to the defaults
+	params := disttypes.DefaultParams()
+	params.AutoCompoundBlockInterval = 0
+	params.MaxAutoCompoundsPerBlock = 0
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, keeper.NewMigrator(distrKeeper).Migrate4to5(ctx))
+	params, err = distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.DefaultAutoCompoundBlockInterval, params.AutoCompoundBlockInterval)
+	require.Equal(t, disttypes.DefaultMaxAutoCompoundsPerBlock, params.MaxAutoCompoundsPerBlock)
+
+	params.AutoCompoundBlockInterval = 2
+	params.MaxAutoCompoundsPerBlock = 0
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	addrStr, err := addrCdc.BytesToString(addr)
+	require.NoError(t, err)
+
+	del := stakingtypes.NewDelegation(addrStr, operatorAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// auto-compounding can not be enabled while it is disabled by the params
+	require.ErrorIs(t, distrKeeper.SetAutoCompound(ctx, addr, valAddr, true), disttypes.ErrAutoCompoundDisabled)
+	params.MaxAutoCompoundsPerBlock = disttypes.DefaultMaxAutoCompoundsPerBlock
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// auto-compounding can only be enabled for an existing delegation
+	otherVal := sdk.ValAddress(valConsAddr1)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, otherVal).Return(nil, collections.ErrNotFound)
+	require.ErrorIs(t, distrKeeper.SetAutoCompound(ctx, addr, otherVal, true), disttypes.ErrNoDelegationExists)
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, valAddr, true))
+	validators, err := distrKeeper.GetDelegatorAutoCompoundValidators(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddr}, validators)
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// the staking denom rewards are withdrawn and delegated at the block interval
+	expRewards := initial.QuoRaw(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expRewards)))
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, expRewards, stakingtypes.Unbonded, val, true).Return(math.LegacyNewDecFromInt(expRewards), nil)
+
+	require.NoError(t, distrKeeper.EndBlocker(ctx))
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the next block is not on the interval, no round is started
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	require.NoError(t, distrKeeper.EndBlocker(ctx))
+
+	// disabling auto-compounding
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, valAddr, false))
+	validators, err = distrKeeper.GetDelegatorAutoCompoundValidators(ctx, addr)
+	require.NoError(t, err)
+	require.Empty(t, validators)
+}
+
+func TestAutoCompoundRoundBound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	params := disttypes.DefaultParams()
+	params.AutoCompoundBlockInterval = 10
+	params.MaxAutoCompoundsPerBlock = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the delegations have no rewards info, their compounding fails without stopping the round
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1), sdk.ValAddress(valConsAddr2)}
+	slices.SortFunc(valAddrs, func(a, b sdk.ValAddress) int { return bytes.Compare(a, b) })
+	for _, valAddr := range valAddrs {
+		require.NoError(t, distrKeeper.AutoCompoundDelegations.Set(ctx, collections.Join(addrs[0], valAddr)))
+	}
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(nil, errors.New("validator not found")).Times(3)
+
+	// at most two delegations are compounded per block
+	require.NoError(t, distrKeeper.EndBlocker(ctx))
+	cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(addrs[0], valAddrs[2]), cursor)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11})
+	require.NoError(t, distrKeeper.EndBlocker(ctx))
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// removing a delegation disables its auto-compounding
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, addrs[0], valAddrs[0]))
+	validators, err := distrKeeper.GetDelegatorAutoCompoundValidators(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+}
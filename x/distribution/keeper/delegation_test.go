@@ -589,6 +589,88 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+	addrCdc := address.NewBech32Codec(sdk.Bech32MainPrefix)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(addrCdc)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := addrCdc.BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with 50% commission, all its shares are tokenized
+	valAddr := sdk.ValAddress(valConsAddr0)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	owner := sdk.AccAddress(valConsAddr1)
+	ownerStr, err := addrCdc.BytesToString(owner)
+	require.NoError(t, err)
+	recordAddr := stakingtypes.TokenizeShareRecordAddress(0)
+	recordAddrStr, err := addrCdc.BytesToString(recordAddr)
+	require.NoError(t, err)
+
+	record := stakingtypes.NewTokenizeShareRecord(0, ownerStr, recordAddrStr, operatorAddr)
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(0)).Return(record, nil).AnyTimes()
+	del := stakingtypes.NewDelegation(recordAddrStr, operatorAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del, nil).AnyTimes()
+
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// only the owner can withdraw the rewards
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, sdk.AccAddress(valAddr), 0)
+	require.ErrorContains(t, err, "is not the owner of tokenize share record 0")
+
+	// the rewards are withdrawn to the record account, and then sent to the owner along
+	// with the rewards withdrawn previously
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	heldRewards := expRewards.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, recordAddr, expRewards)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(heldRewards)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, heldRewards)
+
+	rewards, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, 0)
+	require.NoError(t, err)
+	require.Equal(t, heldRewards, rewards)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
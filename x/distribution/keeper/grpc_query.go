@@ -382,3 +382,35 @@ func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoo
 
 	return &types.QueryCommunityPoolResponse{Pool: sdk.NewDecCoinsFromCoins(balances...)}, nil
 }
+
+// DelegatorAutoCompound queries the validators of the delegations of a delegator with
+// auto-compounding enabled.
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs, err := k.GetDelegatorAutoCompoundValidators(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		addr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, addr)
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Validators: validators}, nil
+}
@@ -54,6 +54,10 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegations key: delAddr+valAddr | value: none, the delegations with auto-compounding enabled
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor value: delAddr+valAddr, the next delegation of the auto-compounding round in progress
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -153,6 +157,18 @@ func NewKeeper(
 			),
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegationsPrefix,
+			"auto_compound_delegations",
+			collections.NamedPairKeyCodec("delegator_address", sdk.AccAddressKey, "validator_address", sdk.ValAddressKey),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -232,6 +248,48 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of a tokenize
+// share record to the record owner, along with the rewards previously withdrawn to the
+// record account when the delegation was modified.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.addrCdc.BytesToString(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if record.Owner != owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of tokenize share record %d", owner, recordID)
+	}
+
+	recordAddr, err := k.addrCdc.StringToBytes(record.ModuleAccount)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the record account has no withdraw address, the rewards are withdrawn to it.
+	if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
 // WithdrawValidatorCommission withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -57,3 +57,18 @@ func (m Migrator) migrateFunds(ctx context.Context) error {
 	// the feePool has now an empty community pool and the remainder is stored in the DecimalPool
 	return m.keeper.FeePool.Set(ctx, feePool)
 }
+
+// Migrate4to5 migrates the x/distribution module state from the consensus version
+// 4 to version 5. Specifically, it sets the auto-compounding parameters to their
+// defaults.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.AutoCompoundBlockInterval = types.DefaultAutoCompoundBlockInterval
+	params.MaxAutoCompoundsPerBlock = types.DefaultMaxAutoCompoundsPerBlock
+
+	return m.keeper.Params.Set(ctx, params)
+}
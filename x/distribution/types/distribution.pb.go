@@ -38,6 +38,15 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_block_interval is the number of blocks between two auto-compounding
+	// rounds, zero disables the block cadence.
+	AutoCompoundBlockInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_block_interval,json=autoCompoundBlockInterval,proto3" json:"auto_compound_block_interval,omitempty"`
+	// auto_compound_epoch_identifier is the x/epochs identifier at the end of which an
+	// auto-compounding round starts, empty disables the epoch cadence.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,6,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// max_auto_compounds_per_block is the maximum number of delegations auto-compounded
+	// in a block, a round is spread over several blocks if needed.
+	MaxAutoCompoundsPerBlock uint32 `protobuf:"varint,7,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +89,69 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundBlockInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundBlockInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+// AutoCompoundDelegation is a delegation whose rewards are automatically
+// re-delegated to its validator.
+type AutoCompoundDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -102,7 +174,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{2}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +229,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +282,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +328,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +377,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +422,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +469,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +534,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +579,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +633,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +680,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,6 +711,7 @@ var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageI
 
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -657,73 +730,82 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1186 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0xa4, 0xae, 0xd3, 0x4c, 0x7f, 0xd1, 0xa9, 0x93, 0x6e, 0xdc, 0x60, 0xbb, 0x2b, 0x2a,
+	0x4c, 0x20, 0x36, 0x6d, 0x25, 0x84, 0x7c, 0xa0, 0x6a, 0xec, 0x54, 0x54, 0x2a, 0x10, 0x6d, 0x10,
+	0x48, 0x70, 0x58, 0x8d, 0x77, 0x27, 0xf6, 0x90, 0xdd, 0x9d, 0x65, 0x66, 0xd6, 0x4d, 0x4e, 0x70,
+	0x2c, 0x1c, 0x80, 0x1b, 0x88, 0x53, 0x05, 0x97, 0x8a, 0x53, 0x0e, 0xb9, 0xf0, 0x1f, 0x54, 0x9c,
+	0xaa, 0x0a, 0x10, 0xe2, 0x50, 0x4a, 0x72, 0x08, 0xe2, 0xaf, 0x40, 0xb3, 0x33, 0x5e, 0xdb, 0x21,
+	0xa0, 0x90, 0x2a, 0xe2, 0x62, 0x79, 0xde, 0x7b, 0xfb, 0x7d, 0xef, 0xbd, 0xf9, 0x66, 0xde, 0xc0,
+	0xba, 0xc7, 0x44, 0xc8, 0x44, 0xc3, 0xa7, 0x42, 0x72, 0xda, 0x49, 0x24, 0x65, 0x51, 0xa3, 0x7f,
+	0xa5, 0x43, 0x24, 0xbe, 0x32, 0x66, 0xac, 0xc7, 0x9c, 0x49, 0x86, 0x2e, 0xea, 0xf8, 0xfa, 0x98,
+	0xcb, 0xc4, 0x97, 0x8a, 0x5d, 0xd6, 0x65, 0x69, 0x5c, 0x43, 0xfd, 0xd3, 0x9f, 0x94, 0xca, 0x86,
+	0xa2, 0x83, 0x05, 0xc9, 0xa0, 0x3d, 0x46, 0x0d, 0x64, 0x69, 0x56, 0xfb, 0x5d, 0xfd, 0xa1, 0xc1,
+	0xd7, 0xae, 0x73, 0x38, 0xa4, 0x11, 0x6b, 0xa4, 0xbf, 0xda, 0x64, 0x3f, 0xc9, 0xc3, 0xc2, 0x32,
+	0xe6, 0x38, 0x14, 0xe8, 0x7d, 0x78, 0xda, 0x63, 0x61, 0x98, 0x44, 0x54, 0x6e, 0xb8, 0x12, 0xaf,
+	0x5b, 0xa0, 0x0a, 0x6a, 0x53, 0x8b, 0xaf, 0x3c, 0x78, 0x5c, 0xc9, 0xfd, 0xfa, 0xb8, 0x62, 0x52,
+	0x15, 0xfe, 0x5a, 0x9d, 0xb2, 0x46, 0x88, 0x65, 0xaf, 0x7e, 0x9b, 0x74, 0xb1, 0xb7, 0xd1, 0x26,
+	0xde, 0xa3, 0xad, 0x05, 0x68, 0x98, 0xda, 0xc4, 0xbb, 0xbf, 0xbb, 0x39, 0x0f, 0x9c, 0x53, 0x19,
+	0xd8, 0xdb, 0x78, 0x1d, 0x7d, 0x00, 0x8b, 0x2a, 0x61, 0x95, 0x55, 0xcc, 0x04, 0xe1, 0x2e, 0x27,
+	0x77, 0x30, 0xf7, 0xad, 0x89, 0x94, 0xe3, 0xd5, 0xc3, 0x71, 0x58, 0xc0, 0x41, 0x0a, 0x75, 0xd9,
+	0x80, 0x3a, 0x29, 0x26, 0x0a, 0xe0, 0x74, 0x87, 0x45, 0x89, 0xf8, 0x1b, 0xd9, 0xb1, 0xa7, 0x24,
+	0x3b, 0x9f, 0xc2, 0xee, 0x61, 0xbb, 0x0a, 0xa7, 0xef, 0x50, 0xd9, 0xf3, 0x39, 0xbe, 0xe3, 0x62,
+	0xdf, 0xe7, 0x2e, 0x89, 0x70, 0x27, 0x20, 0xbe, 0x95, 0xaf, 0x82, 0xda, 0x09, 0xe7, 0xfc, 0xc0,
+	0x79, 0xc3, 0xf7, 0xf9, 0x92, 0x76, 0xa1, 0xeb, 0x70, 0x0e, 0x27, 0x92, 0xb9, 0x1e, 0x0b, 0x63,
+	0x96, 0x44, 0xbe, 0xdb, 0x09, 0x98, 0xb7, 0xe6, 0xd2, 0x48, 0x12, 0xde, 0xc7, 0x81, 0x75, 0xbc,
+	0x0a, 0x6a, 0x79, 0x67, 0x56, 0xc5, 0xb4, 0x4c, 0xc8, 0xa2, 0x8a, 0xb8, 0x65, 0x02, 0x50, 0x0b,
+	0x96, 0xc7, 0x01, 0x48, 0xcc, 0xbc, 0x9e, 0x4b, 0x7d, 0x12, 0x49, 0xba, 0x4a, 0x09, 0xb7, 0x0a,
+	0xaa, 0x56, 0xe7, 0xe2, 0x28, 0xc4, 0x92, 0x8a, 0xb9, 0x95, 0x85, 0xa0, 0xd7, 0xe0, 0x5c, 0x88,
+	0xd7, 0xdd, 0x31, 0x20, 0xe1, 0xc6, 0x84, 0xeb, 0x74, 0xac, 0xc9, 0x2a, 0xa8, 0x9d, 0x76, 0xac,
+	0x10, 0xaf, 0xdf, 0x18, 0x41, 0x11, 0xcb, 0x84, 0xa7, 0xc9, 0x34, 0x2f, 0x7f, 0xba, 0xbb, 0x39,
+	0x5f, 0xd5, 0x6d, 0x5a, 0x10, 0xfe, 0x5a, 0x63, 0x7d, 0x5c, 0xf7, 0x5a, 0x57, 0xf6, 0xf7, 0x00,
+	0xce, 0x8c, 0x02, 0xb4, 0x49, 0x40, 0xba, 0x58, 0x45, 0xa0, 0x25, 0x78, 0xce, 0xd7, 0x2b, 0xc6,
+	0xd3, 0xe6, 0x11, 0x21, 0x8c, 0xec, 0xac, 0x47, 0x5b, 0x0b, 0x45, 0xb3, 0x05, 0x37, 0xb4, 0x67,
+	0x45, 0x72, 0x1a, 0x75, 0x9d, 0x67, 0xb2, 0x4f, 0x8c, 0x1d, 0xbd, 0x09, 0xcf, 0xf5, 0x71, 0x40,
+	0xfd, 0x31, 0x18, 0xad, 0xac, 0x4b, 0x8f, 0xb6, 0x16, 0x9e, 0x35, 0x30, 0xef, 0x0c, 0x62, 0xf6,
+	0xe0, 0xf5, 0xf7, 0xd8, 0x9b, 0x27, 0xee, 0xde, 0xab, 0xe4, 0xfe, 0xb8, 0x57, 0xc9, 0xd9, 0x3f,
+	0x03, 0x58, 0xca, 0x3e, 0x7b, 0x9d, 0x0a, 0xc9, 0x38, 0xf5, 0x70, 0xa0, 0xb7, 0x5e, 0xa0, 0xcf,
+	0x00, 0xbc, 0xe0, 0x25, 0x61, 0x12, 0x60, 0x49, 0xfb, 0xc4, 0xc8, 0xcc, 0xe5, 0xaa, 0x38, 0x0b,
+	0x54, 0x8f, 0xd5, 0x4e, 0x5e, 0x9d, 0x33, 0x37, 0x42, 0x5d, 0xe9, 0x74, 0x70, 0xb2, 0x95, 0xa6,
+	0x5a, 0x8c, 0x46, 0x5a, 0x8a, 0xdf, 0xfd, 0x56, 0x79, 0xb1, 0x4b, 0x65, 0x2f, 0xe9, 0xd4, 0x3d,
+	0x16, 0x9a, 0x13, 0xdb, 0x18, 0x69, 0xab, 0xdc, 0x88, 0x89, 0x18, 0x7c, 0x23, 0xf4, 0xe9, 0x9a,
+	0x1e, 0xd2, 0xea, 0x64, 0x1c, 0x45, 0x8a, 0x9e, 0x87, 0x67, 0x39, 0x59, 0x25, 0x9c, 0x44, 0x1e,
+	0x71, 0x3d, 0x96, 0x44, 0x32, 0xed, 0xc3, 0x69, 0xe7, 0x4c, 0x66, 0x6e, 0x29, 0xab, 0xfd, 0x2d,
+	0x80, 0x17, 0xb2, 0xc2, 0x5a, 0x09, 0xe7, 0x24, 0x92, 0x83, 0xaa, 0x62, 0x38, 0xa9, 0x2b, 0x11,
+	0x47, 0x5c, 0xc4, 0x80, 0x06, 0xcd, 0xc0, 0x42, 0x4c, 0x38, 0x65, 0xfa, 0x3e, 0xc8, 0x3b, 0x66,
+	0x65, 0x7f, 0x05, 0x60, 0x79, 0xb8, 0x6b, 0x9e, 0xa9, 0x99, 0xf8, 0x2d, 0x16, 0x86, 0x54, 0x08,
+	0x25, 0xa1, 0x3e, 0x84, 0x5e, 0xb6, 0x3a, 0xe2, 0x7c, 0x47, 0x98, 0xec, 0xcf, 0x01, 0xbc, 0x98,
+	0xa5, 0xf6, 0x56, 0x22, 0x85, 0xc4, 0x91, 0xaf, 0xe4, 0xf4, 0x7f, 0x35, 0x51, 0x65, 0x74, 0x3e,
+	0xcb, 0x68, 0x25, 0xc0, 0xa2, 0xb7, 0xd4, 0x27, 0x91, 0x44, 0x2f, 0xc0, 0xa1, 0xc2, 0x5d, 0xd3,
+	0x66, 0x90, 0xb6, 0xf9, 0x6c, 0x66, 0x5f, 0x4e, 0xcd, 0xe8, 0x0d, 0x78, 0x62, 0x95, 0x63, 0x4f,
+	0x9d, 0x4d, 0x73, 0x7e, 0xae, 0xfc, 0xe7, 0xcb, 0xd2, 0xc9, 0x20, 0xec, 0x4f, 0x00, 0x2c, 0xee,
+	0x93, 0x91, 0x40, 0x1f, 0xc2, 0x99, 0x61, 0x4a, 0x42, 0x39, 0x5c, 0x92, 0x7a, 0x4c, 0xaf, 0x5e,
+	0xae, 0xff, 0xcb, 0x5c, 0xac, 0xef, 0x03, 0xb9, 0x38, 0xa5, 0xf2, 0xd4, 0x0d, 0x29, 0xf6, 0xf7,
+	0xa1, 0xb4, 0x3f, 0x9e, 0x80, 0x93, 0x37, 0x09, 0x59, 0x66, 0x2c, 0x40, 0x1f, 0xc1, 0x33, 0xc3,
+	0x49, 0x17, 0x33, 0x16, 0x1c, 0x68, 0x8b, 0x9a, 0x87, 0xdd, 0x22, 0x0b, 0x38, 0xc3, 0xc9, 0x9a,
+	0x26, 0x20, 0xe1, 0x29, 0x9f, 0x78, 0x34, 0xc4, 0x81, 0xa6, 0x9f, 0x38, 0x00, 0xfd, 0xb5, 0x43,
+	0xd0, 0x3b, 0x27, 0x0d, 0x8d, 0x62, 0xb5, 0xbf, 0x9c, 0x80, 0xa5, 0xd6, 0x68, 0x1e, 0x2b, 0x31,
+	0x89, 0x7c, 0x3d, 0xce, 0x70, 0x80, 0x8a, 0xf0, 0xb8, 0xa4, 0x32, 0x20, 0xfa, 0x02, 0x76, 0xf4,
+	0x02, 0x55, 0xe1, 0x49, 0x9f, 0x08, 0x8f, 0xd3, 0x78, 0xa8, 0x0a, 0x67, 0xd4, 0x84, 0xe6, 0xe0,
+	0x14, 0x27, 0x1e, 0x8d, 0x29, 0x89, 0xa4, 0x1e, 0xb1, 0xce, 0xd0, 0x80, 0x36, 0x60, 0x01, 0x87,
+	0xe9, 0x45, 0x94, 0x4f, 0x8b, 0x9c, 0xdd, 0xb7, 0xc8, 0xb4, 0xc2, 0x9b, 0xa6, 0xc2, 0xda, 0x01,
+	0x2a, 0x4c, 0xcb, 0xfb, 0x7a, 0x77, 0x73, 0xfe, 0x54, 0x90, 0xca, 0xd0, 0xf5, 0x86, 0x27, 0xc2,
+	0x10, 0x36, 0x6b, 0x83, 0x6b, 0xfc, 0x87, 0xad, 0x85, 0x92, 0x61, 0xed, 0xb2, 0xfe, 0x08, 0x69,
+	0x24, 0x55, 0xce, 0xc0, 0xfe, 0x11, 0xc0, 0xe9, 0xf6, 0x60, 0xaa, 0xac, 0x48, 0xcc, 0x25, 0x8d,
+	0xba, 0xb7, 0xa2, 0xd5, 0xf4, 0x42, 0x8d, 0x39, 0xe9, 0x53, 0x96, 0x88, 0xf1, 0xb3, 0x73, 0x66,
+	0x60, 0x36, 0x47, 0xe7, 0x36, 0x3c, 0x2e, 0x24, 0x5e, 0x23, 0xd6, 0xc4, 0x53, 0xbd, 0x9a, 0x34,
+	0x08, 0x6a, 0xc3, 0x42, 0x8f, 0xd0, 0x6e, 0x4f, 0x37, 0x34, 0xbf, 0xf8, 0xd2, 0x9f, 0x8f, 0x2b,
+	0x67, 0x3d, 0x4e, 0xd2, 0xb1, 0xe9, 0x6a, 0xd7, 0x37, 0xbb, 0x9b, 0xf3, 0x7b, 0x6d, 0xa6, 0x01,
+	0x7a, 0x61, 0xff, 0x0e, 0xe0, 0xec, 0x70, 0xda, 0x66, 0x05, 0x9a, 0x87, 0xcb, 0xbe, 0x53, 0x13,
+	0x1c, 0x7a, 0x6a, 0xa2, 0x08, 0x16, 0xb2, 0x47, 0xdd, 0x51, 0x5e, 0x78, 0x86, 0xa5, 0x99, 0x57,
+	0xdb, 0x6b, 0xff, 0x04, 0xe0, 0xe5, 0x7f, 0x16, 0xf5, 0xbb, 0x54, 0xf6, 0xda, 0x24, 0x66, 0x82,
+	0xca, 0x23, 0xd2, 0xf7, 0xcc, 0x88, 0xbe, 0x95, 0xcb, 0xac, 0x90, 0x05, 0x27, 0x7d, 0x4d, 0x9c,
+	0xbe, 0xe6, 0xa6, 0x9c, 0xc1, 0xb2, 0xf9, 0xdc, 0xdd, 0x03, 0x48, 0x72, 0xf1, 0xfa, 0xfd, 0xed,
+	0x32, 0x78, 0xb0, 0x5d, 0x06, 0x0f, 0xb7, 0xcb, 0xe0, 0xc9, 0x76, 0x19, 0x7c, 0xb1, 0x53, 0xce,
+	0x3d, 0xdc, 0x29, 0xe7, 0x7e, 0xd9, 0x29, 0xe7, 0xde, 0xbb, 0x34, 0x26, 0xab, 0x3d, 0xef, 0xae,
+	0xb4, 0x69, 0x9d, 0x42, 0xfa, 0xc0, 0xbf, 0xf6, 0xd7, 0x00, 0x9f, 0x33, 0x28, 0x4f, 0x93, 0x0c,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +839,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundBlockInterval != that1.AutoCompoundBlockInterval {
+		return false
+	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1183,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoCompoundBlockInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBlockInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1135,6 +1243,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1648,6 +1793,33 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundBlockInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBlockInterval))
+	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	return n
+}
+
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
 	return n
 }
 
@@ -2016,6 +2188,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBlockInterval", wireType)
+			}
+			m.AutoCompoundBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -1,9 +1,12 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []AutoCompoundDelegation,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -15,6 +18,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundDelegations:         autoCompounds,
 	}
 }
 
@@ -30,6 +34,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegations:         []AutoCompoundDelegation{},
 	}
 }
 
@@ -38,5 +43,15 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	autoCompounds := make(map[[2]string]bool, len(gs.AutoCompoundDelegations))
+	for _, ac := range gs.AutoCompoundDelegations {
+		key := [2]string{ac.DelegatorAddress, ac.ValidatorAddress}
+		if autoCompounds[key] {
+			return fmt.Errorf("duplicate auto-compound delegation of %s to %s", ac.DelegatorAddress, ac.ValidatorAddress)
+		}
+		autoCompounds[key] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -7,19 +7,39 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compounding parameters
+const (
+	DefaultAutoCompoundBlockInterval uint64 = 1000
+	DefaultMaxAutoCompoundsPerBlock  uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:              math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:        math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:       math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:       true,
+		AutoCompoundBlockInterval: DefaultAutoCompoundBlockInterval,
+		MaxAutoCompoundsPerBlock:  DefaultMaxAutoCompoundsPerBlock,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+	if p.AutoCompoundBlockInterval != 0 && p.AutoCompoundEpochIdentifier != "" {
+		return errors.New("auto-compound block interval and epoch identifier cannot be both set")
+	}
+	return nil
+}
+
+// AutoCompoundEnabled reports whether auto-compounding rounds are run, it requires a
+// cadence and a positive number of auto-compounds per block.
+func (p Params) AutoCompoundEnabled() bool {
+	return p.MaxAutoCompoundsPerBlock > 0 && (p.AutoCompoundBlockInterval > 0 || p.AutoCompoundEpochIdentifier != "")
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -47,3 +47,19 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_AutoCompound(t *testing.T) {
+	p := types.DefaultParams()
+	require.True(t, p.AutoCompoundEnabled())
+
+	p.AutoCompoundEpochIdentifier = "day"
+	require.Error(t, p.ValidateBasic())
+
+	p.AutoCompoundBlockInterval = 0
+	require.NoError(t, p.ValidateBasic())
+	require.True(t, p.AutoCompoundEnabled())
+
+	p.MaxAutoCompoundsPerBlock = 0
+	require.NoError(t, p.ValidateBasic())
+	require.False(t, p.AutoCompoundEnabled())
+}
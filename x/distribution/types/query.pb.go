@@ -867,6 +867,93 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// validators defines the validators of the delegations with auto-compounding enabled.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -880,7 +967,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1011,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,6 +1066,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -988,89 +1077,92 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
+	// 1360 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	0x17, 0xce, 0xb8, 0x69, 0xfb, 0xeb, 0xeb, 0xaf, 0x24, 0x99, 0xb6, 0xc8, 0xdd, 0xb6, 0x8e, 0xbb,
+	0xa1, 0x4d, 0xd4, 0x2a, 0xde, 0x34, 0x15, 0xa5, 0x24, 0x94, 0x12, 0x3b, 0x29, 0xad, 0x5a, 0xf5,
+	0x8f, 0x5b, 0xa8, 0x00, 0x55, 0xd6, 0xda, 0xbb, 0xd9, 0x2c, 0xb5, 0x77, 0x9c, 0xdd, 0xd9, 0x98,
+	0xa8, 0xea, 0xa5, 0x5c, 0x4a, 0x4f, 0x08, 0x2e, 0xdc, 0xe0, 0x88, 0x38, 0x71, 0xc8, 0x1d, 0x8e,
+	0x11, 0x5c, 0xaa, 0x20, 0x21, 0x4e, 0x80, 0x12, 0x24, 0x8a, 0x10, 0x88, 0x1b, 0x57, 0xe4, 0x99,
+	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xd6, 0x7a, 0x3b, 0xef, 0xfb, 0xde, 0xf7, 0xde,
+	0x9b, 0x99, 0x37, 0x81, 0xc9, 0x02, 0x71, 0x4a, 0xc4, 0x51, 0x34, 0xd3, 0xa1, 0xb6, 0x99, 0x77,
+	0xa9, 0x49, 0x2c, 0x65, 0xed, 0x6c, 0x5e, 0xa7, 0xea, 0x59, 0x65, 0xd5, 0xd5, 0xed, 0xf5, 0x54,
+	0xd9, 0x26, 0x94, 0xe0, 0xa3, 0x7c, 0x61, 0xca, 0xbf, 0x30, 0x25, 0x16, 0x4a, 0xa7, 0x05, 0x4a,
+	0x5e, 0x75, 0x74, 0xee, 0x55, 0xc3, 0x28, 0xab, 0x86, 0x69, 0xa9, 0x6c, 0x35, 0x03, 0x92, 0x0e,
+	0x19, 0xc4, 0x20, 0xec, 0xa7, 0x52, 0xfd, 0x25, 0xac, 0xc7, 0x0c, 0x42, 0x8c, 0xa2, 0xae, 0xa8,
+	0x65, 0x53, 0x51, 0x2d, 0x8b, 0x50, 0xe6, 0xe2, 0x88, 0xaf, 0x09, 0x3f, 0xbe, 0x87, 0x5c, 0x20,
+	0xa6, 0x87, 0x99, 0x8a, 0x52, 0x11, 0x88, 0x98, 0xaf, 0x3f, 0xc2, 0xd7, 0xe7, 0x78, 0x18, 0x42,
+	0x19, 0xff, 0x34, 0xa6, 0x96, 0x4c, 0x8b, 0x28, 0xec, 0x5f, 0x6e, 0x92, 0x0f, 0x01, 0xbe, 0x5d,
+	0xd5, 0x74, 0x4b, 0xb5, 0xd5, 0x92, 0x93, 0xd5, 0x57, 0x5d, 0xdd, 0xa1, 0xf2, 0x7d, 0x38, 0x18,
+	0xb0, 0x3a, 0x65, 0x62, 0x39, 0x3a, 0xbe, 0x0c, 0x7b, 0xca, 0xcc, 0x12, 0x47, 0x49, 0x34, 0xb5,
+	0x7f, 0x76, 0x22, 0x15, 0x91, 0xb8, 0x14, 0x77, 0x4e, 0xef, 0xdb, 0xfc, 0x79, 0x7c, 0xe8, 0xcb,
+	0xdf, 0xbf, 0x3e, 0x8d, 0xb2, 0xc2, 0x5b, 0xae, 0xc0, 0x49, 0x06, 0xff, 0xb6, 0x5a, 0x34, 0x35,
+	0x95, 0x12, 0x7b, 0xd1, 0xe7, 0x7f, 0xd5, 0x5a, 0x26, 0x22, 0x0e, 0x7c, 0x03, 0xc6, 0xd6, 0xbc,
+	0x35, 0x39, 0x55, 0xd3, 0x6c, 0xdd, 0xe1, 0xdc, 0xfb, 0xd2, 0x27, 0xb6, 0x36, 0xa6, 0x8f, 0x0b,
+	0xfa, 0x1a, 0xce, 0x02, 0x5f, 0x72, 0x87, 0xda, 0xa6, 0x65, 0x64, 0x47, 0xd7, 0x1a, 0xec, 0xf2,
+	0xdf, 0x31, 0x38, 0xd5, 0x8e, 0x59, 0x68, 0xbd, 0x0e, 0xa3, 0xa4, 0xac, 0xdb, 0xbd, 0x31, 0x8f,
+	0x78, 0xae, 0xc2, 0x8c, 0x1f, 0x23, 0x18, 0x73, 0xf4, 0xe2, 0x72, 0x2e, 0x4f, 0x2c, 0x2d, 0x67,
+	0xeb, 0x15, 0xd5, 0xd6, 0x9c, 0x78, 0x2c, 0xb9, 0x6b, 0x6a, 0xff, 0xec, 0x31, 0x2f, 0x8b, 0xd5,
+	0x0e, 0xa8, 0x65, 0x6f, 0x51, 0x2f, 0x64, 0x88, 0x69, 0xa5, 0x2f, 0x54, 0xd3, 0xf7, 0xd5, 0x2f,
+	0xe3, 0x67, 0x0c, 0x93, 0xae, 0xb8, 0xf9, 0x54, 0x81, 0x94, 0x44, 0x51, 0xc5, 0x7f, 0xd3, 0x8e,
+	0xf6, 0x40, 0xa1, 0xeb, 0x65, 0xdd, 0xf1, 0x7c, 0x1c, 0x9e, 0xed, 0x91, 0x2a, 0x61, 0x9a, 0x58,
+	0x5a, 0x96, 0xd3, 0xe1, 0x55, 0x80, 0x02, 0x29, 0x95, 0x4c, 0xc7, 0x31, 0x89, 0x15, 0xdf, 0xd5,
+	0x01, 0xf9, 0xb9, 0x1e, 0xc8, 0xb3, 0x3e, 0x12, 0x79, 0x1d, 0x26, 0x83, 0xf9, 0xbe, 0xe9, 0x52,
+	0x87, 0xaa, 0x96, 0x56, 0xcd, 0x12, 0x0f, 0x6b, 0x50, 0xb5, 0xfe, 0x08, 0xc1, 0x54, 0x7b, 0x6e,
+	0x51, 0xed, 0xfb, 0xb0, 0xd7, 0x2b, 0x0a, 0x6f, 0xed, 0x0b, 0x91, 0xad, 0x1d, 0x01, 0xe9, 0xef,
+	0x77, 0x0f, 0x53, 0x5e, 0x85, 0xf1, 0x60, 0x28, 0x99, 0x5a, 0x8a, 0x06, 0x25, 0xff, 0x29, 0x82,
+	0x64, 0x6b, 0x4e, 0x21, 0x7b, 0x39, 0xd0, 0x11, 0x5c, 0xf9, 0x7c, 0x67, 0xca, 0x17, 0x0a, 0x05,
+	0xb7, 0xe4, 0x16, 0x55, 0xaa, 0x6b, 0x75, 0x60, 0xbf, 0x78, 0x7f, 0x1b, 0x3c, 0x8d, 0xc1, 0xb1,
+	0x60, 0x30, 0x77, 0x8a, 0xaa, 0xb3, 0xa2, 0x0f, 0xaa, 0xf8, 0x78, 0x12, 0x46, 0x1c, 0xaa, 0xda,
+	0xd4, 0xb4, 0x8c, 0xdc, 0x8a, 0x6e, 0x1a, 0x2b, 0x34, 0x1e, 0x4b, 0xa2, 0xa9, 0xe1, 0xec, 0x0b,
+	0x9e, 0xf9, 0x0a, 0xb3, 0xe2, 0x09, 0x38, 0xa0, 0x5b, 0x9a, 0x6f, 0xd9, 0x2e, 0xb6, 0xec, 0xff,
+	0xdc, 0x28, 0x16, 0x5d, 0x06, 0xa8, 0x1f, 0xf5, 0xf1, 0x61, 0x96, 0xa6, 0x53, 0x81, 0x8d, 0xc3,
+	0x6f, 0x93, 0xfa, 0xc9, 0x67, 0xe8, 0x42, 0x59, 0xd6, 0xe7, 0x39, 0x37, 0xfc, 0xe4, 0x8b, 0xf1,
+	0x21, 0xf9, 0x1b, 0x04, 0xc7, 0x5b, 0x24, 0x43, 0x94, 0xe5, 0x2d, 0xd8, 0xeb, 0x70, 0x53, 0x1c,
+	0xb1, 0x5d, 0x3a, 0xd3, 0x59, 0x4d, 0x18, 0xce, 0xd2, 0x9a, 0x6e, 0xd1, 0x40, 0x17, 0x0a, 0x2c,
+	0xfc, 0x66, 0x40, 0x46, 0x8c, 0xc9, 0x98, 0x6c, 0x2b, 0x83, 0xc7, 0xe4, 0xd7, 0x21, 0x7f, 0xeb,
+	0x29, 0x58, 0xd4, 0x8b, 0xba, 0xc1, 0x6c, 0x0d, 0x9b, 0x79, 0x09, 0xc6, 0x34, 0xfe, 0xad, 0xa9,
+	0x9e, 0xf1, 0xad, 0x8d, 0xe9, 0x43, 0x82, 0xb4, 0xa1, 0x8c, 0x35, 0x17, 0xaf, 0x8c, 0xa1, 0x6d,
+	0x11, 0xeb, 0xb9, 0x2d, 0xe6, 0xfe, 0x57, 0x2d, 0xc0, 0xf3, 0x6a, 0x11, 0x3e, 0x41, 0x90, 0x68,
+	0x25, 0x41, 0x54, 0xa1, 0xec, 0x3f, 0x13, 0x06, 0x79, 0x50, 0xd7, 0x8e, 0x09, 0x17, 0xe4, 0x86,
+	0x98, 0xee, 0x12, 0xaa, 0x16, 0x07, 0x92, 0x5b, 0x5f, 0x2e, 0xfe, 0x41, 0x30, 0x11, 0xc9, 0x2b,
+	0x12, 0xf2, 0x5e, 0x63, 0x42, 0xce, 0x47, 0xb6, 0x65, 0x1d, 0x6d, 0xd1, 0xe3, 0xe6, 0x88, 0x61,
+	0x47, 0x24, 0x2e, 0xc2, 0x6e, 0x5a, 0x25, 0x1d, 0xf0, 0xa5, 0xc8, 0x49, 0x64, 0x5b, 0x1c, 0xc8,
+	0xb5, 0xc8, 0x6a, 0x2d, 0x34, 0xb8, 0x34, 0x5f, 0x87, 0x64, 0x6b, 0x4e, 0x91, 0xe2, 0x04, 0x40,
+	0xad, 0x69, 0x79, 0x96, 0xf7, 0x65, 0x7d, 0x16, 0x1f, 0x5a, 0x05, 0x5e, 0x0a, 0xa2, 0xdd, 0x33,
+	0xe9, 0x8a, 0x66, 0xab, 0x15, 0x41, 0x3c, 0x30, 0x19, 0x6b, 0x70, 0xb2, 0x0d, 0xb1, 0xd0, 0x92,
+	0x81, 0xd1, 0x8a, 0xf8, 0xd4, 0x31, 0xf1, 0x48, 0x25, 0x08, 0xe6, 0xe3, 0xa5, 0x70, 0x22, 0xc8,
+	0xbb, 0xe0, 0x52, 0x92, 0x21, 0xa5, 0x32, 0x71, 0x2d, 0x6d, 0x60, 0x6a, 0x0d, 0x90, 0xa3, 0x58,
+	0x85, 0xd4, 0x85, 0xe6, 0xb2, 0x75, 0x72, 0x40, 0xf9, 0x9c, 0xe4, 0x59, 0x38, 0xc2, 0x88, 0xaa,
+	0x97, 0xa9, 0x6b, 0x99, 0x74, 0xfd, 0x16, 0x21, 0x45, 0x21, 0x6b, 0xee, 0xf0, 0xd6, 0xc6, 0xf4,
+	0x48, 0xbd, 0xb3, 0x93, 0x33, 0xa9, 0x97, 0x67, 0xe2, 0x48, 0xfe, 0x1c, 0x81, 0x14, 0xe6, 0x24,
+	0xa2, 0x7a, 0x1f, 0x86, 0xcb, 0x84, 0x14, 0x07, 0x7c, 0x7a, 0x31, 0x8e, 0x16, 0x11, 0xce, 0x6e,
+	0x1e, 0x84, 0xdd, 0x2c, 0x42, 0xfc, 0x19, 0x82, 0x3d, 0xfc, 0x45, 0x80, 0x95, 0xc8, 0x63, 0xa3,
+	0xf9, 0x39, 0x22, 0xcd, 0x74, 0xee, 0xc0, 0xa5, 0xcb, 0x67, 0x1e, 0xff, 0xf0, 0xdb, 0xa7, 0xb1,
+	0x93, 0x78, 0x42, 0x89, 0x7a, 0x3d, 0xf1, 0xe7, 0x08, 0xfe, 0x03, 0xc1, 0x91, 0x96, 0x0f, 0x02,
+	0x9c, 0x6e, 0x4f, 0xde, 0xee, 0x1d, 0x23, 0x65, 0xfa, 0xc2, 0x10, 0x9a, 0x32, 0x4c, 0xd3, 0x45,
+	0x3c, 0x1f, 0xa9, 0xa9, 0xde, 0x52, 0xca, 0xc3, 0xa6, 0xbb, 0xf3, 0x11, 0xfe, 0x30, 0x06, 0x47,
+	0x23, 0xa6, 0x57, 0xbc, 0xd8, 0x45, 0xa4, 0x2d, 0x67, 0x79, 0x69, 0xa9, 0x4f, 0x14, 0xa1, 0xf8,
+	0x1e, 0x53, 0x7c, 0x1b, 0xdf, 0xec, 0x43, 0xb1, 0x42, 0xea, 0xf8, 0xde, 0xc3, 0x0b, 0x6f, 0x23,
+	0x38, 0x18, 0x32, 0x17, 0xe3, 0xd7, 0xba, 0x88, 0xbb, 0x69, 0x84, 0x97, 0x2e, 0xf6, 0xe8, 0x2d,
+	0xd4, 0xde, 0x60, 0x6a, 0xaf, 0xe0, 0xcb, 0xfd, 0xa8, 0xad, 0x0f, 0xdd, 0xf8, 0x47, 0x04, 0xa3,
+	0x8d, 0x23, 0x26, 0x7e, 0xb5, 0x8b, 0x18, 0x83, 0x33, 0xba, 0x34, 0xd7, 0x8b, 0xab, 0xd0, 0x76,
+	0x8d, 0x69, 0x5b, 0xc2, 0x99, 0x7e, 0xb4, 0x79, 0x73, 0xec, 0x5f, 0x08, 0xc6, 0x9a, 0xc6, 0x36,
+	0xdc, 0x41, 0x78, 0xad, 0xc6, 0x55, 0x69, 0xbe, 0x27, 0x5f, 0xa1, 0x2d, 0xc7, 0xb4, 0xbd, 0x83,
+	0xef, 0x45, 0x6a, 0xab, 0xdd, 0x31, 0x8e, 0xf2, 0xb0, 0xe9, 0x8a, 0x7a, 0xa4, 0x88, 0xce, 0x0c,
+	0xdd, 0xb3, 0xcf, 0x11, 0xbc, 0x18, 0x3e, 0x9a, 0xe1, 0x4b, 0xdd, 0x04, 0x1e, 0x32, 0x4c, 0x4a,
+	0x6f, 0xf4, 0x0e, 0xd0, 0x55, 0x69, 0x3b, 0x93, 0xcf, 0x36, 0x66, 0xc8, 0x7c, 0xd4, 0xc9, 0xc6,
+	0x6c, 0x3d, 0xca, 0x49, 0x17, 0x7b, 0xf4, 0xee, 0x6a, 0x63, 0xb6, 0x51, 0x58, 0xef, 0x6d, 0xfc,
+	0x2f, 0x82, 0x78, 0xab, 0xe9, 0x09, 0x2f, 0x74, 0x11, 0x6b, 0xf8, 0xc8, 0x27, 0xa5, 0xfb, 0x81,
+	0x10, 0x9a, 0xef, 0x32, 0xcd, 0x37, 0xf0, 0xf5, 0x7e, 0x34, 0x37, 0x8e, 0x7f, 0xf8, 0x4f, 0x04,
+	0x87, 0x43, 0x27, 0x29, 0xfc, 0x7a, 0x17, 0x31, 0x87, 0x0c, 0x7e, 0xd2, 0xa5, 0x9e, 0xfd, 0x85,
+	0xe0, 0xdb, 0x4c, 0xf0, 0x35, 0x7c, 0xb5, 0x1f, 0xc1, 0xaa, 0x4b, 0x49, 0xae, 0xe0, 0x69, 0xfa,
+	0x1e, 0xc1, 0x81, 0xc0, 0x64, 0x86, 0xcf, 0xb7, 0x8f, 0x32, 0x6c, 0xfe, 0x93, 0x5e, 0xe9, 0xda,
+	0x4f, 0xa8, 0xba, 0xfa, 0x5d, 0xf3, 0x58, 0xc6, 0x84, 0x4e, 0xe3, 0x33, 0x91, 0x42, 0x0b, 0x1e,
+	0x5c, 0xae, 0x3a, 0xde, 0x3d, 0x89, 0xa1, 0xf4, 0xfc, 0xe6, 0x76, 0x02, 0x3d, 0xdb, 0x4e, 0xa0,
+	0x5f, 0xb7, 0x13, 0xe8, 0xe3, 0x9d, 0xc4, 0xd0, 0xb3, 0x9d, 0xc4, 0xd0, 0x4f, 0x3b, 0x89, 0xa1,
+	0x77, 0x4f, 0x70, 0x20, 0x47, 0x7b, 0x90, 0x32, 0x89, 0xf2, 0x41, 0x10, 0x90, 0x8d, 0x8c, 0xf9,
+	0x3d, 0xec, 0xaf, 0xcd, 0xe7, 0xfe, 0x1b, 0x00, 0xd4, 0xf8, 0xa7, 0x1e, 0x93, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1104,6 +1196,9 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries the validators of the delegations of a delegator
+	// with auto-compounding enabled.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1199,6 +1294,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1230,6 +1334,9 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries the validators of the delegations of a delegator
+	// with auto-compounding enabled.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1267,6 +1374,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1437,6 +1547,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1496,6 +1624,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2153,6 +2285,68 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2492,6 +2686,34 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4219,6 +4441,170 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
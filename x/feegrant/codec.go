@@ -13,6 +13,8 @@ import (
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(registrar, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateSponsorPool{}, "cosmos-sdk/MsgCreateSponsorPool")
+	legacy.RegisterAminoMsg(registrar, &MsgCloseSponsorPool{}, "cosmos-sdk/MsgCloseSponsorPool")
 
 	registrar.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	registrar.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
@@ -25,6 +27,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateSponsorPool{},
+		&MsgCloseSponsorPool{},
 	)
 
 	registrar.RegisterInterface(
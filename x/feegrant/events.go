@@ -8,7 +8,13 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
+	EventTypeCreateSponsorPool = "create_sponsor_pool"
+	EventTypeCloseSponsorPool  = "close_sponsor_pool"
+	EventTypeUseSponsorPool    = "use_sponsor_pool"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+	AttributeKeySponsor = "sponsor"
+	AttributeKeyUser    = "user"
 )
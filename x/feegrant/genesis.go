@@ -1,7 +1,13 @@
 package feegrant
 
 import (
+	"fmt"
+
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +31,30 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	pools := make(map[string]bool, len(data.SponsorPools))
+	for _, p := range data.SponsorPools {
+		if pools[p.Sponsor] {
+			return fmt.Errorf("duplicate sponsor pool for sponsor %s", p.Sponsor)
+		}
+		pools[p.Sponsor] = true
+
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, u := range data.SponsorPoolUsages {
+		if !pools[u.Sponsor] {
+			return fmt.Errorf("sponsor pool usage of user %s for unknown sponsor pool %s", u.User, u.Sponsor)
+		}
+		if u.User == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing sponsor pool usage user address")
+		}
+		if !u.Usage.PeriodSpent.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid sponsor pool usage: %s", u.Usage.PeriodSpent)
+		}
+	}
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -116,3 +117,50 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// SponsorPool returns the sponsor pool of the given sponsor.
+func (q Keeper) SponsorPool(ctx context.Context, req *feegrant.QuerySponsorPoolRequest) (*feegrant.QuerySponsorPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sponsor, err := q.addrCdc.StringToBytes(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := q.SponsorPools.Get(ctx, sponsor)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sponsor pool %s not found", req.Sponsor)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorPoolResponse{Pool: &pool}, nil
+}
+
+// SponsorPoolUsage returns the fees sponsored by the pool of the given sponsor
+// for the given user within the current period.
+func (q Keeper) SponsorPoolUsage(ctx context.Context, req *feegrant.QuerySponsorPoolUsageRequest) (*feegrant.QuerySponsorPoolUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sponsor, err := q.addrCdc.StringToBytes(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := q.addrCdc.StringToBytes(req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := q.GetSponsorPoolUsage(ctx, sponsor, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorPoolUsageResponse{Usage: usage}, nil
+}
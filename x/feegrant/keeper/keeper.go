@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -30,6 +31,10 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// SponsorPools key: sponsor | value: SponsorPool
+	SponsorPools collections.Map[sdk.AccAddress, feegrant.SponsorPool]
+	// SponsorPoolUsages key: sponsor+user | value: SponsorPoolUsage
+	SponsorPoolUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.SponsorPoolUsage]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +61,20 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		SponsorPools: collections.NewMap(
+			sb,
+			feegrant.SponsorPoolKeyPrefix,
+			"sponsor_pools",
+			sdk.AccAddressKey,
+			codec.CollValue[feegrant.SponsorPool](cdc),
+		),
+		SponsorPoolUsages: collections.NewMap(
+			sb,
+			feegrant.SponsorPoolUsageKeyPrefix,
+			"sponsor_pool_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[feegrant.SponsorPoolUsage](cdc),
+		),
 	}
 }
 
@@ -211,9 +230,20 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the granter has not granted an allowance to the grantee, the fee is paid from the sponsor pool
+// of the granter, if any.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
+	if errors.Is(err, collections.ErrNotFound) {
+		hasPool, poolErr := k.SponsorPools.Has(ctx, granter)
+		if poolErr != nil {
+			return poolErr
+		}
+		if hasPool {
+			return k.useSponsorPool(ctx, granter, grantee, fee, msgs)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -275,6 +305,30 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, p := range data.SponsorPools {
+		sponsor, err := k.addrCdc.StringToBytes(p.Sponsor)
+		if err != nil {
+			return err
+		}
+		if err := k.SponsorPools.Set(ctx, sponsor, p); err != nil {
+			return err
+		}
+	}
+	for _, u := range data.SponsorPoolUsages {
+		sponsor, err := k.addrCdc.StringToBytes(u.Sponsor)
+		if err != nil {
+			return err
+		}
+		user, err := k.addrCdc.StringToBytes(u.User)
+		if err != nil {
+			return err
+		}
+		if err := k.SponsorPoolUsages.Set(ctx, collections.Join(sdk.AccAddress(sponsor), sdk.AccAddress(user)), u.Usage); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -286,9 +340,37 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.SponsorPool
+	err = k.SponsorPools.Walk(ctx, nil, func(_ sdk.AccAddress, pool feegrant.SponsorPool) (stop bool, err error) {
+		pools = append(pools, pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.SponsorPoolUserUsage
+	err = k.SponsorPoolUsages.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.SponsorPoolUsage) (stop bool, err error) {
+		sponsor, err := k.addrCdc.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		user, err := k.addrCdc.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		usages = append(usages, feegrant.SponsorPoolUserUsage{Sponsor: sponsor, User: user, Usage: usage})
+		return false, nil
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:        grants,
+		SponsorPools:      pools,
+		SponsorPoolUsages: usages,
 	}, err
 }
 
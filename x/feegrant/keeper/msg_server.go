@@ -103,3 +103,42 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// CreateSponsorPool opens a sponsor pool paying fees from the sponsor's funds.
+func (k msgServer) CreateSponsorPool(ctx context.Context, msg *feegrant.MsgCreateSponsorPool) (*feegrant.MsgCreateSponsorPoolResponse, error) {
+	if _, err := k.addrCdc.StringToBytes(msg.Sponsor); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+	}
+
+	pool := feegrant.SponsorPool{
+		Sponsor:         msg.Sponsor,
+		SpendLimit:      msg.SpendLimit,
+		AllowedMessages: msg.AllowedMessages,
+		Period:          msg.Period,
+		PeriodUserLimit: msg.PeriodUserLimit,
+		Expiration:      msg.Expiration,
+	}
+	if err := pool.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CreateSponsorPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateSponsorPoolResponse{}, nil
+}
+
+// CloseSponsorPool closes the sponsor pool of the sponsor.
+func (k msgServer) CloseSponsorPool(ctx context.Context, msg *feegrant.MsgCloseSponsorPool) (*feegrant.MsgCloseSponsorPoolResponse, error) {
+	sponsor, err := k.addrCdc.StringToBytes(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address: %s", err)
+	}
+
+	if err := k.Keeper.CloseSponsorPool(ctx, sponsor); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCloseSponsorPoolResponse{}, nil
+}
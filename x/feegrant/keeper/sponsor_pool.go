@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateSponsorPool opens a new sponsor pool.
+func (k Keeper) CreateSponsorPool(ctx context.Context, pool feegrant.SponsorPool) error {
+	sponsor, err := k.addrCdc.StringToBytes(pool.Sponsor)
+	if err != nil {
+		return err
+	}
+
+	has, err := k.SponsorPools.Has(ctx, sponsor)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sponsor pool already exists")
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if pool.Expiration != nil && pool.Expiration.Before(now) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	if err := k.SponsorPools.Set(ctx, sponsor, pool); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeCreateSponsorPool,
+		event.NewAttribute(feegrant.AttributeKeySponsor, pool.Sponsor),
+	)
+}
+
+// CloseSponsorPool closes the sponsor pool of the sponsor and deletes its usages.
+func (k Keeper) CloseSponsorPool(ctx context.Context, sponsor sdk.AccAddress) error {
+	has, err := k.SponsorPools.Has(ctx, sponsor)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "sponsor pool not found")
+	}
+
+	if err := k.SponsorPools.Remove(ctx, sponsor); err != nil {
+		return err
+	}
+	if err := k.SponsorPoolUsages.Clear(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](sponsor)); err != nil {
+		return err
+	}
+
+	sponsorStr, err := k.addrCdc.BytesToString(sponsor)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeCloseSponsorPool,
+		event.NewAttribute(feegrant.AttributeKeySponsor, sponsorStr),
+	)
+}
+
+// GetSponsorPoolUsage returns the fees sponsored by the pool of the sponsor for
+// the user within the current period.
+func (k Keeper) GetSponsorPoolUsage(ctx context.Context, sponsor, user sdk.AccAddress) (feegrant.SponsorPoolUsage, error) {
+	usage, err := k.SponsorPoolUsages.Get(ctx, collections.Join(sponsor, user))
+	if errors.Is(err, collections.ErrNotFound) {
+		return feegrant.SponsorPoolUsage{}, nil
+	}
+	if err != nil {
+		return feegrant.SponsorPoolUsage{}, err
+	}
+
+	// the fees sponsored in a past period don't count towards the user limit anymore
+	if !k.HeaderService.HeaderInfo(ctx).Time.Before(usage.PeriodReset) {
+		usage.PeriodSpent = nil
+	}
+
+	return usage, nil
+}
+
+// useSponsorPool will try to pay the given fee from the sponsor pool of the sponsor
+// as requested by the user.
+func (k Keeper) useSponsorPool(ctx context.Context, sponsor, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	pool, err := k.SponsorPools.Get(ctx, sponsor)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(sponsor, user)
+	usage, err := k.SponsorPoolUsages.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	remove, err := pool.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), &usage, fee, msgs)
+	if err != nil {
+		return err
+	}
+
+	userStr, err := k.addrCdc.BytesToString(user)
+	if err != nil {
+		return err
+	}
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeUseSponsorPool,
+		event.NewAttribute(feegrant.AttributeKeySponsor, pool.Sponsor),
+		event.NewAttribute(feegrant.AttributeKeyUser, userStr),
+	); err != nil {
+		return err
+	}
+
+	if remove {
+		return k.CloseSponsorPool(ctx, sponsor)
+	}
+
+	if err := k.SponsorPools.Set(ctx, sponsor, pool); err != nil {
+		return err
+	}
+	if pool.PeriodUserLimit.Empty() {
+		return nil
+	}
+	return k.SponsorPoolUsages.Set(ctx, key, usage)
+}
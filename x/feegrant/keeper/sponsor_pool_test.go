@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorPool() {
+	sponsor, alice, bob := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	prune := []sdk.Msg{&feegrant.MsgPruneAllowances{}}
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now().UTC()})
+
+	// without a pool, the fees of a grantee without allowance are not paid
+	err := suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, alice, fee(1), prune)
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.CreateSponsorPool(ctx, &feegrant.MsgCreateSponsorPool{
+		Sponsor:         suite.encodedAddrs[0],
+		SpendLimit:      fee(50),
+		AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})},
+		Period:          time.Hour,
+		PeriodUserLimit: fee(20),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.CreateSponsorPool(ctx, &feegrant.MsgCreateSponsorPool{
+		Sponsor:         suite.encodedAddrs[0],
+		SpendLimit:      fee(50),
+		AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})},
+	})
+	suite.Require().ErrorContains(err, "sponsor pool already exists")
+
+	// any user can use the pool within its user limit
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, alice, fee(15), prune))
+	suite.Require().ErrorIs(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, alice, fee(6), prune), feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, bob, fee(20), prune))
+	suite.Require().ErrorIs(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, bob, fee(1), []sdk.Msg{&feegrant.MsgRevokeAllowance{}}), feegrant.ErrMessageNotAllowed)
+
+	poolRes, err := suite.feegrantKeeper.SponsorPool(ctx, &feegrant.QuerySponsorPoolRequest{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee(15), poolRes.Pool.SpendLimit)
+
+	usageRes, err := suite.feegrantKeeper.SponsorPoolUsage(ctx, &feegrant.QuerySponsorPoolUsageRequest{Sponsor: suite.encodedAddrs[0], User: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee(15), usageRes.Usage.PeriodSpent)
+
+	// allowances granted by the sponsor take precedence over its pool
+	allowance := &feegrant.BasicAllowance{SpendLimit: fee(100)}
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(ctx, sponsor, alice, allowance))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, alice, fee(30), []sdk.Msg{&feegrant.MsgRevokeAllowance{}}))
+
+	genState, err := suite.feegrantKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genState.SponsorPools, 1)
+	suite.Require().Len(genState.SponsorPoolUsages, 2)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genState))
+
+	// the user limit resets with the period
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(time.Hour)})
+	usageRes, err = suite.feegrantKeeper.SponsorPoolUsage(ctx, &feegrant.QuerySponsorPoolUsageRequest{Sponsor: suite.encodedAddrs[0], User: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(usageRes.Usage.PeriodSpent)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, bob, fee(5), prune))
+
+	// the pool is closed once its budget is spent
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, suite.addrs[3], fee(10), prune))
+	_, err = suite.feegrantKeeper.SponsorPool(ctx, &feegrant.QuerySponsorPoolRequest{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().ErrorContains(err, "not found")
+	genState, err = suite.feegrantKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(genState.SponsorPools)
+	suite.Require().Empty(genState.SponsorPoolUsages)
+
+	// pools can be closed by their sponsor
+	_, err = suite.msgSrvr.CreateSponsorPool(ctx, &feegrant.MsgCreateSponsorPool{
+		Sponsor:         suite.encodedAddrs[0],
+		SpendLimit:      fee(50),
+		AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})},
+	})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.CloseSponsorPool(ctx, &feegrant.MsgCloseSponsorPool{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Error(suite.feegrantKeeper.UseGrantedFees(ctx, sponsor, bob, fee(1), prune))
+	_, err = suite.msgSrvr.CloseSponsorPool(ctx, &feegrant.MsgCloseSponsorPool{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().Error(err)
+}
@@ -20,4 +20,12 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// SponsorPoolKeyPrefix is the set of the kvstore for sponsor pools
+	// - 0x02<sponsor_address_bytes>: SponsorPool
+	SponsorPoolKeyPrefix = collections.NewPrefix(2)
+
+	// SponsorPoolUsageKeyPrefix is the set of the kvstore for the usages of the sponsor pools
+	// - 0x03<sponsor_address_len><sponsor_address_bytes><user_address_bytes>: SponsorPoolUsage
+	SponsorPoolUsageKeyPrefix = collections.NewPrefix(3)
 )
@@ -0,0 +1,111 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Accept can use fee payment requested, the messages of the transaction as well
+// as timestamp of the current block to determine whether or not to sponsor the
+// fees of the user whose usage of the pool is provided. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The pool and the usage are updated and will be saved again after an acceptance.
+//
+// If remove is true, the pool will be closed (e.g. when its budget is spent).
+func (p *SponsorPool) Accept(ctx context.Context, usage *SponsorPoolUsage, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return false, errors.New("environment not set")
+	}
+	blockTime := environment.HeaderService.HeaderInfo(ctx).Time
+	if p.Expiration != nil && p.Expiration.Before(blockTime) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "sponsor pool")
+	}
+
+	gasMeter := environment.GasService.GasMeter(ctx)
+	msgsMap := make(map[string]struct{}, len(p.AllowedMessages))
+	for _, msg := range p.AllowedMessages {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return false, err
+		}
+		msgsMap[msg] = struct{}{}
+	}
+	for _, msg := range msgs {
+		if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+			return false, err
+		}
+		if _, allowed := msgsMap[sdk.MsgTypeURL(msg)]; !allowed {
+			return false, errorsmod.Wrap(ErrMessageNotAllowed, "message does not exist in sponsor pool allowed messages")
+		}
+	}
+
+	if !p.PeriodUserLimit.Empty() {
+		// If we are within one period from the last reset, step from it, otherwise
+		// the new period starts at the current block time.
+		if !blockTime.Before(usage.PeriodReset) {
+			usage.PeriodSpent = nil
+			usage.PeriodReset = usage.PeriodReset.Add(p.Period)
+			if blockTime.After(usage.PeriodReset) {
+				usage.PeriodReset = blockTime.Add(p.Period)
+			}
+		}
+
+		spent := usage.PeriodSpent.Add(fee...)
+		if !spent.IsAllLTE(p.PeriodUserLimit) {
+			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "sponsor pool user period limit")
+		}
+		usage.PeriodSpent = spent
+	}
+
+	left, invalid := p.SpendLimit.SafeSub(fee...)
+	if invalid {
+		return false, errorsmod.Wrap(ErrFeeLimitExceeded, "sponsor pool")
+	}
+	p.SpendLimit = left
+
+	return left.IsZero(), nil
+}
+
+// ValidateBasic performs basic sanity checks on the pool.
+func (p SponsorPool) ValidateBasic() error {
+	if p.Sponsor == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing sponsor address")
+	}
+
+	if p.SpendLimit.Empty() || !p.SpendLimit.IsValid() || !p.SpendLimit.IsAllPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit must be positive: %s", p.SpendLimit)
+	}
+
+	if len(p.AllowedMessages) == 0 {
+		return ErrNoMessages
+	}
+
+	if p.Period < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "negative period")
+	}
+	if !p.PeriodUserLimit.Empty() {
+		if !p.PeriodUserLimit.IsValid() || !p.PeriodUserLimit.IsAllPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "period user limit must be positive: %s", p.PeriodUserLimit)
+		}
+		if p.Period == 0 {
+			return errorsmod.Wrap(ErrInvalidDuration, "period must be set with a period user limit")
+		}
+	} else if p.Period != 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "period must not be set without a period user limit")
+	}
+
+	if p.Expiration != nil && p.Expiration.Unix() < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSponsorPoolValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	msgs := []string{sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})}
+	negative := time.Unix(-1, 0)
+
+	cases := map[string]struct {
+		pool  feegrant.SponsorPool
+		valid bool
+	}{
+		"valid": {
+			pool:  feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom, AllowedMessages: msgs, Period: time.Hour, PeriodUserLimit: atom},
+			valid: true,
+		},
+		"valid without user limit": {
+			pool:  feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom, AllowedMessages: msgs},
+			valid: true,
+		},
+		"missing sponsor": {
+			pool: feegrant.SponsorPool{SpendLimit: atom, AllowedMessages: msgs},
+		},
+		"missing spend limit": {
+			pool: feegrant.SponsorPool{Sponsor: "cosmos1", AllowedMessages: msgs},
+		},
+		"missing allowed messages": {
+			pool: feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom},
+		},
+		"user limit without period": {
+			pool: feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom, AllowedMessages: msgs, PeriodUserLimit: atom},
+		},
+		"period without user limit": {
+			pool: feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom, AllowedMessages: msgs, Period: time.Hour},
+		},
+		"negative expiration": {
+			pool: feegrant.SponsorPool{Sponsor: "cosmos1", SpendLimit: atom, AllowedMessages: msgs, Expiration: &negative},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.pool.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSponsorPoolAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Now().UTC()
+	accept := func(pool *feegrant.SponsorPool, usage *feegrant.SponsorPoolUsage, blockTime time.Time, fee sdk.Coins, msgs ...sdk.Msg) (bool, error) {
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: blockTime})
+		return pool.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+			HeaderService: mockHeaderService{},
+			GasService:    mockGasService{},
+		}), usage, fee, msgs)
+	}
+
+	oneHour := now.Add(time.Hour)
+	pool := &feegrant.SponsorPool{
+		Sponsor:         "cosmos1",
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgPruneAllowances{})},
+		Period:          10 * time.Minute,
+		PeriodUserLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 30)),
+		Expiration:      &oneHour,
+	}
+	prune := &feegrant.MsgPruneAllowances{}
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	var usage feegrant.SponsorPoolUsage
+	remove, err := accept(pool, &usage, now, fee(20), prune)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, fee(80), pool.SpendLimit)
+	require.Equal(t, fee(20), usage.PeriodSpent)
+	require.Equal(t, now.Add(pool.Period), usage.PeriodReset)
+
+	_, err = accept(pool, &usage, now, fee(1), &feegrant.MsgRevokeAllowance{})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	_, err = accept(pool, &usage, now.Add(time.Minute), fee(11), prune)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// the user limit resets with the period
+	usage = feegrant.SponsorPoolUsage{PeriodSpent: fee(20), PeriodReset: now.Add(pool.Period)}
+	_, err = accept(pool, &usage, now.Add(pool.Period), fee(30), prune)
+	require.NoError(t, err)
+	require.Equal(t, fee(30), usage.PeriodSpent)
+	require.Equal(t, now.Add(2*pool.Period), usage.PeriodReset)
+	require.Equal(t, fee(50), pool.SpendLimit)
+
+	// the pool is removed once its budget is spent
+	pool.SpendLimit = fee(10)
+	remove, err = accept(pool, &feegrant.SponsorPoolUsage{}, now, fee(10), prune)
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	pool.SpendLimit = fee(10)
+	_, err = accept(pool, &feegrant.SponsorPoolUsage{}, now, fee(11), prune)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	remove, err = accept(pool, &feegrant.SponsorPoolUsage{}, oneHour.Add(time.Second), fee(1), prune)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExpired)
+	require.True(t, remove)
+}
@@ -55,6 +55,33 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Governors.Set(ctx, addr, *governor); err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		governor, err := ak.AddressCodec().StringToBytes(delegation.GovernorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.GovernanceDelegations.Set(ctx, delegator, *delegation); err != nil {
+			return err
+		}
+		if err := k.GovernorDelegations.Set(ctx, collections.Join(sdk.AccAddress(governor), sdk.AccAddress(delegator))); err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -62,17 +89,34 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+			if proposal.DiscussionEndTime != nil {
+				err := k.DiscussionProposalsQueue.Set(ctx, collections.Join(*proposal.DiscussionEndTime, proposal.Id), proposal.Id)
+				if err != nil {
+					return err
+				}
+			}
 		case v1.StatusVotingPeriod:
 			err := k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
 			if err != nil {
 				return err
 			}
+		case v1.StatusQueued:
+			err := k.TimelockQueue.Set(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id), proposal.Id)
+			if err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
 		}
 	}
 
+	for _, revision := range data.ProposalRevisions {
+		if err := k.ProposalRevisions.Set(ctx, collections.Join(revision.ProposalId, revision.Revision), *revision); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +175,42 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	var governors []*v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governanceDelegations []*v1.GovernanceDelegation
+	err = k.GovernanceDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegation) (stop bool, err error) {
+		governanceDelegations = append(governanceDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var proposalRevisions []*v1.ProposalRevision
+	err = k.ProposalRevisions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], value v1.ProposalRevision) (stop bool, err error) {
+		proposalRevisions = append(proposalRevisions, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		Governors:             governors,
+		GovernanceDelegations: governanceDelegations,
+		ProposalRevisions:     proposalRevisions,
 	}, nil
 }
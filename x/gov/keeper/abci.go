@@ -23,6 +23,11 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
 
+	// end the discussion period of draft proposals, which can then enter the voting period.
+	if err := k.endDiscussionPeriods(ctx); err != nil {
+		return err
+	}
+
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](k.HeaderService.HeaderInfo(ctx).Time)
@@ -176,48 +181,19 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
+			// passed proposals with an execution delay are queued in the timelock
+			if delay := executionDelay(proposal, params); delay > 0 && len(proposal.Messages) > 0 {
+				if err := k.queueProposal(ctx, &proposal, delay); err != nil {
+					return err
+				}
 
-			messages, err := proposal.GetMsgs()
-			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+				tagValue = types.AttributeValueProposalQueued
+				logMsg = fmt.Sprintf("passed, queued for execution at %s", proposal.ExecutionTime)
 
 				break
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
-						return err
-					}
-				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			})
-			if err != nil {
-				// `idx` and `err` are populated with the msg index and error.
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
-			}
+			tagValue, logMsg = k.executeProposal(ctx, &proposal, params)
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -278,7 +254,54 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
-	return nil
+
+	// execute the queued proposals whose execution delay has elapsed
+	return k.processTimelockQueue(ctx, params)
+}
+
+// executeProposal executes the messages of a passed proposal and updates its
+// status accordingly. It returns the event attribute value and the log message
+// of the execution result.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal, params v1.Params) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", *proposal, err)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
+				return err
+			}
+		}
+
+		proposal.Status = v1.StatusPassed
+		tagValue = types.AttributeValueProposalPassed
+		logMsg = "passed"
+
+		return nil
+	})
+	if err != nil {
+		// `idx` and `err` are populated with the msg index and error.
+		// We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	return tagValue, logMsg
 }
 
 // executes route(msg) and recovers from panic.
@@ -146,9 +146,10 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
-	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
+	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period.
+	// Draft proposals only enter the voting period at the end of their discussion period.
 	activatedVotingPeriod := false
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
+	if proposal.Status == v1.StatusDepositPeriod && !proposal.InDiscussionPeriod(k.HeaderService.HeaderInfo(ctx).Time) && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
 		err = k.ActivateVotingPeriod(ctx, proposal)
 		if err != nil {
 			return false, err
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StartDiscussionPeriod turns a newly submitted proposal into a draft, which can
+// be amended by its proposer until the end of its discussion period. The deposit
+// period of the proposal is extended by the discussion period.
+func (k Keeper) StartDiscussionPeriod(ctx context.Context, proposal v1.Proposal) (v1.Proposal, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if params.DiscussionPeriod == nil || *params.DiscussionPeriod <= 0 {
+		return v1.Proposal{}, types.ErrDraftsDisabled
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod || proposal.DiscussionEndTime != nil {
+		return v1.Proposal{}, types.ErrInvalidProposal.Wrapf("proposal %d cannot be turned into a draft", proposal.Id)
+	}
+
+	if err := k.InactiveProposalsQueue.Remove(ctx, collections.Join(*proposal.DepositEndTime, proposal.Id)); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	discussionEndTime := k.HeaderService.HeaderInfo(ctx).Time.Add(*params.DiscussionPeriod)
+	depositEndTime := proposal.DepositEndTime.Add(*params.DiscussionPeriod)
+	proposal.DiscussionEndTime = &discussionEndTime
+	proposal.DepositEndTime = &depositEndTime
+
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.InactiveProposalsQueue.Set(ctx, collections.Join(depositEndTime, proposal.Id), proposal.Id); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.DiscussionProposalsQueue.Set(ctx, collections.Join(discussionEndTime, proposal.Id), proposal.Id); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.setProposalRevision(ctx, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	return proposal, nil
+}
+
+// AmendProposal replaces the messages, metadata, title and summary of a draft
+// proposal during its discussion period and records them as a new revision.
+// The deposits made on the proposal are kept.
+func (k Keeper) AmendProposal(ctx context.Context, proposalID uint64, proposer string, messages []sdk.Msg, metadata, title, summary string) (v1.Proposal, error) {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.Proposal{}, types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return v1.Proposal{}, err
+	}
+
+	if proposal.Proposer != proposer {
+		return v1.Proposal{}, types.ErrInvalidProposer.Wrapf("invalid proposer %s", proposer)
+	}
+
+	if !proposal.InDiscussionPeriod(k.HeaderService.HeaderInfo(ctx).Time) {
+		return v1.Proposal{}, types.ErrNotInDiscussionPeriod.Wrapf("proposal %d", proposalID)
+	}
+
+	msgs, proposalParams, err := k.validateProposalMsgs(ctx, messages, proposal.ProposalType)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	proposal.Messages = anys
+	proposal.Metadata = metadata
+	proposal.Title = title
+	proposal.Summary = summary
+	proposal.MessageBasedParams = proposalParams
+	proposal.Revision++
+
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.setProposalRevision(ctx, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAmendProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeyRevision, fmt.Sprintf("%d", proposal.Revision)),
+		event.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgs, ",")),
+	); err != nil {
+		return v1.Proposal{}, fmt.Errorf("failed to emit event: %w", err)
+	}
+
+	return proposal, nil
+}
+
+// GetProposalRevisions returns all the revisions of a proposal, ordered by
+// revision number.
+func (k Keeper) GetProposalRevisions(ctx context.Context, proposalID uint64) ([]v1.ProposalRevision, error) {
+	iter, err := k.ProposalRevisions.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](proposalID))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// setProposalRevision records the current content of a proposal as its latest revision.
+func (k Keeper) setProposalRevision(ctx context.Context, proposal v1.Proposal) error {
+	revision := v1.ProposalRevision{
+		ProposalId:   proposal.Id,
+		Revision:     proposal.Revision,
+		Messages:     proposal.Messages,
+		Metadata:     proposal.Metadata,
+		Title:        proposal.Title,
+		Summary:      proposal.Summary,
+		RevisionTime: k.HeaderService.HeaderInfo(ctx).Time,
+	}
+
+	return k.ProposalRevisions.Set(ctx, collections.Join(proposal.Id, proposal.Revision), revision)
+}
+
+// deleteProposalRevisions deletes all the revisions of a proposal.
+func (k Keeper) deleteProposalRevisions(ctx context.Context, proposalID uint64) error {
+	return k.ProposalRevisions.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](proposalID))
+}
+
+// endDiscussionPeriods ends the discussion period of the draft proposals whose
+// discussion end time has passed, and activates the voting period of the ones
+// which have already reached their minimum deposit.
+func (k Keeper) endDiscussionPeriods(ctx context.Context) error {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](k.HeaderService.HeaderInfo(ctx).Time)
+	iter, err := k.DiscussionProposalsQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.DiscussionProposalsQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		if proposal.Status != v1.StatusDepositPeriod || !sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(proposal.GetMinDepositFromParams(params)) {
+			continue
+		}
+
+		if err := k.ActivateVotingPeriod(ctx, proposal); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeProposalDeposit,
+			event.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+	}
+
+	return nil
+}
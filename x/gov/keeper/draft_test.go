@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestDraftProposal() {
+	suite.reset()
+	require := suite.Require()
+
+	govAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
+	require.NoError(err)
+	proposer, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	require.NoError(err)
+	other, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	require.NoError(err)
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	require.NoError(err)
+	minDeposit := sdk.NewCoins(params.MinDeposit...)
+
+	msgs := []sdk.Msg{&v1.MsgCancelProposal{Proposer: govAcct}}
+	submitMsg, err := v1.NewMsgSubmitProposal(msgs, minDeposit, proposer, "", "title", "summary", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(err)
+	submitMsg.Draft = true
+
+	// drafts are disabled by default
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, submitMsg)
+	require.ErrorIs(err, types.ErrDraftsDisabled)
+
+	discussionPeriod := time.Hour
+	params.DiscussionPeriod = &discussionPeriod
+	require.NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, submitMsg)
+	require.NoError(err)
+
+	// the voting period does not start during the discussion period, even with enough deposit
+	proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+	require.NoError(err)
+	require.Equal(v1.StatusDepositPeriod, proposal.Status)
+	require.Equal(suite.ctx.HeaderInfo().Time.Add(discussionPeriod), *proposal.DiscussionEndTime)
+	require.Equal(suite.ctx.HeaderInfo().Time.Add(discussionPeriod+*params.MaxDepositPeriod), *proposal.DepositEndTime)
+
+	// only the proposer can amend the proposal
+	amendMsg, err := v1.NewMsgAmendProposal(res.ProposalId, msgs, other, "", "amended title", "amended summary")
+	require.NoError(err)
+	_, err = suite.msgSrvr.AmendProposal(suite.ctx, amendMsg)
+	require.ErrorIs(err, types.ErrInvalidProposer)
+
+	amendMsg.Proposer = proposer
+	amendRes, err := suite.msgSrvr.AmendProposal(suite.ctx, amendMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), amendRes.Revision)
+
+	// deposits are carried across amendments
+	_, err = suite.msgSrvr.Deposit(suite.ctx, v1.NewMsgDeposit(other, res.ProposalId, minDeposit))
+	require.NoError(err)
+
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+	require.NoError(err)
+	require.Equal(v1.StatusDepositPeriod, proposal.Status)
+	require.Equal("amended title", proposal.Title)
+	require.Equal(minDeposit.Add(minDeposit...), sdk.NewCoins(proposal.TotalDeposit...))
+
+	revisionsRes, err := suite.queryClient.ProposalRevisions(suite.ctx, &v1.QueryProposalRevisionsRequest{ProposalId: res.ProposalId})
+	require.NoError(err)
+	require.Len(revisionsRes.Revisions, 2)
+	require.Equal("title", revisionsRes.Revisions[0].Title)
+	require.Equal("amended title", revisionsRes.Revisions[1].Title)
+
+	// the proposal enters the voting period at the end of the discussion period
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: proposal.DiscussionEndTime.Add(time.Second)})
+	_, err = suite.msgSrvr.AmendProposal(suite.ctx, amendMsg)
+	require.ErrorIs(err, types.ErrNotInDiscussionPeriod)
+
+	require.NoError(suite.govKeeper.EndBlocker(suite.ctx))
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+	require.NoError(err)
+	require.Equal(v1.StatusVotingPeriod, proposal.Status)
+
+	// the revisions are kept as history
+	revisions, err := suite.govKeeper.GetProposalRevisions(suite.ctx, res.ProposalId)
+	require.NoError(err)
+	require.Len(revisions, 2)
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateGovernor registers an account as a governor other accounts can delegate
+// their governance voting power to. An account delegating its own governance
+// voting power cannot become a governor.
+func (k Keeper) CreateGovernor(ctx context.Context, addr sdk.AccAddress, description v1.GovernorDescription) error {
+	has, err := k.Governors.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrGovernorExists
+	}
+
+	has, err = k.GovernanceDelegations.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrGovernanceDelegation.Wrap("a delegating account cannot become a governor")
+	}
+
+	if err := k.validateGovernorDescription(description); err != nil {
+		return err
+	}
+
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	governor := v1.Governor{
+		Address:     addrStr,
+		Description: description,
+		CreateTime:  k.HeaderService.HeaderInfo(ctx).Time,
+	}
+	if err := k.Governors.Set(ctx, addr, governor); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeCreateGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, addrStr),
+	)
+}
+
+// EditGovernor updates the description of a governor.
+func (k Keeper) EditGovernor(ctx context.Context, addr sdk.AccAddress, description v1.GovernorDescription) error {
+	governor, err := k.Governors.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrGovernorNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.validateGovernorDescription(description); err != nil {
+		return err
+	}
+
+	governor.Description = description
+	if err := k.Governors.Set(ctx, addr, governor); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeEditGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, governor.Address),
+	)
+}
+
+// DelegateGovernance delegates the governance voting power of an account to a
+// governor, replacing any previous governance delegation of the account.
+func (k Keeper) DelegateGovernance(ctx context.Context, delegator, governor sdk.AccAddress) error {
+	if delegator.Equals(governor) {
+		return types.ErrGovernanceDelegation.Wrap("cannot delegate to self")
+	}
+
+	isGovernor, err := k.Governors.Has(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if isGovernor {
+		return types.ErrGovernanceDelegation.Wrap("a governor cannot delegate")
+	}
+
+	has, err := k.Governors.Has(ctx, governor)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrGovernorNotFound
+	}
+
+	if err := k.removeGovernanceDelegation(ctx, delegator); err != nil && !errors.Is(err, types.ErrNoGovernanceDelegation) {
+		return err
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+	governorStr, err := k.authKeeper.AddressCodec().BytesToString(governor)
+	if err != nil {
+		return err
+	}
+
+	delegation := v1.GovernanceDelegation{DelegatorAddress: delegatorStr, GovernorAddress: governorStr}
+	if err := k.setGovernanceDelegation(ctx, delegator, governor, delegation); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateGovernance,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+		event.NewAttribute(types.AttributeKeyGovernor, governorStr),
+	)
+}
+
+// UndelegateGovernance removes the governance delegation of an account.
+func (k Keeper) UndelegateGovernance(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := k.GetGovernanceDelegation(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if err := k.removeGovernanceDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateGovernance,
+		event.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+	)
+}
+
+// GetGovernanceDelegation returns the governance delegation of an account.
+func (k Keeper) GetGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) (v1.GovernanceDelegation, error) {
+	delegation, err := k.GovernanceDelegations.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.GovernanceDelegation{}, types.ErrNoGovernanceDelegation
+	}
+	return delegation, err
+}
+
+// GovernorVotingPower returns the voting power of a governor, being its own
+// voting power and the voting power of all of its delegators.
+func (k Keeper) GovernorVotingPower(ctx context.Context, governor sdk.AccAddress) (math.LegacyDec, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	delegators, err := k.getGovernorDelegators(ctx, governor)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	votingPower := math.LegacyZeroDec()
+	for _, addr := range append([]sdk.AccAddress{governor}, delegators...) {
+		power, err := k.deductDelegatorVotingPower(ctx, addr, validators)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		votingPower = votingPower.Add(power)
+	}
+
+	return votingPower, nil
+}
+
+// getGovernorDelegators returns the accounts delegating to a governor.
+func (k Keeper) getGovernorDelegators(ctx context.Context, governor sdk.AccAddress) ([]sdk.AccAddress, error) {
+	iter, err := k.GovernorDelegations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	delegators := make([]sdk.AccAddress, len(keys))
+	for i, key := range keys {
+		delegators[i] = key.K2()
+	}
+
+	return delegators, nil
+}
+
+// setGovernanceDelegation stores a governance delegation and indexes it by governor.
+func (k Keeper) setGovernanceDelegation(ctx context.Context, delegator, governor sdk.AccAddress, delegation v1.GovernanceDelegation) error {
+	if err := k.GovernanceDelegations.Set(ctx, delegator, delegation); err != nil {
+		return err
+	}
+
+	return k.GovernorDelegations.Set(ctx, collections.Join(governor, delegator))
+}
+
+// removeGovernanceDelegation removes the governance delegation of an account
+// and its governor index.
+func (k Keeper) removeGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := k.GetGovernanceDelegation(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GovernanceDelegations.Remove(ctx, delegator); err != nil {
+		return err
+	}
+
+	return k.GovernorDelegations.Remove(ctx, collections.Join(sdk.AccAddress(governor), delegator))
+}
+
+// validateGovernorDescription checks that a governor description has a moniker
+// and does not exceed the maximum metadata length.
+func (k Keeper) validateGovernorDescription(description v1.GovernorDescription) error {
+	if description.Moniker == "" {
+		return types.ErrInvalidGovernorDescription.Wrap("moniker cannot be empty")
+	}
+
+	for _, field := range []string{description.Moniker, description.Identity, description.Website, description.Details} {
+		if err := k.assertMetadataLength(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestGovernanceDelegation() {
+	suite.reset()
+	require := suite.Require()
+
+	addrs := make([]string, len(suite.addrs))
+	for i, addr := range suite.addrs {
+		str, err := suite.acctKeeper.AddressCodec().BytesToString(addr)
+		require.NoError(err)
+		addrs[i] = str
+	}
+	governor, delegator, other := addrs[0], addrs[1], addrs[2]
+	description := v1.GovernorDescription{Moniker: "governor"}
+
+	// the governor must exist
+	_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, governor))
+	require.ErrorIs(err, types.ErrGovernorNotFound)
+	_, err = suite.msgSrvr.EditGovernor(suite.ctx, v1.NewMsgEditGovernor(governor, description))
+	require.ErrorIs(err, types.ErrGovernorNotFound)
+
+	_, err = suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, v1.GovernorDescription{}))
+	require.ErrorIs(err, types.ErrInvalidGovernorDescription)
+	_, err = suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, description))
+	require.NoError(err)
+	_, err = suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, description))
+	require.ErrorIs(err, types.ErrGovernorExists)
+
+	description.Website = "https://example.com"
+	_, err = suite.msgSrvr.EditGovernor(suite.ctx, v1.NewMsgEditGovernor(governor, description))
+	require.NoError(err)
+
+	// a governor cannot delegate
+	_, err = suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(governor, governor))
+	require.ErrorIs(err, types.ErrGovernanceDelegation)
+
+	_, err = suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, governor))
+	require.NoError(err)
+	_, err = suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(other, governor))
+	require.NoError(err)
+
+	// a delegating account cannot become a governor
+	_, err = suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(delegator, description))
+	require.ErrorIs(err, types.ErrGovernanceDelegation)
+
+	governorRes, err := suite.queryClient.Governor(suite.ctx, &v1.QueryGovernorRequest{GovernorAddress: governor})
+	require.NoError(err)
+	require.Equal(description, governorRes.Governor.Description)
+	require.Equal(math.LegacyZeroDec().String(), governorRes.VotingPower)
+
+	governorsRes, err := suite.queryClient.Governors(suite.ctx, &v1.QueryGovernorsRequest{})
+	require.NoError(err)
+	require.Len(governorsRes.Governors, 1)
+
+	delegationsRes, err := suite.queryClient.GovernorDelegations(suite.ctx, &v1.QueryGovernorDelegationsRequest{GovernorAddress: governor})
+	require.NoError(err)
+	require.Len(delegationsRes.Delegations, 2)
+
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(delegator))
+	require.NoError(err)
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(delegator))
+	require.ErrorIs(err, types.ErrNoGovernanceDelegation)
+
+	_, err = suite.queryClient.GovernanceDelegation(suite.ctx, &v1.QueryGovernanceDelegationRequest{DelegatorAddress: delegator})
+	require.Error(err)
+	delegationRes, err := suite.queryClient.GovernanceDelegation(suite.ctx, &v1.QueryGovernanceDelegationRequest{DelegatorAddress: other})
+	require.NoError(err)
+	require.Equal(governor, delegationRes.Delegation.GovernorAddress)
+
+	delegationsRes, err = suite.queryClient.GovernorDelegations(suite.ctx, &v1.QueryGovernorDelegationsRequest{GovernorAddress: governor})
+	require.NoError(err)
+	require.Len(delegationsRes.Delegations, 1)
+	require.Equal(other, delegationsRes.Delegations[0].DelegatorAddress)
+}
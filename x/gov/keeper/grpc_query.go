@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -334,6 +335,149 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// Governor queries a governor and its voting power
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	governor, err := q.k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	votingPower, err := q.k.GovernorVotingPower(ctx, governorAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor, VotingPower: votingPower.String()}, nil
+}
+
+// Governors queries all governors
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(_ sdk.AccAddress, value v1.Governor) (*v1.Governor, error) {
+		return &value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernorDelegations queries the governance delegations to a governor
+func (q queryServer) GovernorDelegations(ctx context.Context, req *v1.QueryGovernorDelegationsRequest) (*v1.QueryGovernorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.GovernorDelegations, req.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*v1.GovernanceDelegation, error) {
+		delegation, err := q.k.GovernanceDelegations.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		return &delegation, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](governorAddr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// GovernanceDelegation queries the governance delegation of an account
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegatorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, err := q.k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governance delegation of %s doesn't exist", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{Delegation: &delegation}, nil
+}
+
+// ProposalRevisions queries the revision history of a draft proposal
+func (q queryServer) ProposalRevisions(ctx context.Context, req *v1.QueryProposalRevisionsRequest) (*v1.QueryProposalRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	revisions, pageRes, err := query.CollectionPaginate(ctx, q.k.ProposalRevisions, req.Pagination, func(_ collections.Pair[uint64, uint64], revision v1.ProposalRevision) (*v1.ProposalRevision, error) {
+		return &revision, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalRevisionsResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
+
+// QueuedProposals queries the passed proposals queued in the timelock
+func (q queryServer) QueuedProposals(ctx context.Context, req *v1.QueryQueuedProposalsRequest) (*v1.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.k.TimelockQueue, req.Pagination, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (*v1.Proposal, error) {
+		proposal, err := q.k.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryQueuedProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -5,6 +5,8 @@ import (
 
 	v5 "cosmossdk.io/x/gov/migrations/v5"
 	v6 "cosmossdk.io/x/gov/migrations/v6"
+	v7 "cosmossdk.io/x/gov/migrations/v7"
+	v8 "cosmossdk.io/x/gov/migrations/v8"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -43,3 +45,13 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.KVStoreService, m.keeper.Params, m.keeper.Proposals)
 }
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.Proposals, m.keeper.MessageBasedParams)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx context.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.Params)
+}
@@ -43,20 +43,8 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 	}
 
 	// verify that if present, the metadata title and summary equals the proposal title and summary
-	if len(msg.Metadata) != 0 {
-		proposalMetadata := govtypes.ProposalMetadata{}
-		if err := json.Unmarshal([]byte(msg.Metadata), &proposalMetadata); err == nil {
-			if proposalMetadata.Title != msg.Title {
-				return nil, errors.Wrapf(govtypes.ErrInvalidProposalContent, "metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, msg.Title)
-			}
-
-			if proposalMetadata.Summary != msg.Summary {
-				return nil, errors.Wrapf(govtypes.ErrInvalidProposalContent, "metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, msg.Summary)
-			}
-		}
-
-		// if we can't unmarshal the metadata, this means the client didn't use the recommended metadata format
-		// nothing can be done here, and this is still a valid case, so we ignore the error
+	if err := validateProposalMetadata(msg.Metadata, msg.Title, msg.Summary); err != nil {
+		return nil, err
 	}
 
 	// This method checks that all message metadata, summary and title
@@ -91,6 +79,21 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.Draft {
+		proposal, err = k.Keeper.StartDiscussionPeriod(ctx, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			govtypes.EventTypeSubmitProposal,
+			event.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(govtypes.AttributeKeyDiscussionEndTime, proposal.DiscussionEndTime.String()),
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeSubmitProposal)
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -333,7 +336,7 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 	}
 
 	// delete the message params if the params are empty
-	if msg.Params == nil || *msg.Params == (v1.MessageBasedParams{}) {
+	if msg.Params == nil || msg.Params.IsEmpty() {
 		if err := k.MessageBasedParams.Remove(ctx, msg.MsgUrl); err != nil {
 			return nil, err
 		}
@@ -407,6 +410,112 @@ func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSu
 	}, nil
 }
 
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(ctx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.CreateGovernor(ctx, addr, msg.Description); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(ctx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.EditGovernor(ctx, addr, msg.Description); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// DelegateGovernance implements the MsgServer.DelegateGovernance method.
+func (k msgServer) DelegateGovernance(ctx context.Context, msg *v1.MsgDelegateGovernance) (*v1.MsgDelegateGovernanceResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateGovernance(ctx, delegator, governor); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernanceResponse{}, nil
+}
+
+// UndelegateGovernance implements the MsgServer.UndelegateGovernance method.
+func (k msgServer) UndelegateGovernance(ctx context.Context, msg *v1.MsgUndelegateGovernance) (*v1.MsgUndelegateGovernanceResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateGovernance(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernanceResponse{}, nil
+}
+
+// AmendProposal implements the MsgServer.AmendProposal method.
+func (k msgServer) AmendProposal(ctx context.Context, msg *v1.MsgAmendProposal) (*v1.MsgAmendProposalResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Proposer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	// check that either metadata or Msgs length is non nil.
+	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
+		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+	}
+
+	if err := validateProposalMetadata(msg.Metadata, msg.Title, msg.Summary); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateProposalLengths(msg.Metadata, msg.Title, msg.Summary); err != nil {
+		return nil, err
+	}
+
+	proposalMsgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.AmendProposal(ctx, msg.ProposalId, msg.Proposer, proposalMsgs, msg.Metadata, msg.Title, msg.Summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAmendProposalResponse{Revision: proposal.Revision}, nil
+}
+
+// CancelQueuedProposal implements the MsgServer.CancelQueuedProposal method.
+func (k msgServer) CancelQueuedProposal(ctx context.Context, msg *v1.MsgCancelQueuedProposal) (*v1.MsgCancelQueuedProposalResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+	}
+
+	if err := k.Keeper.CancelQueuedProposal(ctx, msg.ProposalId, msg.Guardian); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelQueuedProposalResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -502,6 +611,29 @@ func (k legacyMsgServer) Deposit(goCtx context.Context, msg *v1beta1.MsgDeposit)
 	return &v1beta1.MsgDepositResponse{}, nil
 }
 
+// validateProposalMetadata verifies that if present, the metadata title and
+// summary equal the proposal title and summary.
+func validateProposalMetadata(metadata, title, summary string) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	proposalMetadata := govtypes.ProposalMetadata{}
+	if err := json.Unmarshal([]byte(metadata), &proposalMetadata); err == nil {
+		if proposalMetadata.Title != title {
+			return errors.Wrapf(govtypes.ErrInvalidProposalContent, "metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, title)
+		}
+
+		if proposalMetadata.Summary != summary {
+			return errors.Wrapf(govtypes.ErrInvalidProposalContent, "metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, summary)
+		}
+	}
+
+	// if we can't unmarshal the metadata, this means the client didn't use the recommended metadata format
+	// nothing can be done here, and this is still a valid case, so we ignore the error
+	return nil
+}
+
 // validateDeposit validates the deposit amount, do not use for initial deposit.
 func validateDeposit(amount sdk.Coins) error {
 	if !amount.IsValid() || !amount.IsAllPositive() {
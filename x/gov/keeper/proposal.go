@@ -33,82 +33,128 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	}
 
 	// additional checks per proposal types
-	switch proposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC {
 		if len(params.OptimisticAuthorizedAddresses) > 0 && !slices.Contains(params.OptimisticAuthorizedAddresses, proposerAddr) {
 			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposer, "proposer is not authorized to submit optimistic proposal")
 		}
-	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-		if len(messages) > 0 { // cannot happen, except when the proposal is created via keeper call instead of message server.
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, "multiple choice proposal should not contain any messages")
-		}
+	}
+
+	msgs, proposalParams, err := k.validateProposalMsgs(ctx, messages, proposalType)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	proposalID, err := k.ProposalID.Next(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	submitTime := k.HeaderService.HeaderInfo(ctx).Time
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*params.MaxDepositPeriod), metadata, title, summary, proposerAddr, proposalType)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+	proposal.MessageBasedParams = proposalParams
+
+	if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+	err = k.InactiveProposalsQueue.Set(ctx, collections.Join(*proposal.DepositEndTime, proposalID), proposalID)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	// called right after a proposal is submitted
+	err = k.Hooks().AfterProposalSubmission(ctx, proposalID)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSubmitProposal,
+		event.NewAttribute(types.AttributeKeyProposalType, proposalType.String()),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		event.NewAttribute(types.AttributeKeyProposalProposer, proposerAddr),
+		event.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgs, ",")),
+	); err != nil {
+		return v1.Proposal{}, fmt.Errorf("failed to emit event: %w", err)
+	}
+
+	return proposal, nil
+}
+
+// validateProposalMsgs checks that each message of a proposal has a handler and
+// the gov module account as the only signer. It returns the type URLs of the
+// messages and the strictest message based params applying to them, if any.
+// When some messages have message based params, the messages without any are
+// considered to have the default params.
+func (k Keeper) validateProposalMsgs(ctx context.Context, messages []sdk.Msg, proposalType v1.ProposalType) ([]string, *v1.MessageBasedParams, error) {
+	// cannot happen, except when the proposal is created via keeper call instead of message server.
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE && len(messages) > 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, "multiple choice proposal should not contain any messages")
 	}
 
 	msgs := make([]string, 0, len(messages)) // will hold a string slice of all Msg type URLs.
 
 	// Loop through all messages and confirm that each has a handler and the gov module account as the only signer
-	var currentMessagedBasedParams *v1.MessageBasedParams
+	var (
+		proposalParams *v1.MessageBasedParams
+		hasUnclassed   bool
+	)
 	for _, msg := range messages {
 		msgs = append(msgs, sdk.MsgTypeURL(msg))
 
-		// check if any of the message has message based params
-		hasMessagedBasedParams := true
-		messagedBasedParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(msg))
+		// check if any of the message has message based params, the strictest
+		// params of all messages applying to the proposal
+		messageParams, err := k.getMessageBasedParams(ctx, msg)
 		if err != nil {
-			if !errorsmod.IsOf(err, collections.ErrNotFound) {
-				return v1.Proposal{}, err
-			}
-
-			hasMessagedBasedParams = false
+			return nil, nil, err
 		}
 
-		if hasMessagedBasedParams {
-			// set initial value for currentMessagedBasedParams
-			if currentMessagedBasedParams == nil {
-				currentMessagedBasedParams = &messagedBasedParams
-			}
-
-			// check if newly fetched messagedBasedParams is different from the previous fetched params
-			isEqual, err := currentMessagedBasedParams.Equal(&messagedBasedParams)
-			if err != nil {
-				return v1.Proposal{}, err
-			}
-
-			if len(messages) > 1 && !isEqual {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, "cannot submit multiple messages proposal with different message based params")
+		if messageParams != nil {
+			if proposalType != v1.ProposalType_PROPOSAL_TYPE_STANDARD {
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidProposalType, "cannot submit non standard proposal with message based params")
 			}
 
-			if proposalType != v1.ProposalType_PROPOSAL_TYPE_STANDARD {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "cannot submit non standard proposal with message based params")
+			if proposalParams == nil {
+				proposalParams = messageParams
+			} else {
+				stricter, err := proposalParams.Stricter(*messageParams)
+				if err != nil {
+					return nil, nil, err
+				}
+				proposalParams = &stricter
 			}
+		} else {
+			hasUnclassed = true
 		}
 
 		// perform a basic validation of the message
 		if m, ok := msg.(sdk.HasValidateBasic); ok {
 			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
 			}
 		}
 
 		signers, _, err := k.cdc.GetMsgSigners(msg)
 		if err != nil {
-			return v1.Proposal{}, err
+			return nil, nil, err
 		}
 		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
+			return nil, nil, types.ErrInvalidSigner
 		}
 
 		// assert that the governance module account is the only signer of the messages
 		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
 			addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
 			if err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
+				return nil, nil, errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
 			}
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidSigner, addr)
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidSigner, addr)
 		}
 
 		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+			return nil, nil, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
 		}
 
 		// Only if it's a MsgExecLegacyContent we try to execute the
@@ -123,12 +169,12 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 
 		content, err := v1.LegacyContentFromMessage(msg)
 		if err != nil {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalContent, "%+v", err)
+			return nil, nil, errorsmod.Wrapf(types.ErrInvalidProposalContent, "%+v", err)
 		}
 
 		// Ensure that the content has a respective handler
 		if !k.legacyRouter.HasRoute(content.ProposalRoute()) {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+			return nil, nil, errorsmod.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 		}
 
 		if err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
@@ -141,46 +187,37 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 
 			return errors.New("we don't want to execute the proposal, we just want to check if it can be executed")
 		}); errors.Is(err, types.ErrInvalidProposalContent) {
-			return v1.Proposal{}, err
+			return nil, nil, err
 		}
 	}
 
-	proposalID, err := k.ProposalID.Next(ctx)
-	if err != nil {
-		return v1.Proposal{}, err
-	}
-
-	submitTime := k.HeaderService.HeaderInfo(ctx).Time
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*params.MaxDepositPeriod), metadata, title, summary, proposerAddr, proposalType)
-	if err != nil {
-		return v1.Proposal{}, err
-	}
+	if proposalParams != nil && hasUnclassed {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
-		return v1.Proposal{}, err
-	}
-	err = k.InactiveProposalsQueue.Set(ctx, collections.Join(*proposal.DepositEndTime, proposalID), proposalID)
-	if err != nil {
-		return v1.Proposal{}, err
+		stricter, err := proposalParams.Stricter(defaultMessageBasedParams(params))
+		if err != nil {
+			return nil, nil, err
+		}
+		proposalParams = &stricter
 	}
 
-	// called right after a proposal is submitted
-	err = k.Hooks().AfterProposalSubmission(ctx, proposalID)
-	if err != nil {
-		return v1.Proposal{}, err
-	}
+	return msgs, proposalParams, nil
+}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeSubmitProposal,
-		event.NewAttribute(types.AttributeKeyProposalType, proposalType.String()),
-		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-		event.NewAttribute(types.AttributeKeyProposalProposer, proposerAddr),
-		event.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgs, ",")),
-	); err != nil {
-		return v1.Proposal{}, fmt.Errorf("failed to emit event: %w", err)
+// defaultMessageBasedParams returns the global params applying to standard
+// proposals as message based params.
+func defaultMessageBasedParams(params v1.Params) v1.MessageBasedParams {
+	return v1.MessageBasedParams{
+		VotingPeriod:   params.VotingPeriod,
+		Quorum:         params.Quorum,
+		YesQuorum:      params.YesQuorum,
+		Threshold:      params.Threshold,
+		VetoThreshold:  params.VetoThreshold,
+		ExecutionDelay: params.ExecutionDelay,
 	}
-
-	return proposal, nil
 }
 
 // CancelProposal will cancel proposal before the voting period ends
@@ -275,6 +312,16 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 			return err
 		}
 	}
+	if proposal.DiscussionEndTime != nil {
+		err := k.DiscussionProposalsQueue.Remove(ctx, collections.Join(*proposal.DiscussionEndTime, proposalID))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.deleteProposalRevisions(ctx, proposalID); err != nil {
+		return err
+	}
 
 	return k.Proposals.Remove(ctx, proposalID)
 }
@@ -296,14 +343,9 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 	default:
 		votingPeriod = params.VotingPeriod
 
-		if len(proposal.Messages) > 0 {
-			// check if any of the message has message based params
-			customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
-			if err == nil {
-				votingPeriod = customMessageParams.VotingPeriod
-			} else if !errors.Is(err, collections.ErrNotFound) {
-				return err
-			}
+		// use the message based params resolved at submission, if any
+		if proposal.MessageBasedParams != nil {
+			votingPeriod = proposal.MessageBasedParams.VotingPeriod
 		}
 	}
 
@@ -320,3 +362,24 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 
 	return k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
 }
+
+// getMessageBasedParams returns the message based params applying to a message,
+// or nil if the message uses the default gov params. Params with a minimum
+// amount only apply to messages exposing an amount reaching it.
+func (k Keeper) getMessageBasedParams(ctx context.Context, msg sdk.Msg) (*v1.MessageBasedParams, error) {
+	params, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(msg))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !params.MinAmount.Empty() {
+		msgWithAmount, ok := msg.(interface{ GetAmount() sdk.Coins })
+		if !ok || !params.AppliesTo(msgWithAmount.GetAmount()) {
+			return nil, nil
+		}
+	}
+
+	return &params, nil
+}
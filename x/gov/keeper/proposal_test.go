@@ -1,7 +1,6 @@
 package keeper_test
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -10,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -147,8 +148,8 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgUpdateParams{Authority: govAcct}}, "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, nil},
 		// normal proposal with 2 msgs with custom params shared the same value
 		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgSudoExec{Authority: govAcct}}, "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, nil},
-		// normal proposal with 2 msgs with different custom params
-		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgCancelProposal{}}, "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, errors.New("cannot submit multiple messages proposal with different message based params")},
+		// normal proposal with 2 msgs with different custom params, the strictest params apply
+		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgCancelProposal{Proposer: govAcct}}, "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, nil},
 		{legacyProposal(&tp, govAcct), "", v1.ProposalType_PROPOSAL_TYPE_EXPEDITED, nil},
 		{nil, "", v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, nil},
 		// Keeper does not check the validity of title and description, no error
@@ -162,8 +163,6 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		{legacyProposal(&invalidProposalRoute{}, govAcct), "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, types.ErrNoProposalHandlerExists},
 		// error invalid multiple choice proposal
 		{legacyProposal(&tp, govAcct), "", v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, types.ErrInvalidProposalMsg},
-		// error invalid multiple msg proposal with 1 msg with custom params
-		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgCancelProposal{Proposer: govAcct}}, "", v1.ProposalType_PROPOSAL_TYPE_STANDARD, types.ErrInvalidProposalMsg},
 		// error invalid msg proposal type with 1 msg with custom params
 		{[]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}}, "", v1.ProposalType_PROPOSAL_TYPE_EXPEDITED, types.ErrInvalidProposalType},
 	}
@@ -176,6 +175,90 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMessageBasedParams() {
+	suite.reset()
+	govAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
+	suite.Require().NoError(err)
+	duration := func(d time.Duration) *time.Duration { return &d }
+
+	// upgrades need a higher quorum and threshold
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(suite.ctx, sdk.MsgTypeURL(&v1.MsgUpdateParams{}), v1.MessageBasedParams{
+		VotingPeriod:  duration(24 * time.Hour),
+		Quorum:        "0.5",
+		YesQuorum:     "0",
+		Threshold:     "0.67",
+		VetoThreshold: "0.334",
+	}))
+	// large transfers need a longer voting period
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(suite.ctx, sdk.MsgTypeURL(&banktypes.MsgCreateScheduledTransfer{}), v1.MessageBasedParams{
+		VotingPeriod:  duration(7 * 24 * time.Hour),
+		Quorum:        "0.4",
+		YesQuorum:     "0",
+		Threshold:     "0.5",
+		VetoThreshold: "0.3",
+		MinAmount:     sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}))
+
+	transfer := func(amount int64) sdk.Msg {
+		return &banktypes.MsgCreateScheduledTransfer{
+			Sender:    govAcct,
+			Recipient: govAcct,
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		expParams *v1.MessageBasedParams
+	}{
+		{"no message based params", []sdk.Msg{&v1.MsgCancelProposal{Proposer: govAcct}}, nil},
+		{"amount below the minimum amount", []sdk.Msg{transfer(999)}, nil},
+		{"amount reaching the minimum amount", []sdk.Msg{transfer(1000)}, &v1.MessageBasedParams{
+			VotingPeriod:  duration(7 * 24 * time.Hour),
+			Quorum:        "0.4",
+			YesQuorum:     "0",
+			Threshold:     "0.5",
+			VetoThreshold: "0.3",
+		}},
+		{"strictest params of mixed messages", []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, transfer(1000)}, &v1.MessageBasedParams{
+			VotingPeriod:  duration(7 * 24 * time.Hour),
+			Quorum:        sdkmath.LegacyNewDecWithPrec(5, 1).String(),
+			YesQuorum:     sdkmath.LegacyZeroDec().String(),
+			Threshold:     sdkmath.LegacyNewDecWithPrec(67, 2).String(),
+			VetoThreshold: sdkmath.LegacyNewDecWithPrec(3, 1).String(),
+		}},
+		{"default params of unclassed messages in mixed messages", []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct}, &v1.MsgCancelProposal{Proposer: govAcct}}, &v1.MessageBasedParams{
+			VotingPeriod:  duration(v1.DefaultPeriod),
+			Quorum:        sdkmath.LegacyNewDecWithPrec(5, 1).String(),
+			YesQuorum:     sdkmath.LegacyZeroDec().String(),
+			Threshold:     sdkmath.LegacyNewDecWithPrec(67, 2).String(),
+			VetoThreshold: v1.DefaultVetoThreshold.String(),
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tc.msgs, "", "title", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			suite.Require().NoError(err)
+			if tc.expParams == nil {
+				suite.Require().Nil(proposal.MessageBasedParams)
+				return
+			}
+			suite.Require().Equal(*tc.expParams.VotingPeriod, *proposal.MessageBasedParams.VotingPeriod)
+			suite.Require().Equal(tc.expParams.Quorum, proposal.MessageBasedParams.Quorum)
+			suite.Require().Equal(tc.expParams.Threshold, proposal.MessageBasedParams.Threshold)
+			suite.Require().Equal(tc.expParams.VetoThreshold, proposal.MessageBasedParams.VetoThreshold)
+
+			// the voting period of the resolved params applies
+			suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+			proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposal.VotingStartTime.Add(*tc.expParams.VotingPeriod), *proposal.VotingEndTime)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCancelProposal() {
 	govAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
 	suite.Require().NoError(err)
@@ -1,8 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
-	"errors"
+	"slices"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -18,16 +19,18 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, v1.TallyResult{}, err
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
-	}
-
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
-	params, err := k.Params.Get(ctx)
+	// the tally function of the app takes precedence over the tally strategy
+	calculateVoteResultsAndVotingPowerFn := k.config.CalculateVoteResultsAndVotingPowerFn
+	if calculateVoteResultsAndVotingPowerFn == nil {
+		calculateVoteResultsAndVotingPowerFn = NewTallyStrategyFn(params.TallyStrategy)
+	}
+
+	totalVoterPower, results, err := calculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -77,17 +80,12 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 	thresholdStr := params.Threshold
 	vetoThresholdStr := params.VetoThreshold
 
-	if len(proposal.Messages) > 0 {
-		// check if any of the message has message based params
-		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
-		if err != nil && !errors.Is(err, collections.ErrNotFound) {
-			return false, false, tallyResults, err
-		} else if err == nil {
-			quorumStr = customMessageParams.GetQuorum()
-			thresholdStr = customMessageParams.GetThreshold()
-			vetoThresholdStr = customMessageParams.GetVetoThreshold()
-			yesQuorumStr = customMessageParams.GetYesQuorum()
-		}
+	// use the message based params resolved at submission, if any
+	if customMessageParams := proposal.MessageBasedParams; customMessageParams != nil {
+		quorumStr = customMessageParams.GetQuorum()
+		thresholdStr = customMessageParams.GetThreshold()
+		vetoThresholdStr = customMessageParams.GetVetoThreshold()
+		yesQuorumStr = customMessageParams.GetYesQuorum()
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
@@ -243,69 +241,117 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
+	ballots, err := k.collectBallots(ctx, proposalID, validators)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	for _, b := range ballots {
+		addBallotResults(results, b.power, b.options)
+		totalVP = totalVP.Add(b.power)
+	}
+
+	return totalVP, results, nil
+}
+
+// ballot is the stake weighted voting power and the vote of a voter.
+type ballot struct {
+	power   math.LegacyDec
+	options v1.WeightedVoteOptions
+}
+
+// collectBallots iterates over all votes of a proposal, removing them from the
+// store, and returns the ballots of the voters in a deterministic order.
+// Validators vote with the power of their delegators who did not vote, and
+// governors with the power of their delegators who did not vote, each of them
+// getting its own ballot.
+func (k Keeper) collectBallots(ctx context.Context, proposalID uint64, validators map[string]v1.ValidatorGovInfo) ([]*ballot, error) {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+	votes, err := k.Votes.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := votes.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	voted := make(map[string]bool, len(kvs))
+	for _, kv := range kvs {
+		voted[string(kv.Key.K2())] = true
+	}
+
+	var ballots []*ballot
+	byVoter := make(map[string]*ballot, len(kvs))
+	for _, kv := range kvs {
+		vote := kv.Value
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
 		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
-
 		if val, ok := validators[valAddrStr]; ok {
 			val.Vote = vote.Options
 			validators[valAddrStr] = val
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
+		votingPower, err := k.deductDelegatorVotingPower(ctx, voter, validators)
+		if err != nil {
+			return nil, err
+		}
+		b := &ballot{power: votingPower, options: vote.Options}
+		ballots = append(ballots, b)
+		byVoter[string(voter)] = b
 
-				totalVP = totalVP.Add(votingPower)
-			}
+		// a governor votes with the voting power of its delegators who did not
+		// vote themselves
+		isGovernor, err := k.Governors.Has(ctx, voter)
+		if err != nil {
+			return nil, err
+		}
+		if !isGovernor {
+			continue
+		}
 
-			return false
-		})
+		delegators, err := k.getGovernorDelegators(ctx, voter)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
+		for _, delegator := range delegators {
+			if voted[string(delegator)] {
+				continue
+			}
 
-		votesToRemove = append(votesToRemove, key)
-		return false, nil
-	}); err != nil {
-		return math.LegacyDec{}, nil, err
+			votingPower, err := k.deductDelegatorVotingPower(ctx, delegator, validators)
+			if err != nil {
+				return nil, err
+			}
+			ballots = append(ballots, &ballot{power: votingPower, options: vote.Options})
+		}
 	}
 
 	// remove all votes from store
-	for _, key := range votesToRemove {
-		if err := k.Votes.Remove(ctx, key); err != nil {
-			return math.LegacyDec{}, nil, err
+	for _, kv := range kvs {
+		if err := k.Votes.Remove(ctx, kv.Key); err != nil {
+			return nil, err
 		}
 	}
 
-	// iterate over the validators again to tally their voting power
+	// add the remaining voting power of the validators to their ballot, sorted
+	// by validator address so the order of the ballots is deterministic
+	vals := make([]v1.ValidatorGovInfo, 0, len(validators))
 	for _, val := range validators {
+		vals = append(vals, val)
+	}
+	slices.SortFunc(vals, func(a, b v1.ValidatorGovInfo) int {
+		return bytes.Compare(a.Address, b.Address)
+	})
+	for _, val := range vals {
 		if len(val.Vote) == 0 {
 			continue
 		}
@@ -313,15 +359,46 @@ func defaultCalculateVoteResultsAndVotingPower(
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+		if b, ok := byVoter[string(val.Address)]; ok {
+			b.power = b.power.Add(votingPower)
+		} else {
+			ballots = append(ballots, &ballot{power: votingPower, options: val.Vote})
 		}
-		totalVP = totalVP.Add(votingPower)
 	}
 
-	return totalVP, results, nil
+	return ballots, nil
+}
+
+// addBallotResults adds a voting power to the results of the vote options.
+func addBallotResults(results map[v1.VoteOption]math.LegacyDec, votingPower math.LegacyDec, options v1.WeightedVoteOptions) {
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		subPower := votingPower.Mul(weight)
+		results[option.Option] = results[option.Option].Add(subPower)
+	}
+}
+
+// deductDelegatorVotingPower deducts the delegations of a delegator from the
+// shares of the bonded validators it delegates to and returns its voting power.
+func (k Keeper) deductDelegatorVotingPower(ctx context.Context, delegator sdk.AccAddress, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+	err := k.sk.IterateDelegations(ctx, delegator, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			// delegation shares * bonded / total shares
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false
+	})
+
+	return votingPower, err
 }
 
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// NewTallyStrategyFn returns the vote results and voting power calculation of a
+// tally strategy.
+//
+// Strategies other than the stake weighted one reweight the ballots of the
+// voters while keeping the total voting power equal to the bonded stake of the
+// counted voters, so that the quorum keeps being measured against the total
+// bonded tokens.
+func NewTallyStrategyFn(strategy v1.TallyStrategy) CalculateVoteResultsAndVotingPowerFn {
+	switch strategy {
+	case v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC:
+		return newWeightedTallyFn(quadraticVotingPower)
+	case v1.TallyStrategy_TALLY_STRATEGY_CAPPED:
+		return newWeightedTallyFn(cappedVotingPower)
+	case v1.TallyStrategy_TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE:
+		return newWeightedTallyFn(oneAccountOneVoteVotingPower)
+	default:
+		return defaultCalculateVoteResultsAndVotingPower
+	}
+}
+
+// votingPowerFn returns the voting power of a voter given its stake weighted
+// voting power. Voters with no voting power are not counted.
+type votingPowerFn func(stake math.LegacyDec) (math.LegacyDec, error)
+
+// newVotingPowerFn returns the votingPowerFn of a tally.
+type newVotingPowerFn func(ctx context.Context, k Keeper, validators map[string]v1.ValidatorGovInfo) (votingPowerFn, error)
+
+// newWeightedTallyFn returns a vote results and voting power calculation
+// reweighting the ballots of the voters with a votingPowerFn.
+func newWeightedTallyFn(newFn newVotingPowerFn) CalculateVoteResultsAndVotingPowerFn {
+	return func(
+		ctx context.Context,
+		k Keeper,
+		proposalID uint64,
+		validators map[string]v1.ValidatorGovInfo,
+	) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+		votingPowerOf, err := newFn(ctx, k, validators)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		ballots, err := k.collectBallots(ctx, proposalID, validators)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		powers := make([]math.LegacyDec, len(ballots))
+		totalStake, totalPower := math.LegacyZeroDec(), math.LegacyZeroDec()
+		for i, b := range ballots {
+			if powers[i], err = votingPowerOf(b.power); err != nil {
+				return math.LegacyDec{}, nil, err
+			}
+			if !powers[i].IsPositive() {
+				continue
+			}
+
+			totalStake = totalStake.Add(b.power)
+			totalPower = totalPower.Add(powers[i])
+		}
+
+		totalVP := math.LegacyZeroDec()
+		results := createEmptyResults()
+		if !totalPower.IsPositive() {
+			return totalVP, results, nil
+		}
+
+		for i, b := range ballots {
+			if !powers[i].IsPositive() {
+				continue
+			}
+
+			// scale the voting power back to the bonded stake of the voters
+			votingPower := powers[i].Mul(totalStake).Quo(totalPower)
+			addBallotResults(results, votingPower, b.options)
+			totalVP = totalVP.Add(votingPower)
+		}
+
+		return totalVP, results, nil
+	}
+}
+
+// quadraticVotingPower gives each voter the square root of its stake.
+func quadraticVotingPower(context.Context, Keeper, map[string]v1.ValidatorGovInfo) (votingPowerFn, error) {
+	return func(stake math.LegacyDec) (math.LegacyDec, error) {
+		return stake.ApproxSqrt()
+	}, nil
+}
+
+// cappedVotingPower caps the stake of each voter to MaxVoterPower of the total
+// bonded tokens.
+func cappedVotingPower(ctx context.Context, k Keeper, validators map[string]v1.ValidatorGovInfo) (votingPowerFn, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxVoterPower, err := math.LegacyNewDecFromStr(params.MaxVoterPower)
+	if err != nil {
+		return nil, err
+	}
+
+	totalBonded := math.ZeroInt()
+	for _, val := range validators {
+		totalBonded = totalBonded.Add(val.BondedTokens)
+	}
+	maxPower := maxVoterPower.MulInt(totalBonded)
+
+	return func(stake math.LegacyDec) (math.LegacyDec, error) {
+		return math.LegacyMinDec(stake, maxPower), nil
+	}, nil
+}
+
+// oneAccountOneVoteVotingPower gives the same voting power to each voter with a
+// stake of at least MinVoterStake.
+func oneAccountOneVoteVotingPower(ctx context.Context, k Keeper, _ map[string]v1.ValidatorGovInfo) (votingPowerFn, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minVoterStake, ok := math.NewIntFromString(params.MinVoterStake)
+	if !ok {
+		return nil, fmt.Errorf("invalid min voter stake: %s", params.MinVoterStake)
+	}
+
+	return func(stake math.LegacyDec) (math.LegacyDec, error) {
+		if !stake.IsPositive() || stake.LT(minVoterStake.ToLegacyDec()) {
+			return math.LegacyZeroDec(), nil
+		}
+		return math.LegacyOneDec(), nil
+	}, nil
+}
@@ -38,16 +38,27 @@ var (
 	delegatorVote = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption) {
 		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
 		require.NoError(s.t, err)
+		delegatorDelegations(s, voter, delegations)
+	}
+	delegatorDelegations = func(s tallyFixture, delegator sdk.AccAddress, delegations []stakingtypes.Delegation) {
 		s.mocks.stakingKeeper.EXPECT().
-			IterateDelegations(s.ctx, voter, gomock.Any()).
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
 			DoAndReturn(
-				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
 					for i, d := range delegations {
 						fn(int64(i), d)
 					}
 					return nil
 				})
 	}
+	governanceDelegation = func(s tallyFixture, delegator, governor sdk.AccAddress) {
+		has, err := s.keeper.Governors.Has(s.ctx, governor)
+		require.NoError(s.t, err)
+		if !has {
+			require.NoError(s.t, s.keeper.CreateGovernor(s.ctx, governor, v1.GovernorDescription{Moniker: "governor"}))
+		}
+		require.NoError(s.t, s.keeper.DelegateGovernance(s.ctx, delegator, governor))
+	}
 	validatorVote = func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
@@ -276,6 +287,95 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			// the governor has no delegations of its own
+			// the first delegator delegates 42 shares to the first validator
+			// the second delegator delegates 10 shares to the second validator
+			// neither delegator votes, the governor votes yes
+			// first validator votes no
+			name: "governor votes with the power of its delegators: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del1Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[1])
+				require.NoError(t, err)
+				del2Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[2])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				val1Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[1])
+				require.NoError(t, err)
+				governanceDelegation(s, s.delAddrs[1], s.delAddrs[0])
+				governanceDelegation(s, s.delAddrs[2], s.delAddrs[0])
+				delegatorDelegations(s, s.delAddrs[1], []stakingtypes.Delegation{{
+					DelegatorAddress: del1Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}})
+				delegatorDelegations(s, s.delAddrs[2], []stakingtypes.Delegation{{
+					DelegatorAddress: del2Addr,
+					ValidatorAddress: val1Addr,
+					Shares:           sdkmath.LegacyNewDec(10),
+				}})
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "52",
+				AbstainCount:     "0",
+				NoCount:          "999958",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "52",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999958",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// the governor has no delegations of its own and votes yes
+			// the first delegator delegates 42 shares to the first validator and votes no
+			// the second delegator delegates 10 shares to the second validator
+			name: "delegator vote overrides its governor vote: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del1Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[1])
+				require.NoError(t, err)
+				del2Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[2])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				val1Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[1])
+				require.NoError(t, err)
+				governanceDelegation(s, s.delAddrs[1], s.delAddrs[0])
+				governanceDelegation(s, s.delAddrs[2], s.delAddrs[0])
+				delegatorVote(s, s.delAddrs[1], []stakingtypes.Delegation{{
+					DelegatorAddress: del1Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorDelegations(s, s.delAddrs[2], []stakingtypes.Delegation{{
+					DelegatorAddress: del2Addr,
+					ValidatorAddress: val1Addr,
+					Shares:           sdkmath.LegacyNewDec(10),
+				}})
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "10",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "10",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "quorum reached with only abstain: prop fails",
 			setup: func(s tallyFixture) {
@@ -1546,3 +1646,128 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_Strategies(t *testing.T) {
+	// the first validator votes yes with 1000000 of voting power
+	// the first delegator votes no with 10000 shares delegated to the second validator
+	// the second delegator votes no with 40000 shares delegated to the third validator
+	setup := func(s tallyFixture) {
+		setTotalBonded(s, 10000000)
+		for i, shares := range []int64{10000, 40000} {
+			delAddr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[i])
+			require.NoError(t, err)
+			valAddr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[i+1])
+			require.NoError(t, err)
+			delegatorVote(s, s.delAddrs[i], []stakingtypes.Delegation{{
+				DelegatorAddress: delAddr,
+				ValidatorAddress: valAddr,
+				Shares:           sdkmath.LegacyNewDec(shares),
+			}}, v1.VoteOption_VOTE_OPTION_THREE)
+		}
+		validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+	}
+
+	tests := []struct {
+		name          string
+		strategy      v1.TallyStrategy
+		maxVoterPower string
+		minVoterStake string
+		expectedYes   string
+		expectedNo    string
+	}{
+		{
+			name:        "stake weighted",
+			strategy:    v1.TallyStrategy_TALLY_STRATEGY_STAKE_WEIGHTED,
+			expectedYes: "1000000",
+			expectedNo:  "50000",
+		},
+		{
+			// voting powers of 1000, 100 and 200 scaled back to the 1050000 of stake
+			name:        "quadratic",
+			strategy:    v1.TallyStrategy_TALLY_STRATEGY_QUADRATIC,
+			expectedYes: "807692",
+			expectedNo:  "242307",
+		},
+		{
+			// voting powers of 500000, 10000 and 40000 scaled back to the 1050000 of stake
+			name:          "capped",
+			strategy:      v1.TallyStrategy_TALLY_STRATEGY_CAPPED,
+			maxVoterPower: "0.05",
+			expectedYes:   "954545",
+			expectedNo:    "95454",
+		},
+		{
+			// the first delegator is not counted, voting powers of 1 and 1 scaled
+			// back to the 1040000 of stake
+			name:          "one account one vote",
+			strategy:      v1.TallyStrategy_TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE,
+			minVoterStake: "20000",
+			expectedYes:   "520000",
+			expectedNo:    "520000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.BurnVoteQuorum = true
+			params.TallyStrategy = tt.strategy
+			if tt.maxVoterPower != "" {
+				params.MaxVoterPower = tt.maxVoterPower
+			}
+			if tt.minVoterStake != "" {
+				params.MinVoterStake = tt.minVoterStake
+			}
+			require.NoError(t, params.ValidateBasic(address.NewBech32Codec("cosmos")))
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			setup(tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			})
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.False(t, pass, "wrong pass")
+			assert.True(t, burn, "wrong burn") // burn because quorum not reached
+			assert.Equal(t, tt.expectedYes, tally.YesCount)
+			assert.Equal(t, tt.expectedNo, tally.NoCount)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelQueuedProposal cancels a passed proposal queued in the timelock. It can
+// only be called by the timelock guardian.
+func (k Keeper) CancelQueuedProposal(ctx context.Context, proposalID uint64, guardian string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.TimelockGuardian == "" || params.TimelockGuardian != guardian {
+		return types.ErrInvalidSigner.Wrapf("invalid timelock guardian; expected %s, got %s", params.TimelockGuardian, guardian)
+	}
+
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return err
+	}
+
+	if proposal.Status != v1.StatusQueued {
+		return types.ErrInvalidProposal.Wrapf("proposal %d is not queued in the timelock", proposalID)
+	}
+
+	if err := k.TimelockQueue.Remove(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id)); err != nil {
+		return err
+	}
+
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusRejected
+	proposal.FailedReason = "proposal was canceled by the timelock guardian"
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+
+	k.Logger.Info("queued proposal is canceled by the timelock guardian", "proposal", proposal.Id, "guardian", guardian)
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeQueuedProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalCanceled),
+	)
+}
+
+// executionDelay returns the execution delay of a passed proposal: the one of
+// the message based params resolved at submission, if any, or else the one of
+// the gov params.
+func executionDelay(proposal v1.Proposal, params v1.Params) time.Duration {
+	if proposal.MessageBasedParams != nil && proposal.MessageBasedParams.ExecutionDelay != nil {
+		return *proposal.MessageBasedParams.ExecutionDelay
+	}
+
+	if params.ExecutionDelay != nil {
+		return *params.ExecutionDelay
+	}
+
+	return 0
+}
+
+// queueProposal queues a passed proposal in the timelock until its execution delay elapses.
+func (k Keeper) queueProposal(ctx context.Context, proposal *v1.Proposal, delay time.Duration) error {
+	executionTime := k.HeaderService.HeaderInfo(ctx).Time.Add(delay)
+	proposal.ExecutionTime = &executionTime
+	proposal.Status = v1.StatusQueued
+
+	if err := k.TimelockQueue.Set(ctx, collections.Join(executionTime, proposal.Id), proposal.Id); err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeQueuedProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()),
+	); err != nil {
+		k.Logger.Error("failed to emit event", "error", err)
+	}
+
+	return nil
+}
+
+// processTimelockQueue executes the queued proposals whose execution delay has
+// elapsed, unless they have been vetoed during the delay.
+func (k Keeper) processTimelockQueue(ctx context.Context, params v1.Params) error {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](k.HeaderService.HeaderInfo(ctx).Time)
+	iter, err := k.TimelockQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.TimelockQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		var tagValue, logMsg string
+
+		// a proposal whose veto votes can't be tallied fails rather than halting
+		// the chain
+		vetoed, err := k.isVetoedInTimelock(ctx, proposal, params)
+		if err != nil {
+			k.Logger.Error("failed to tally timelock veto votes", "proposal", proposal.Id, "error", err)
+			// the tally may have failed before removing the votes
+			if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+				return err
+			}
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = fmt.Sprintf("failed to tally timelock veto votes: %s", err)
+			tagValue = types.AttributeValueProposalFailed
+			logMsg = proposal.FailedReason
+		} else if vetoed {
+			proposal.Status = v1.StatusRejected
+			proposal.FailedReason = "proposal was vetoed during its execution delay"
+			tagValue = types.AttributeValueProposalVetoed
+			logMsg = "vetoed"
+		} else {
+			tagValue, logMsg = k.executeProposal(ctx, &proposal, params)
+		}
+
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+
+		k.Logger.Info(
+			"queued proposal processed",
+			"proposal", proposal.Id,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeQueuedProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+	}
+
+	return nil
+}
+
+// isVetoedInTimelock tallies the veto votes cast on a queued proposal during its
+// execution delay, and returns true if they reach the timelock veto threshold
+// of the total bonded stake.
+func (k Keeper) isVetoedInTimelock(ctx context.Context, proposal v1.Proposal, params v1.Params) (bool, error) {
+	iter, err := k.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id))
+	if err != nil {
+		return false, err
+	}
+	hasVotes := iter.Valid()
+	if err := iter.Close(); err != nil {
+		return false, err
+	}
+
+	if !hasVotes {
+		return false, nil
+	}
+
+	// the threshold can be unset when the execution delay only comes from the
+	// message based params, the default threshold applies then
+	vetoThreshold := v1.DefaultTimelockVetoThreshold
+	if params.TimelockVetoThreshold != "" {
+		vetoThreshold, err = math.LegacyNewDecFromStr(params.TimelockVetoThreshold)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, _, tallyResults, err := k.Tally(ctx, proposal)
+	if err != nil {
+		return false, err
+	}
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, nil
+	}
+
+	vetoCount, err := math.LegacyNewDecFromStr(tallyResults.NoWithVetoCount)
+	if err != nil {
+		return false, err
+	}
+
+	return vetoCount.Quo(math.LegacyNewDecFromInt(totalBonded)).GTE(vetoThreshold), nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// timelockVote is like validatorVote but doesn't expect the delegations of the
+// voter to be iterated once, as queued proposals are tallied again before execution.
+func timelockVote(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
+	err := s.keeper.AddVote(s.ctx, s.proposal.Id, sdk.AccAddress(voter), v1.NewNonSplitVoteOption(vote), "")
+	require.NoError(s.t, err)
+}
+
+func TestTimelock(t *testing.T) {
+	tests := []struct {
+		name      string
+		malleate  func(s tallyFixture, guardian string)
+		expStatus v1.ProposalStatus
+	}{
+		{
+			name:      "queued proposal is executed after the delay",
+			malleate:  func(tallyFixture, string) {},
+			expStatus: v1.StatusPassed,
+		},
+		{
+			name: "queued proposal is vetoed during the delay",
+			malleate: func(s tallyFixture, _ string) {
+				for _, valAddr := range s.valAddrs[:4] {
+					timelockVote(s, valAddr, v1.OptionNoWithVeto)
+				}
+			},
+			expStatus: v1.StatusRejected,
+		},
+		{
+			name: "queued proposal is not vetoed below the veto threshold",
+			malleate: func(s tallyFixture, _ string) {
+				for _, valAddr := range s.valAddrs[:3] {
+					timelockVote(s, valAddr, v1.OptionNoWithVeto)
+				}
+			},
+			expStatus: v1.StatusPassed,
+		},
+		{
+			name: "unset veto threshold falls back to the default",
+			malleate: func(s tallyFixture, _ string) {
+				params, err := s.keeper.Params.Get(s.ctx)
+				require.NoError(s.t, err)
+				params.TimelockVetoThreshold = ""
+				require.NoError(s.t, s.keeper.Params.Set(s.ctx, params))
+
+				for _, valAddr := range s.valAddrs[:4] {
+					timelockVote(s, valAddr, v1.OptionNoWithVeto)
+				}
+			},
+			expStatus: v1.StatusRejected,
+		},
+		{
+			name: "queued proposal fails without halting when veto votes can't be tallied",
+			malleate: func(s tallyFixture, _ string) {
+				params, err := s.keeper.Params.Get(s.ctx)
+				require.NoError(s.t, err)
+				params.TimelockVetoThreshold = "invalid"
+				require.NoError(s.t, s.keeper.Params.Set(s.ctx, params))
+
+				timelockVote(s, s.valAddrs[0], v1.OptionNoWithVeto)
+			},
+			expStatus: v1.StatusFailed,
+		},
+		{
+			name: "queued proposal is canceled by the guardian",
+			malleate: func(s tallyFixture, guardian string) {
+				err := s.keeper.CancelQueuedProposal(s.ctx, s.proposal.Id, sdk.AccAddress(s.valAddrs[0]).String())
+				require.ErrorIs(s.t, err, types.ErrInvalidSigner)
+				require.NoError(s.t, s.keeper.CancelQueuedProposal(s.ctx, s.proposal.Id, guardian))
+			},
+			expStatus: v1.StatusRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			var (
+				numVals  = 10
+				addrs    = simtestutil.CreateRandomAccounts(numVals + 1)
+				valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs = addrs[numVals:]
+			)
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(10000000), nil).AnyTimes()
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					}).AnyTimes()
+			// validators vote without delegations
+			mocks.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			govAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(govAcct)
+			require.NoError(t, err)
+			guardian, err := mocks.acctKeeper.AddressCodec().BytesToString(delAddrs[0])
+			require.NoError(t, err)
+
+			params := v1.DefaultParams()
+			executionDelay := time.Hour
+			params.ExecutionDelay = &executionDelay
+			params.TimelockGuardian = guardian
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			// Submit, activate and pass a proposal
+			msgs := []sdk.Msg{&v1.MsgUpdateParams{Authority: govAddr, Params: v1.DefaultParams()}}
+			proposal, err := govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+			proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+
+			s := tallyFixture{t: t, proposal: proposal, valAddrs: valAddrs, delAddrs: delAddrs, ctx: ctx, keeper: govKeeper, mocks: mocks}
+			for _, valAddr := range valAddrs[:5] {
+				timelockVote(s, valAddr, v1.OptionYes)
+			}
+
+			s.ctx = ctx.WithHeaderInfo(header.Info{Time: proposal.VotingEndTime.Add(time.Second)})
+			require.NoError(t, govKeeper.EndBlocker(s.ctx))
+
+			// the passed proposal is queued in the timelock
+			proposal, err = govKeeper.Proposals.Get(s.ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusQueued, proposal.Status)
+			require.Equal(t, s.ctx.HeaderInfo().Time.Add(executionDelay), *proposal.ExecutionTime)
+
+			res, err := keeper.NewQueryServer(govKeeper).QueuedProposals(s.ctx, &v1.QueryQueuedProposalsRequest{})
+			require.NoError(t, err)
+			require.Len(t, res.Proposals, 1)
+
+			// queued proposals can only be vetoed
+			err = govKeeper.AddVote(s.ctx, proposal.Id, sdk.AccAddress(valAddrs[0]), v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.ErrorIs(t, err, types.ErrInvalidVote)
+
+			tt.malleate(s, guardian)
+
+			s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: proposal.ExecutionTime.Add(time.Second)})
+			require.NoError(t, govKeeper.EndBlocker(s.ctx))
+
+			proposal, err = govKeeper.Proposals.Get(s.ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatus, proposal.Status, proposal.FailedReason)
+
+			// the votes of the processed proposal are removed
+			votes, err := keeper.NewQueryServer(govKeeper).Votes(s.ctx, &v1.QueryVotesRequest{ProposalId: proposal.Id})
+			require.NoError(t, err)
+			require.Empty(t, votes.Votes)
+
+			res, err = keeper.NewQueryServer(govKeeper).QueuedProposals(s.ctx, &v1.QueryQueuedProposalsRequest{})
+			require.NoError(t, err)
+			require.Empty(t, res.Proposals)
+		})
+	}
+}
@@ -26,8 +26,8 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
-	// check if proposal is in voting period.
-	if proposal.Status != v1.StatusVotingPeriod {
+	// check if proposal is in voting period, or queued in the timelock where it can only be vetoed.
+	if proposal.Status != v1.StatusVotingPeriod && proposal.Status != v1.StatusQueued {
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
@@ -41,6 +41,14 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	}
 
 	for _, option := range options {
+		if proposal.Status == v1.StatusQueued {
+			if option.Option != v1.OptionNoWithVeto || !v1.ValidWeightedVoteOption(*option) {
+				return errors.Wrap(types.ErrInvalidVote, "queued proposals can only be vetoed")
+			}
+
+			continue
+		}
+
 		switch proposal.ProposalType {
 		case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
 			if option.Option != v1.OptionNo {
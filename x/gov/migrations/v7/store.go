@@ -0,0 +1,35 @@
+package v7
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+//
+// Resolution of the message based params of the proposals in deposit or voting
+// period from their first message, as done at tally time before v7.
+func MigrateStore(ctx context.Context, proposalCollection collections.Map[uint64, v1.Proposal], messageBasedParamsCollection collections.Map[string, v1.MessageBasedParams]) error {
+	return proposalCollection.Walk(ctx, nil, func(key uint64, proposal v1.Proposal) (bool, error) {
+		if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+			return false, nil
+		}
+		if len(proposal.Messages) == 0 {
+			return false, nil
+		}
+
+		params, err := messageBasedParamsCollection.Get(ctx, proposal.Messages[0].TypeUrl)
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+
+		proposal.MessageBasedParams = &params
+		return false, proposalCollection.Set(ctx, key, proposal)
+	})
+}
@@ -0,0 +1,63 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/gov"
+	v7 "cosmossdk.io/x/gov/migrations/v7"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, gov.AppModule{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	proposalCollection := collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc))
+	messageBasedParamsCollection := collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc))
+
+	votingPeriod := 7 * 24 * time.Hour
+	params := v1.MessageBasedParams{
+		VotingPeriod:  &votingPeriod,
+		Quorum:        "0.5",
+		YesQuorum:     "0",
+		Threshold:     "0.67",
+		VetoThreshold: "0.334",
+	}
+	require.NoError(t, messageBasedParamsCollection.Set(ctx, sdk.MsgTypeURL(&v1.MsgUpdateParams{}), params))
+
+	msgs := []sdk.Msg{&v1.MsgUpdateParams{}}
+	for id, status := range map[uint64]v1.ProposalStatus{1: v1.StatusVotingPeriod, 2: v1.StatusPassed} {
+		proposal, err := v1.NewProposal(msgs, id, time.Now(), time.Now(), "", "title", "summary", "", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		require.NoError(t, err)
+		proposal.Status = status
+		require.NoError(t, proposalCollection.Set(ctx, id, proposal))
+	}
+
+	// Run migrations.
+	err := v7.MigrateStore(ctx, proposalCollection, messageBasedParamsCollection)
+	require.NoError(t, err)
+
+	// Check proposals
+	proposal, err := proposalCollection.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, &params, proposal.MessageBasedParams)
+
+	proposal, err = proposalCollection.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Nil(t, proposal.MessageBasedParams)
+}
@@ -0,0 +1,26 @@
+package v8
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The migration
+// includes:
+//
+// Addition of the default timelock veto threshold to the gov params.
+func MigrateStore(ctx context.Context, paramsCollection collections.Item[v1.Params]) error {
+	govParams, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gov params: %w", err)
+	}
+
+	if govParams.TimelockVetoThreshold == "" {
+		govParams.TimelockVetoThreshold = v1.DefaultTimelockVetoThreshold.String()
+	}
+
+	return paramsCollection.Set(ctx, govParams)
+}
@@ -0,0 +1,50 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/gov"
+	v8 "cosmossdk.io/x/gov/migrations/v8"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, gov.AppModule{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsCollection := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc))
+
+	params := v1.DefaultParams()
+	params.TimelockVetoThreshold = ""
+	require.NoError(t, paramsCollection.Set(ctx, params))
+
+	// Run migrations.
+	require.NoError(t, v8.MigrateStore(ctx, paramsCollection))
+
+	// Check params
+	params, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultTimelockVetoThreshold.String(), params.TimelockVetoThreshold)
+	require.NoError(t, params.ValidateBasic(nil))
+
+	// a set threshold is kept
+	params.TimelockVetoThreshold = "0.5"
+	require.NoError(t, paramsCollection.Set(ctx, params))
+	require.NoError(t, v8.MigrateStore(ctx, paramsCollection))
+	params, err = paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.5", params.TimelockVetoThreshold)
+}
@@ -8,6 +8,13 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeAmendProposal    = "amend_proposal"
+	EventTypeQueuedProposal   = "queued_proposal"
+
+	EventTypeCreateGovernor       = "create_governor"
+	EventTypeEditGovernor         = "edit_governor"
+	EventTypeDelegateGovernance   = "delegate_governance"
+	EventTypeUndelegateGovernance = "undelegate_governance"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +26,11 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyGovernor             = "governor"
+	AttributeKeyDelegator            = "delegator"
+	AttributeKeyDiscussionEndTime    = "discussion_end_time"
+	AttributeKeyRevision             = "revision"
+	AttributeKeyExecutionTime        = "execution_time"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -27,6 +39,8 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalQueued             = "proposal_queued"              // passed and queued in the timelock
+	AttributeValueProposalVetoed             = "proposal_vetoed"              // vetoed during the timelock
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
@@ -16,16 +16,22 @@ const (
 )
 
 var (
-	ProposalsKeyPrefix           = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
-	ActiveProposalQueuePrefix    = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
-	InactiveProposalQueuePrefix  = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
-	ProposalIDKey                = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
-	DepositsKeyPrefix            = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
-	VotesKeyPrefix               = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
-	ParamsKey                    = collections.NewPrefix(48) // ParamsKey stores the module's params.
-	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
-	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
-	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	ProposalsKeyPrefix            = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
+	ActiveProposalQueuePrefix     = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
+	InactiveProposalQueuePrefix   = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
+	ProposalIDKey                 = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
+	DepositsKeyPrefix             = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
+	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
+	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
+	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	ProposalVoteOptionsKeyPrefix  = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
+	MessageBasedParamsKey         = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	GovernorsKeyPrefix            = collections.NewPrefix(52) // GovernorsKeyPrefix stores the governors.
+	GovernanceDelegationsKey      = collections.NewPrefix(53) // GovernanceDelegationsKey stores the governance delegations by delegator.
+	GovernorDelegationsKey        = collections.NewPrefix(54) // GovernorDelegationsKey indexes the governance delegations by governor.
+	ProposalRevisionsKeyPrefix    = collections.NewPrefix(55) // ProposalRevisionsKeyPrefix stores the revisions of draft proposals.
+	DiscussionProposalQueuePrefix = collections.NewPrefix(56) // DiscussionProposalQueuePrefix stores the proposals in discussion period.
+	TimelockQueuePrefix           = collections.NewPrefix(57) // TimelockQueuePrefix stores the passed proposals waiting for execution.
 )
 
 // Reserved kvstore keys
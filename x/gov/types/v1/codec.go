@@ -20,6 +20,12 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateGovernor{}, "cosmos-sdk/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgEditGovernor{}, "cosmos-sdk/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgDelegateGovernance{}, "cosmos-sdk/v1/MsgDelegateGovernance")
+	legacy.RegisterAminoMsg(registrar, &MsgUndelegateGovernance{}, "cosmos-sdk/v1/MsgUndelegateGovernance")
+	legacy.RegisterAminoMsg(registrar, &MsgAmendProposal{}, "cosmos-sdk/v1/MsgAmendProposal")
+	legacy.RegisterAminoMsg(registrar, &MsgCancelQueuedProposal{}, "cosmos-sdk/v1/MsgCancelQueuedProposal")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -34,6 +40,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgDelegateGovernance{},
+		&MsgUndelegateGovernance{},
+		&MsgAmendProposal{},
+		&MsgCancelQueuedProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -98,6 +98,58 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate governors and invalid governance delegations
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.Address)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+			if _, ok := governors[d.DelegatorAddress]; ok {
+				return fmt.Errorf("governor %s cannot delegate its governance voting power", d.DelegatorAddress)
+			}
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate revisions
+	errGroup.Go(func() error {
+		type revisionKey struct {
+			ProposalId uint64
+			Revision   uint64
+		}
+		revisionIds := make(map[revisionKey]struct{})
+		for _, r := range data.ProposalRevisions {
+			if _, ok := proposalIds[r.ProposalId]; !ok {
+				return fmt.Errorf("proposal revision %d has non-existent proposal id: %d", r.Revision, r.ProposalId)
+			}
+
+			rk := revisionKey{r.ProposalId, r.Revision}
+			if _, ok := revisionIds[rk]; ok {
+				return fmt.Errorf("duplicate proposal revision %d of proposal %d", r.Revision, r.ProposalId)
+			}
+
+			revisionIds[rk] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -116,5 +168,11 @@ func (data GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) err
 			return err
 		}
 	}
+	for _, r := range data.ProposalRevisions {
+		err := r.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -75,6 +75,17 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "quorum too large",
 		},
+		{
+			name: "capped tally strategy without max voter power",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TallyStrategy = v1.TallyStrategy_TALLY_STRATEGY_CAPPED
+				params1.MaxVoterPower = ""
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid max voter power",
+		},
 		{
 			name: "invalid threshold",
 			genesisState: func() *v1.GenesisState {
@@ -95,6 +106,16 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "invalid timelock veto threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.TimelockVetoThreshold = "2"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "timelock veto threshold too large",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -172,6 +193,40 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: "governor"}, &v1.Governor{Address: "governor"})
+
+				return state
+			},
+			expErrMsg: "duplicate governor: governor",
+		},
+		{
+			name: "non-existent governor in governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{
+						DelegatorAddress: "delegator",
+						GovernorAddress:  "governor",
+					})
+
+				return state
+			},
+			expErrMsg: "has non-existent governor: governor",
+		},
+		{
+			name: "non-existent proposal id in proposal revisions",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalRevisions = append(state.ProposalRevisions, &v1.ProposalRevision{ProposalId: 1})
+
+				return state
+			},
+			expErrMsg: "proposal revision 0 has non-existent proposal id: 1",
+		},
 	}
 
 	for _, tc := range testCases {
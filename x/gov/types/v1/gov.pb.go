@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -73,6 +74,48 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// TallyStrategy enumerates the strategies computing the voting power of the
+// voters when tallying a proposal.
+type TallyStrategy int32
+
+const (
+	// TALLY_STRATEGY_UNSPECIFIED defines no tally strategy, which fallback to TALLY_STRATEGY_STAKE_WEIGHTED.
+	TallyStrategy_TALLY_STRATEGY_UNSPECIFIED TallyStrategy = 0
+	// TALLY_STRATEGY_STAKE_WEIGHTED defines a voting power equal to the bonded stake of the voter.
+	TallyStrategy_TALLY_STRATEGY_STAKE_WEIGHTED TallyStrategy = 1
+	// TALLY_STRATEGY_QUADRATIC defines a voting power equal to the square root of the bonded stake of the voter.
+	TallyStrategy_TALLY_STRATEGY_QUADRATIC TallyStrategy = 2
+	// TALLY_STRATEGY_CAPPED defines a voting power equal to the bonded stake of the voter, capped by max_voter_power.
+	TallyStrategy_TALLY_STRATEGY_CAPPED TallyStrategy = 3
+	// TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE defines an equal voting power for every voter having at least
+	// min_voter_stake of bonded stake.
+	TallyStrategy_TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE TallyStrategy = 4
+)
+
+var TallyStrategy_name = map[int32]string{
+	0: "TALLY_STRATEGY_UNSPECIFIED",
+	1: "TALLY_STRATEGY_STAKE_WEIGHTED",
+	2: "TALLY_STRATEGY_QUADRATIC",
+	3: "TALLY_STRATEGY_CAPPED",
+	4: "TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE",
+}
+
+var TallyStrategy_value = map[string]int32{
+	"TALLY_STRATEGY_UNSPECIFIED":          0,
+	"TALLY_STRATEGY_STAKE_WEIGHTED":       1,
+	"TALLY_STRATEGY_QUADRATIC":            2,
+	"TALLY_STRATEGY_CAPPED":               3,
+	"TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE": 4,
+}
+
+func (x TallyStrategy) String() string {
+	return proto.EnumName(TallyStrategy_name, int32(x))
+}
+
+func (TallyStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -130,7 +173,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -154,6 +197,9 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_QUEUED defines a proposal status of a proposal that has
+	// passed and waits in the timelock queue for its execution delay to elapse.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +209,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_QUEUED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +219,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_QUEUED":         6,
 }
 
 func (x ProposalStatus) String() string {
@@ -179,7 +227,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -342,6 +390,20 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// message_based_params defines the voting parameters of the proposal, resolved
+	// at submission from the message based params of its messages, the strictest
+	// params winning for proposals with messages of different classes.
+	// It is nil when the proposal uses the default gov params.
+	MessageBasedParams *MessageBasedParams `protobuf:"bytes,17,opt,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
+	// discussion_end_time is the end time of the discussion period of a draft
+	// proposal. Until then, the proposer can amend the proposal and the voting
+	// period cannot start. It is nil for proposals not submitted as drafts.
+	DiscussionEndTime *time.Time `protobuf:"bytes,18,opt,name=discussion_end_time,json=discussionEndTime,proto3,stdtime" json:"discussion_end_time,omitempty"`
+	// revision is the number of amendments made to the proposal.
+	Revision uint64 `protobuf:"varint,19,opt,name=revision,proto3" json:"revision,omitempty"`
+	// execution_time is the time at which a passed proposal queued in the
+	// timelock is executed, unless it is vetoed or cancelled before.
+	ExecutionTime *time.Time `protobuf:"bytes,20,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +552,34 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetMessageBasedParams() *MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *Proposal) GetDiscussionEndTime() *time.Time {
+	if m != nil {
+		return m.DiscussionEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -956,15 +1046,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1065,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1005,6 +1095,30 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// tally_strategy defines how the voting power of the voters is computed when
+	// tallying a proposal. It is ignored if the app overrides the tally function.
+	TallyStrategy TallyStrategy `protobuf:"varint,23,opt,name=tally_strategy,json=tallyStrategy,proto3,enum=cosmos.gov.v1.TallyStrategy" json:"tally_strategy,omitempty"`
+	// max_voter_power defines the maximum voting power of a voter with the capped
+	// tally strategy, as a proportion of the total bonded tokens.
+	MaxVoterPower string `protobuf:"bytes,24,opt,name=max_voter_power,json=maxVoterPower,proto3" json:"max_voter_power,omitempty"`
+	// min_voter_stake defines the minimum bonded stake of a voter for its vote to
+	// count with the one account one vote tally strategy.
+	MinVoterStake string `protobuf:"bytes,25,opt,name=min_voter_stake,json=minVoterStake,proto3" json:"min_voter_stake,omitempty"`
+	// discussion_period defines the duration of the discussion period of draft
+	// proposals, during which they can be amended by their proposer.
+	// If zero, proposals cannot be submitted as drafts.
+	DiscussionPeriod *time.Duration `protobuf:"bytes,26,opt,name=discussion_period,json=discussionPeriod,proto3,stdduration" json:"discussion_period,omitempty"`
+	// execution_delay defines the duration passed proposals wait in the timelock
+	// queue before being executed. If zero, passed proposals are executed
+	// immediately.
+	ExecutionDelay *time.Duration `protobuf:"bytes,27,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
+	// timelock_guardian defines the address allowed to cancel the proposals
+	// queued in the timelock. If empty, queued proposals can only be vetoed.
+	TimelockGuardian string `protobuf:"bytes,28,opt,name=timelock_guardian,json=timelockGuardian,proto3" json:"timelock_guardian,omitempty"`
+	// timelock_veto_threshold defines the minimum proportion of the total bonded
+	// stake vetoing a queued proposal during its execution delay for it to be
+	// cancelled.
+	TimelockVetoThreshold string `protobuf:"bytes,29,opt,name=timelock_veto_threshold,json=timelockVetoThreshold,proto3" json:"timelock_veto_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1194,6 +1308,55 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetTallyStrategy() TallyStrategy {
+	if m != nil {
+		return m.TallyStrategy
+	}
+	return TallyStrategy_TALLY_STRATEGY_UNSPECIFIED
+}
+
+func (m *Params) GetMaxVoterPower() string {
+	if m != nil {
+		return m.MaxVoterPower
+	}
+	return ""
+}
+
+func (m *Params) GetMinVoterStake() string {
+	if m != nil {
+		return m.MinVoterStake
+	}
+	return ""
+}
+
+func (m *Params) GetDiscussionPeriod() *time.Duration {
+	if m != nil {
+		return m.DiscussionPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return nil
+}
+
+func (m *Params) GetTimelockGuardian() string {
+	if m != nil {
+		return m.TimelockGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetTimelockVetoThreshold() string {
+	if m != nil {
+		return m.TimelockVetoThreshold
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1209,6 +1372,14 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// min_amount defines the minimum amount of a message for these params to apply
+	// to it, e.g. for treasury spends above a given size. When set, only messages
+	// exposing an amount reaching it in any denom are subject to these params.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount"`
+	// execution_delay defines the duration passed proposals wait in the timelock
+	// queue before being executed. If unset, the execution delay of the gov
+	// params applies.
+	ExecutionDelay *time.Duration `protobuf:"bytes,6,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
@@ -1279,8 +1450,317 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetMinAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinAmount
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return nil
+}
+
+// Governor defines a registered governance representative, whose votes are
+// inherited by the accounts delegating their governance voting power to it.
+type Governor struct {
+	// address is the account address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// description defines the description of the governor.
+	Description GovernorDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	// create_time is the time the governor was registered.
+	CreateTime time.Time `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Governor) GetDescription() GovernorDescription {
+	if m != nil {
+		return m.Description
+	}
+	return GovernorDescription{}
+}
+
+func (m *Governor) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
+// GovernorDescription defines the description of a governor.
+type GovernorDescription struct {
+	// moniker defines a human-readable name for the governor.
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// identity defines an optional identity signature (ex. UPort or Keybase).
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// website defines an optional website link.
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// details define other optional details.
+	Details string `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *GovernorDescription) Reset()         { *m = GovernorDescription{} }
+func (m *GovernorDescription) String() string { return proto.CompactTextString(m) }
+func (*GovernorDescription) ProtoMessage()    {}
+func (*GovernorDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *GovernorDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernorDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorDescription.Merge(m, src)
+}
+func (m *GovernorDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorDescription proto.InternalMessageInfo
+
+func (m *GovernorDescription) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *GovernorDescription) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *GovernorDescription) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *GovernorDescription) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// GovernanceDelegation defines the delegation of the governance voting power of
+// an account to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the address of the delegating account.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// ProposalRevision defines a revision of the content of a draft proposal.
+type ProposalRevision struct {
+	// proposal_id is the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// revision is the revision number, starting at 0 for the submitted content.
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// messages are the arbitrary messages of the revision.
+	Messages []*any.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// metadata is any arbitrary metadata of the revision.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the revision.
+	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the revision.
+	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// revision_time is the time the revision was made.
+	RevisionTime time.Time `protobuf:"bytes,7,opt,name=revision_time,json=revisionTime,proto3,stdtime" json:"revision_time"`
+}
+
+func (m *ProposalRevision) Reset()         { *m = ProposalRevision{} }
+func (m *ProposalRevision) String() string { return proto.CompactTextString(m) }
+func (*ProposalRevision) ProtoMessage()    {}
+func (*ProposalRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *ProposalRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalRevision.Merge(m, src)
+}
+func (m *ProposalRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalRevision proto.InternalMessageInfo
+
+func (m *ProposalRevision) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ProposalRevision) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ProposalRevision) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *ProposalRevision) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalRevision) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *ProposalRevision) GetRevisionTime() time.Time {
+	if m != nil {
+		return m.RevisionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.TallyStrategy", TallyStrategy_name, TallyStrategy_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
@@ -1294,138 +1774,180 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernorDescription)(nil), "cosmos.gov.v1.GovernorDescription")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*ProposalRevision)(nil), "cosmos.gov.v1.ProposalRevision")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x6f, 0xe3, 0xc6,
+	0xf5, 0x5f, 0x4a, 0xb2, 0x6c, 0x3d, 0x4b, 0x32, 0x3d, 0xb6, 0xd7, 0xb4, 0xbd, 0xfe, 0xb1, 0xfa,
+	0x7e, 0x91, 0xba, 0x9b, 0x58, 0xf6, 0x6e, 0xea, 0x34, 0x4d, 0x93, 0x83, 0x2c, 0x71, 0x6d, 0xa5,
+	0xb6, 0xa5, 0x50, 0xb4, 0x37, 0xdb, 0xa2, 0x20, 0x68, 0x71, 0x56, 0x66, 0x56, 0x24, 0x55, 0x72,
+	0xe4, 0x1f, 0xbd, 0xf4, 0x5f, 0xc8, 0x31, 0xa7, 0xa2, 0x68, 0x0f, 0x2d, 0x7a, 0xea, 0x61, 0xd1,
+	0xbf, 0x21, 0x28, 0x50, 0x20, 0xd8, 0x43, 0x51, 0x04, 0xe8, 0xa6, 0x48, 0x0e, 0x05, 0xf2, 0x27,
+	0x14, 0x3d, 0x14, 0x33, 0x1c, 0xfe, 0x10, 0x2d, 0xc7, 0xf2, 0xa2, 0x97, 0x5d, 0x71, 0xde, 0xe7,
+	0xf3, 0x66, 0xe6, 0xbd, 0x37, 0x6f, 0xde, 0x3c, 0xc3, 0x7c, 0xdb, 0xf1, 0x2c, 0xc7, 0xdb, 0xec,
+	0x38, 0x67, 0x9b, 0x67, 0x0f, 0xe9, 0x7f, 0xe5, 0x9e, 0xeb, 0x10, 0x07, 0x15, 0x7c, 0x41, 0x99,
+	0x8e, 0x9c, 0x3d, 0x5c, 0x5c, 0xe1, 0xb8, 0x13, 0xdd, 0xc3, 0x9b, 0x67, 0x0f, 0x4f, 0x30, 0xd1,
+	0x1f, 0x6e, 0xb6, 0x1d, 0xd3, 0xf6, 0xe1, 0x8b, 0xb3, 0x1d, 0xa7, 0xe3, 0xb0, 0x9f, 0x9b, 0xf4,
+	0x17, 0x1f, 0x5d, 0xed, 0x38, 0x4e, 0xa7, 0x8b, 0x37, 0xd9, 0xd7, 0x49, 0xff, 0xd9, 0x26, 0x31,
+	0x2d, 0xec, 0x11, 0xdd, 0xea, 0x71, 0xc0, 0x42, 0x12, 0xa0, 0xdb, 0x97, 0x5c, 0xb4, 0x92, 0x14,
+	0x19, 0x7d, 0x57, 0x27, 0xa6, 0x13, 0xcc, 0xb8, 0xe0, 0xaf, 0x48, 0xf3, 0x27, 0xe5, 0xab, 0xf5,
+	0x45, 0xd3, 0xba, 0x65, 0xda, 0xce, 0x26, 0xfb, 0xd7, 0x1f, 0x2a, 0x39, 0x80, 0x9e, 0x60, 0xb3,
+	0x73, 0x4a, 0xb0, 0x71, 0xec, 0x10, 0xdc, 0xe8, 0x51, 0x4d, 0xe8, 0x21, 0x64, 0x1d, 0xf6, 0x4b,
+	0x12, 0xd6, 0x84, 0xf5, 0xe2, 0xa3, 0x85, 0xf2, 0xc0, 0xae, 0xcb, 0x11, 0x54, 0xe1, 0x40, 0xf4,
+	0x06, 0x64, 0xcf, 0x99, 0x22, 0x29, 0xb5, 0x26, 0xac, 0xe7, 0x76, 0x8a, 0x2f, 0x5f, 0x6c, 0x00,
+	0x67, 0xd5, 0x70, 0x5b, 0xe1, 0xd2, 0xd2, 0x6f, 0x04, 0x18, 0xaf, 0xe1, 0x9e, 0xe3, 0x99, 0x04,
+	0xad, 0xc2, 0x64, 0xcf, 0x75, 0x7a, 0x8e, 0xa7, 0x77, 0x35, 0xd3, 0x60, 0x73, 0x65, 0x14, 0x08,
+	0x86, 0xea, 0x06, 0x7a, 0x07, 0x72, 0x86, 0x8f, 0x75, 0x5c, 0xae, 0x57, 0x7a, 0xf9, 0x62, 0x63,
+	0x96, 0xeb, 0xad, 0x18, 0x86, 0x8b, 0x3d, 0xaf, 0x45, 0x5c, 0xd3, 0xee, 0x28, 0x11, 0x14, 0xbd,
+	0x0f, 0x59, 0xdd, 0x72, 0xfa, 0x36, 0x91, 0xd2, 0x6b, 0xe9, 0xf5, 0xc9, 0x68, 0xfd, 0xd4, 0x4d,
+	0x65, 0xee, 0xa6, 0x72, 0xd5, 0x31, 0xed, 0x9d, 0xdc, 0xe7, 0xaf, 0x56, 0xef, 0xfc, 0xe1, 0x5f,
+	0x7f, 0x7a, 0x20, 0x28, 0x9c, 0x53, 0xfa, 0x6b, 0x0e, 0x26, 0x9a, 0x7c, 0x11, 0xa8, 0x08, 0xa9,
+	0x70, 0x69, 0x29, 0xd3, 0x40, 0x5b, 0x30, 0x61, 0x61, 0xcf, 0xd3, 0x3b, 0xd8, 0x93, 0x52, 0x4c,
+	0xf9, 0x6c, 0xd9, 0xf7, 0x48, 0x39, 0xf0, 0x48, 0xb9, 0x62, 0x5f, 0x2a, 0x21, 0x0a, 0x6d, 0x43,
+	0xd6, 0x23, 0x3a, 0xe9, 0x7b, 0x52, 0x9a, 0x19, 0x73, 0x39, 0x61, 0xcc, 0x60, 0xaa, 0x16, 0x03,
+	0x29, 0x1c, 0x8c, 0xf6, 0x00, 0x3d, 0x33, 0x6d, 0xbd, 0xab, 0x11, 0xbd, 0xdb, 0xbd, 0xd4, 0x5c,
+	0xec, 0xf5, 0xbb, 0x44, 0xca, 0xac, 0x09, 0xeb, 0x93, 0x8f, 0x16, 0x13, 0x2a, 0x54, 0x0a, 0x51,
+	0x18, 0x42, 0x11, 0x19, 0x2b, 0x36, 0x82, 0x2a, 0x30, 0xe9, 0xf5, 0x4f, 0x2c, 0x93, 0x68, 0x34,
+	0xcc, 0xa4, 0x31, 0xae, 0x22, 0xb9, 0x6a, 0x35, 0x88, 0xc1, 0x9d, 0xcc, 0xa7, 0x5f, 0xad, 0x0a,
+	0x0a, 0xf8, 0x24, 0x3a, 0x8c, 0x3e, 0x04, 0x91, 0x5b, 0x57, 0xc3, 0xb6, 0xe1, 0xeb, 0xc9, 0x8e,
+	0xa8, 0xa7, 0xc8, 0x99, 0xb2, 0x6d, 0x30, 0x5d, 0x75, 0x28, 0x10, 0x87, 0xe8, 0x5d, 0x8d, 0x8f,
+	0x4b, 0xe3, 0xb7, 0xf0, 0x51, 0x9e, 0x51, 0x83, 0x00, 0xda, 0x87, 0xe9, 0x33, 0x87, 0x98, 0x76,
+	0x47, 0xf3, 0x88, 0xee, 0xf2, 0xfd, 0x4d, 0x8c, 0xb8, 0xae, 0x29, 0x9f, 0xda, 0xa2, 0x4c, 0xb6,
+	0xb0, 0x3d, 0xe0, 0x43, 0xd1, 0x1e, 0x73, 0x23, 0xea, 0x2a, 0xf8, 0xc4, 0x60, 0x8b, 0x8b, 0x34,
+	0x48, 0x88, 0x6e, 0xe8, 0x44, 0x97, 0x80, 0x86, 0xad, 0x12, 0x7e, 0xa3, 0xef, 0xc3, 0x18, 0x31,
+	0x49, 0x17, 0x4b, 0x93, 0x2c, 0x9e, 0x67, 0xbe, 0x7c, 0xb1, 0x31, 0xe5, 0xef, 0x7c, 0xc3, 0x33,
+	0x9e, 0xaf, 0x6d, 0x95, 0x7f, 0xf0, 0x43, 0xc5, 0x47, 0xa0, 0x0d, 0x18, 0xf7, 0xfa, 0x96, 0xa5,
+	0xbb, 0x97, 0x52, 0xfe, 0x7a, 0x70, 0x80, 0x41, 0xbb, 0x30, 0xe1, 0x9f, 0x1d, 0xec, 0x4a, 0x05,
+	0x86, 0x7f, 0xf3, 0xba, 0xc3, 0x32, 0x4c, 0x4f, 0x48, 0x46, 0x6f, 0x43, 0x0e, 0x5f, 0xf4, 0xb0,
+	0x61, 0x12, 0x6c, 0x48, 0xc5, 0x35, 0x61, 0x7d, 0x62, 0x67, 0xee, 0x0a, 0x63, 0x7b, 0x4b, 0x12,
+	0x94, 0x08, 0x87, 0xde, 0x85, 0xc2, 0x33, 0xdd, 0xec, 0x62, 0x43, 0x73, 0xb1, 0xee, 0x39, 0xb6,
+	0x34, 0x75, 0xcd, 0x92, 0xb7, 0xb7, 0x94, 0xbc, 0x8f, 0x54, 0x18, 0x10, 0x29, 0x50, 0x08, 0xd3,
+	0x00, 0xb9, 0xec, 0x61, 0x49, 0x64, 0xe7, 0x64, 0xe9, 0x9a, 0x73, 0xa2, 0x5e, 0xf6, 0xf0, 0x8e,
+	0xf8, 0xe5, 0x8b, 0x8d, 0xfc, 0x05, 0xcd, 0xcb, 0x6b, 0x67, 0x5b, 0xe5, 0x47, 0xe5, 0x2d, 0x25,
+	0xdf, 0x8b, 0xc9, 0x51, 0x0b, 0x66, 0xf9, 0x01, 0xd4, 0x68, 0x3c, 0x19, 0x5a, 0x4f, 0x77, 0x75,
+	0xcb, 0x93, 0xa6, 0x99, 0x43, 0xef, 0x27, 0x54, 0x1f, 0xf8, 0xd0, 0x1d, 0x8a, 0x6c, 0x32, 0xa0,
+	0x82, 0xac, 0x2b, 0x63, 0xa8, 0x09, 0x33, 0x86, 0xe9, 0xb5, 0xfb, 0x9e, 0x67, 0x3a, 0x76, 0x14,
+	0x24, 0x68, 0xc4, 0x20, 0x99, 0x8e, 0xc8, 0xb1, 0x40, 0x71, 0xf1, 0x99, 0x49, 0x87, 0xa4, 0x19,
+	0x96, 0x63, 0xc2, 0x6f, 0xb4, 0x0b, 0x45, 0x7c, 0x81, 0xdb, 0x7d, 0x9a, 0x5e, 0xfd, 0x89, 0x66,
+	0x47, 0x8d, 0xc6, 0x90, 0x47, 0x25, 0xa5, 0xbf, 0x08, 0x30, 0x13, 0x18, 0x2f, 0xca, 0xdc, 0x1e,
+	0x5a, 0x06, 0xf0, 0x93, 0xb7, 0xe6, 0xd8, 0x98, 0xa5, 0xb8, 0x9c, 0x92, 0xf3, 0x47, 0x1a, 0x36,
+	0x8e, 0x89, 0xc9, 0xb9, 0x23, 0xa5, 0xe2, 0x62, 0xf5, 0xdc, 0x41, 0xf7, 0x21, 0x1f, 0x88, 0x4f,
+	0x5d, 0x8c, 0x59, 0x72, 0xcb, 0x29, 0x93, 0x1c, 0x40, 0x87, 0x68, 0x7e, 0xe7, 0x90, 0x67, 0x4e,
+	0xdf, 0x65, 0xb9, 0x2b, 0xa7, 0x70, 0xa5, 0x8f, 0x9d, 0xbe, 0x1b, 0x03, 0x78, 0x3d, 0xdd, 0x92,
+	0xc6, 0xe2, 0x80, 0x56, 0x4f, 0xb7, 0xde, 0x13, 0x5f, 0x26, 0xdc, 0x5c, 0xfa, 0x4f, 0x1a, 0x26,
+	0xe3, 0xc9, 0x6d, 0x03, 0x72, 0x97, 0xd8, 0xd3, 0xda, 0x2c, 0xdb, 0xb3, 0x3d, 0xec, 0x88, 0xb1,
+	0xab, 0xa7, 0x4e, 0x47, 0x95, 0x89, 0x4b, 0xec, 0x55, 0x29, 0x02, 0x6d, 0x43, 0x41, 0x3f, 0xf1,
+	0x88, 0x6e, 0xda, 0x9c, 0x92, 0xba, 0x86, 0x92, 0xe7, 0x30, 0x9f, 0xf6, 0x26, 0x4c, 0xd8, 0x0e,
+	0x67, 0xa4, 0xaf, 0x61, 0x8c, 0xdb, 0x8e, 0x0f, 0xfe, 0x00, 0x90, 0xed, 0x68, 0xe7, 0x26, 0x39,
+	0xd5, 0xce, 0x30, 0x09, 0x68, 0x99, 0x6b, 0x68, 0x53, 0xb6, 0xf3, 0xc4, 0x24, 0xa7, 0xc7, 0x98,
+	0x70, 0xfa, 0xbb, 0x20, 0x46, 0x6e, 0xe1, 0xe4, 0xb1, 0x2b, 0x77, 0x6a, 0xdd, 0x26, 0x4a, 0x31,
+	0x74, 0x56, 0x92, 0x49, 0xce, 0x83, 0x69, 0xb3, 0xdf, 0xc5, 0x54, 0xcf, 0xf9, 0x9c, 0xef, 0x03,
+	0x8a, 0x3b, 0x93, 0x73, 0xc7, 0x87, 0x72, 0xc5, 0x98, 0x8b, 0x7d, 0xf6, 0x7b, 0x30, 0x1d, 0xf3,
+	0x33, 0x27, 0x4f, 0x0c, 0x25, 0x4f, 0x45, 0xde, 0xf7, 0xb9, 0x1b, 0x00, 0xd4, 0xf7, 0x9c, 0x94,
+	0x1b, 0x4a, 0xca, 0x51, 0x04, 0x83, 0x97, 0xfe, 0x2c, 0x40, 0x86, 0xc6, 0xf0, 0xcd, 0xb5, 0x43,
+	0x19, 0xc6, 0xce, 0x1c, 0x82, 0x6f, 0xae, 0x1b, 0x7c, 0x18, 0xfa, 0x31, 0x8c, 0xfb, 0x6b, 0xf3,
+	0xa4, 0xcc, 0x5a, 0x7a, 0x48, 0x92, 0xb8, 0x5a, 0x27, 0x29, 0x01, 0x63, 0x20, 0xe1, 0x8f, 0x0d,
+	0x26, 0xfc, 0x0f, 0x33, 0x13, 0x69, 0x31, 0x53, 0xfa, 0x87, 0x00, 0x05, 0x7e, 0x6d, 0xf1, 0x6c,
+	0xf2, 0x14, 0x26, 0x2d, 0xd3, 0x0e, 0x6f, 0x41, 0xe1, 0xa6, 0x5b, 0x70, 0x99, 0xde, 0x82, 0xdf,
+	0xbe, 0x5a, 0x9d, 0x8b, 0xb1, 0xde, 0x72, 0x2c, 0x93, 0x60, 0xab, 0x47, 0x2e, 0x15, 0xb0, 0x4c,
+	0x3b, 0xb8, 0x17, 0x2d, 0x40, 0x96, 0x7e, 0x11, 0x80, 0xb4, 0x1e, 0x76, 0x4d, 0xc7, 0x60, 0x86,
+	0xa0, 0x33, 0x24, 0xd3, 0x47, 0x8d, 0x17, 0x90, 0x3b, 0xff, 0xff, 0xed, 0xab, 0xd5, 0x7b, 0x57,
+	0x89, 0xd1, 0x24, 0x9f, 0xd1, 0xec, 0x22, 0x5a, 0xfa, 0x45, 0xb0, 0x13, 0x26, 0x7f, 0x2f, 0x25,
+	0x09, 0xa5, 0x8f, 0x21, 0x7f, 0xcc, 0xee, 0x40, 0xbe, 0xbb, 0x1a, 0xf0, 0x3b, 0x31, 0x98, 0x5d,
+	0xb8, 0x69, 0xf6, 0x0c, 0xd3, 0x9e, 0xf7, 0x59, 0x31, 0xcd, 0xbf, 0x16, 0xf8, 0x89, 0xe7, 0x9a,
+	0xdf, 0x80, 0xec, 0x2f, 0xfa, 0x8e, 0xdb, 0xb7, 0x24, 0xe1, 0x4a, 0xb4, 0xb0, 0x4a, 0xd3, 0x97,
+	0xa2, 0xb7, 0x20, 0x47, 0x83, 0xd9, 0x3b, 0x75, 0xba, 0xc6, 0x35, 0x45, 0x69, 0x04, 0x40, 0xdb,
+	0x50, 0x64, 0x87, 0x35, 0xa2, 0xa4, 0x87, 0x52, 0x0a, 0x14, 0xa5, 0x06, 0x20, 0xb6, 0xc0, 0xdf,
+	0x4d, 0x43, 0x96, 0xaf, 0x4d, 0xbe, 0xa5, 0x4f, 0x63, 0x95, 0x4d, 0xdc, 0x7f, 0x07, 0xaf, 0xe7,
+	0xbf, 0xcc, 0x70, 0xff, 0x5c, 0xf5, 0x45, 0xfa, 0x35, 0x7c, 0x11, 0xb3, 0x7b, 0x66, 0x74, 0xbb,
+	0x8f, 0xdd, 0xde, 0xee, 0xd9, 0x11, 0xec, 0x8e, 0xea, 0xb0, 0x40, 0x0d, 0x6d, 0xda, 0x26, 0x31,
+	0xa3, 0x52, 0x52, 0x63, 0xcb, 0x97, 0xc6, 0x87, 0x6a, 0xb8, 0x6b, 0x99, 0x76, 0xdd, 0xc7, 0x73,
+	0xf3, 0x28, 0x14, 0x8d, 0x8e, 0x60, 0x2e, 0xcc, 0x24, 0x6d, 0xdd, 0x6e, 0xe3, 0x2e, 0x57, 0xe3,
+	0x67, 0xb0, 0xfb, 0x83, 0x6a, 0x86, 0x95, 0x33, 0x33, 0x01, 0xbf, 0xca, 0xe8, 0xbe, 0xda, 0x9f,
+	0xc3, 0x6c, 0x52, 0xad, 0x81, 0xbd, 0x20, 0xc5, 0x8d, 0x5e, 0x99, 0x6d, 0x6f, 0x29, 0x68, 0x50,
+	0x7f, 0x0d, 0x7b, 0x04, 0x7d, 0x02, 0xf3, 0x61, 0xed, 0xa5, 0x0d, 0x7a, 0x17, 0x6e, 0xf2, 0xee,
+	0x3c, 0xf5, 0xee, 0xb0, 0x89, 0xe6, 0x42, 0x95, 0xc7, 0x71, 0xcf, 0x2b, 0x30, 0x13, 0xcd, 0x15,
+	0x39, 0x6a, 0x72, 0x54, 0xfb, 0xa0, 0x90, 0x1d, 0x39, 0xf0, 0x63, 0x88, 0x26, 0xd3, 0xe2, 0x67,
+	0x26, 0x7f, 0x8b, 0x33, 0x13, 0x2d, 0xeb, 0x20, 0x3a, 0x3c, 0x1f, 0x80, 0x78, 0xd2, 0x77, 0x6d,
+	0x6a, 0x14, 0xac, 0xf1, 0x88, 0x2d, 0xb0, 0x22, 0x76, 0x68, 0xf9, 0x5c, 0xa4, 0x60, 0x9a, 0xd3,
+	0x3f, 0xf2, 0xc3, 0xf7, 0x18, 0x96, 0x19, 0x3d, 0x74, 0x5e, 0x78, 0x0a, 0x5d, 0x4c, 0x55, 0x4a,
+	0xc5, 0xeb, 0x75, 0x2d, 0x52, 0x66, 0x50, 0x6a, 0x05, 0x67, 0xd0, 0xa7, 0xa1, 0x1f, 0x41, 0x31,
+	0x5a, 0x16, 0x0d, 0x66, 0x69, 0xea, 0x7a, 0x45, 0xf9, 0x60, 0x51, 0xb4, 0x2c, 0x40, 0x07, 0x30,
+	0x1d, 0xb3, 0x10, 0x8f, 0x4e, 0x71, 0x54, 0xeb, 0x4f, 0x45, 0x89, 0xc5, 0x8f, 0xcc, 0x9f, 0xc1,
+	0x62, 0x32, 0x32, 0x69, 0xb6, 0xe1, 0xd1, 0x33, 0xcd, 0xf4, 0xae, 0x5c, 0xd1, 0x3b, 0x58, 0x6d,
+	0xcf, 0x0f, 0x86, 0xe4, 0x81, 0x7e, 0xc1, 0x63, 0xa5, 0x07, 0xab, 0xf4, 0x52, 0xb4, 0x4c, 0x8f,
+	0x98, 0x6d, 0x4d, 0xef, 0x93, 0x53, 0xc7, 0x35, 0x7f, 0x89, 0x0d, 0x4d, 0xf7, 0xa3, 0x1c, 0x7b,
+	0x12, 0x5a, 0x4b, 0xaf, 0xe7, 0x76, 0xd6, 0xbf, 0xe3, 0x04, 0x0c, 0xce, 0xb5, 0x1c, 0x29, 0xac,
+	0x84, 0xfa, 0x2a, 0x81, 0x3a, 0x74, 0x02, 0x31, 0x80, 0xe6, 0xe2, 0x4f, 0x70, 0x7b, 0x30, 0x4e,
+	0x67, 0x46, 0xda, 0xd1, 0x52, 0xa4, 0x44, 0xe1, 0x3a, 0xa2, 0x68, 0xfd, 0x00, 0x80, 0x56, 0x99,
+	0x3c, 0x9a, 0x66, 0x47, 0x52, 0x48, 0xeb, 0x52, 0x1e, 0x53, 0x75, 0x10, 0xa3, 0x60, 0xe7, 0x4a,
+	0xe6, 0x6e, 0x50, 0xf2, 0xb0, 0xbc, 0x55, 0xde, 0x52, 0xa6, 0x42, 0x1e, 0x57, 0xf5, 0x18, 0xee,
+	0x86, 0xce, 0x8b, 0x9e, 0x07, 0x1d, 0xdd, 0x93, 0xee, 0xd2, 0x12, 0x68, 0xc8, 0xc3, 0x28, 0x4c,
+	0x43, 0x72, 0x00, 0xdf, 0xd5, 0x3d, 0x54, 0x85, 0xa2, 0xdf, 0x58, 0xf0, 0x88, 0xab, 0x13, 0xdc,
+	0xb9, 0x94, 0xe6, 0xd9, 0xab, 0xeb, 0xde, 0xb0, 0xd6, 0x42, 0x8b, 0x63, 0x94, 0x02, 0x89, 0x7f,
+	0xa2, 0x77, 0x60, 0x8a, 0x46, 0x0e, 0x2b, 0xa0, 0xb4, 0x9e, 0x73, 0x8e, 0x5d, 0x49, 0x1a, 0x9e,
+	0xbd, 0x2d, 0xfd, 0x82, 0x86, 0xb3, 0xdb, 0xa4, 0x20, 0xc6, 0x33, 0x6d, 0xce, 0xf3, 0x88, 0xfe,
+	0x1c, 0x4b, 0x0b, 0x43, 0x2b, 0xbf, 0x82, 0x65, 0xb2, 0x63, 0xe0, 0xb6, 0x28, 0x88, 0xbe, 0xf7,
+	0x63, 0x0f, 0x30, 0x1e, 0xb0, 0x8b, 0x23, 0x5e, 0x8b, 0x11, 0x93, 0x87, 0xea, 0x1e, 0x4c, 0x45,
+	0x16, 0x34, 0x70, 0x57, 0xbf, 0x94, 0x96, 0x46, 0xd3, 0x15, 0x3d, 0xcc, 0x6a, 0x94, 0x86, 0x64,
+	0x98, 0xa6, 0x0f, 0xb4, 0xae, 0xd3, 0x7e, 0xae, 0x75, 0xfa, 0xba, 0x6b, 0x98, 0xba, 0x2d, 0xdd,
+	0xbb, 0xa1, 0xee, 0x14, 0x03, 0xca, 0x2e, 0x67, 0xa0, 0xc7, 0x30, 0x1f, 0xaa, 0x49, 0x5c, 0x8a,
+	0xcb, 0x43, 0xcd, 0x3a, 0x17, 0xc0, 0x8f, 0x07, 0x8a, 0x92, 0x99, 0x97, 0x57, 0x53, 0x4a, 0xe9,
+	0x55, 0x1a, 0xd0, 0xd5, 0x77, 0xee, 0xff, 0xa6, 0x4e, 0x8b, 0xd5, 0x06, 0xa9, 0xef, 0xac, 0x0d,
+	0x36, 0x86, 0x9c, 0xa3, 0x2b, 0xc5, 0x41, 0x74, 0x6e, 0x06, 0x4a, 0x89, 0xf4, 0xed, 0x4b, 0x89,
+	0xcc, 0x28, 0xa5, 0xc4, 0x27, 0x40, 0x4b, 0x2f, 0x8d, 0x37, 0x0c, 0xc7, 0x6e, 0xba, 0x7e, 0xb6,
+	0xe8, 0xf5, 0xf3, 0xc7, 0xaf, 0x56, 0xd7, 0x3b, 0x26, 0x39, 0xed, 0x9f, 0x94, 0xdb, 0x8e, 0xc5,
+	0xbb, 0xac, 0x9b, 0x91, 0xc9, 0x37, 0x69, 0x1f, 0xc3, 0x63, 0x04, 0x4f, 0xc9, 0x59, 0xa6, 0x5d,
+	0x61, 0xda, 0x87, 0x85, 0x5c, 0xf6, 0xb5, 0x42, 0x6e, 0xc8, 0xcb, 0xf8, 0x6f, 0x02, 0x4c, 0xec,
+	0x3a, 0x67, 0xd8, 0xb5, 0x1d, 0x17, 0x3d, 0x82, 0x71, 0x9e, 0x70, 0x25, 0xe1, 0x86, 0x38, 0x0c,
+	0x80, 0xa8, 0x01, 0x93, 0x06, 0xf6, 0xda, 0xae, 0xe9, 0xb7, 0x7e, 0xfd, 0x72, 0xb3, 0x94, 0xc8,
+	0x07, 0xc1, 0x0c, 0xb5, 0x08, 0x19, 0xbf, 0x91, 0xe3, 0x1a, 0x68, 0x35, 0xdc, 0x76, 0xb1, 0x4e,
+	0xb0, 0xdf, 0xbe, 0x48, 0xdf, 0xd8, 0xbe, 0x98, 0xa0, 0x8a, 0xfc, 0xe6, 0xa3, 0x4f, 0x64, 0xfd,
+	0x8b, 0x5f, 0xc1, 0xcc, 0x90, 0x59, 0x91, 0x04, 0xe3, 0x96, 0x63, 0x9b, 0xcf, 0xb1, 0xcb, 0x7b,
+	0x17, 0xc1, 0x27, 0x7d, 0x8d, 0x99, 0x06, 0xb6, 0x89, 0x49, 0x2e, 0x79, 0xdf, 0x22, 0xfc, 0xa6,
+	0xac, 0x73, 0x7c, 0xe2, 0x99, 0x24, 0xe8, 0x58, 0x04, 0x9f, 0x54, 0x62, 0x60, 0xa2, 0x9b, 0x5d,
+	0x8f, 0x77, 0x2a, 0x82, 0xcf, 0xd2, 0x6f, 0x05, 0x98, 0xf5, 0x57, 0x40, 0x2f, 0xbb, 0x1a, 0xee,
+	0xe2, 0x8e, 0xce, 0x37, 0x38, 0x6d, 0xf8, 0x5f, 0x8e, 0xab, 0x8d, 0x6a, 0x6f, 0x31, 0xa4, 0xf0,
+	0x71, 0x54, 0x05, 0xb1, 0xc3, 0x37, 0x18, 0x6a, 0xb9, 0xe9, 0xd5, 0x3a, 0x15, 0x30, 0xf8, 0x70,
+	0xe9, 0xb3, 0x14, 0x88, 0x41, 0xe9, 0xa1, 0x04, 0x3d, 0xa4, 0x1b, 0x5f, 0xc9, 0xf1, 0x06, 0x54,
+	0x2a, 0xd1, 0x80, 0x8a, 0xb7, 0xba, 0xd3, 0x23, 0xb5, 0xba, 0xe3, 0xcf, 0xe0, 0x4c, 0xa2, 0xef,
+	0x39, 0x1b, 0xf4, 0x3d, 0xfd, 0xf7, 0xb1, 0xff, 0x41, 0x8d, 0x1e, 0xb4, 0x38, 0xb3, 0xbe, 0xd1,
+	0xf9, 0x27, 0x6d, 0x13, 0x07, 0x2b, 0xf1, 0xc3, 0x67, 0xfc, 0x16, 0xe1, 0x93, 0x0f, 0xa8, 0x54,
+	0xf8, 0xe0, 0xf7, 0x02, 0xe4, 0xe3, 0xdd, 0x43, 0xb4, 0x0c, 0x0b, 0x4d, 0xa5, 0xd1, 0x6c, 0xb4,
+	0x2a, 0xfb, 0x9a, 0xfa, 0xb4, 0x29, 0x6b, 0x47, 0x87, 0xad, 0xa6, 0x5c, 0xad, 0x3f, 0xae, 0xcb,
+	0x35, 0xf1, 0x0e, 0x5a, 0x84, 0xbb, 0x83, 0xe2, 0x96, 0x5a, 0x39, 0xac, 0x55, 0x94, 0x9a, 0x28,
+	0xa0, 0xfb, 0xb0, 0x3c, 0x28, 0x3b, 0x38, 0xda, 0x57, 0xeb, 0xcd, 0x7d, 0x59, 0xab, 0xee, 0x35,
+	0xea, 0x55, 0x59, 0x4c, 0xa1, 0x7b, 0x20, 0x0d, 0x42, 0x1a, 0x4d, 0xb5, 0x7e, 0x50, 0x6f, 0xa9,
+	0xf5, 0xaa, 0x98, 0x46, 0x4b, 0x30, 0x3f, 0x28, 0x95, 0x3f, 0x6e, 0xca, 0xb5, 0xba, 0x2a, 0xd7,
+	0xc4, 0xcc, 0x83, 0x17, 0x02, 0x14, 0x06, 0x6e, 0x5c, 0xb4, 0x02, 0x8b, 0x6a, 0x65, 0x7f, 0xff,
+	0xa9, 0xd6, 0x52, 0x95, 0x8a, 0x2a, 0xef, 0x3e, 0x4d, 0xac, 0xf5, 0x3e, 0x2c, 0x27, 0xe4, 0x2d,
+	0xb5, 0xf2, 0x13, 0x59, 0x7b, 0x22, 0xd7, 0x77, 0xf7, 0xa8, 0x52, 0x81, 0xae, 0x27, 0x01, 0xf9,
+	0xe8, 0xa8, 0x52, 0x53, 0x2a, 0x74, 0x3d, 0x29, 0xb4, 0x00, 0x73, 0x09, 0x69, 0xb5, 0xd2, 0x6c,
+	0xca, 0x35, 0x31, 0x8d, 0xbe, 0x07, 0xff, 0x97, 0x10, 0x35, 0x0e, 0x65, 0xad, 0x52, 0xad, 0x36,
+	0x8e, 0x0e, 0x55, 0xf6, 0xfb, 0xb8, 0xa1, 0xca, 0x62, 0xe6, 0xc1, 0xbf, 0x05, 0x80, 0xd8, 0x9f,
+	0x8f, 0x96, 0x60, 0x9e, 0x0a, 0xd8, 0xbe, 0x1b, 0x87, 0x89, 0x05, 0xcf, 0xc0, 0x54, 0x5c, 0xf8,
+	0x54, 0x6e, 0x89, 0x42, 0x72, 0xb0, 0x71, 0x28, 0x8b, 0x02, 0x9a, 0x87, 0x99, 0xf8, 0x60, 0x65,
+	0xa7, 0xa5, 0x56, 0xea, 0x87, 0x62, 0x2a, 0x89, 0x56, 0x9f, 0x34, 0xc4, 0x14, 0x42, 0x50, 0x8c,
+	0x0f, 0x1e, 0x36, 0xc4, 0x34, 0x9a, 0x83, 0xe9, 0x01, 0xe0, 0x9e, 0x22, 0xcb, 0x62, 0x9a, 0x1a,
+	0x64, 0x10, 0xaa, 0x3d, 0xa9, 0xab, 0x7b, 0xda, 0xb1, 0xac, 0x36, 0xc4, 0x0c, 0x9a, 0x05, 0x31,
+	0x2e, 0x7d, 0xdc, 0x38, 0x52, 0xae, 0x8e, 0xb6, 0x9a, 0x95, 0x03, 0x71, 0x6c, 0x31, 0x25, 0x0a,
+	0x0f, 0xbe, 0x15, 0xa0, 0x38, 0xf8, 0x37, 0x1c, 0xb4, 0x0a, 0x4b, 0xa1, 0x8f, 0x5b, 0x6a, 0x45,
+	0x3d, 0x6a, 0x25, 0x8c, 0x50, 0x82, 0x95, 0x24, 0xa0, 0x26, 0x37, 0x1b, 0xad, 0xba, 0xaa, 0x35,
+	0x65, 0xa5, 0xde, 0x48, 0x46, 0x1a, 0xc7, 0x1c, 0x37, 0xd4, 0xfa, 0xe1, 0x6e, 0x00, 0x49, 0x0d,
+	0x04, 0x2a, 0x87, 0x34, 0x2b, 0xad, 0x16, 0x73, 0x5e, 0x3c, 0x0a, 0xb9, 0x4c, 0x91, 0x3f, 0x94,
+	0xab, 0x2c, 0xd0, 0x86, 0x31, 0x1f, 0x57, 0xea, 0xfb, 0x72, 0x4d, 0x1c, 0x1b, 0x26, 0xfb, 0xe8,
+	0x48, 0x3e, 0x92, 0x6b, 0x62, 0x76, 0x67, 0xfb, 0xf3, 0xaf, 0x57, 0x84, 0x2f, 0xbe, 0x5e, 0x11,
+	0xfe, 0xf9, 0xf5, 0x8a, 0xf0, 0xe9, 0x37, 0x2b, 0x77, 0xbe, 0xf8, 0x66, 0xe5, 0xce, 0xdf, 0xbf,
+	0x59, 0xb9, 0xf3, 0xd3, 0x25, 0x3f, 0x4d, 0x79, 0xc6, 0xf3, 0xb2, 0xe9, 0x6c, 0xb2, 0x9b, 0xc9,
+	0xbf, 0x05, 0xe9, 0x9f, 0x45, 0xb3, 0xec, 0xb4, 0xbe, 0xfd, 0xdf, 0x01, 0x00, 0xb7, 0x18, 0xec,
+	0xdb, 0x57, 0x1d, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,12 +2054,57 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalType != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Revision != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DiscussionEndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DiscussionEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DiscussionEndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MessageBasedParams != nil {
+		{
+			size, err := m.MessageBasedParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
@@ -1585,22 +2152,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1619,22 +2186,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1898,12 +2465,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2512,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2021,6 +2588,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimelockVetoThreshold) > 0 {
+		i -= len(m.TimelockVetoThreshold)
+		copy(dAtA[i:], m.TimelockVetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TimelockVetoThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.TimelockGuardian) > 0 {
+		i -= len(m.TimelockGuardian)
+		copy(dAtA[i:], m.TimelockGuardian)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TimelockGuardian)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.ExecutionDelay != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.DiscussionPeriod != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DiscussionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DiscussionPeriod):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MinVoterStake) > 0 {
+		i -= len(m.MinVoterStake)
+		copy(dAtA[i:], m.MinVoterStake)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinVoterStake)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.MaxVoterPower) > 0 {
+		i -= len(m.MaxVoterPower)
+		copy(dAtA[i:], m.MaxVoterPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MaxVoterPower)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.TallyStrategy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TallyStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2136,12 +2770,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2822,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2253,6 +2887,30 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.ExecutionDelay != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -2275,86 +2933,298 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
-	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintGov(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GovernorDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FinalTallyResult != nil {
+	return dAtA[:n], nil
+}
+
+func (m *ProposalRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevisionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevisionTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintGov(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
 		l = m.FinalTallyResult.Size()
 		n += 1 + l + sovGov(uint64(l))
 	}
@@ -2406,6 +3276,21 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if m.MessageBasedParams != nil {
+		l = m.MessageBasedParams.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.DiscussionEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DiscussionEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 2 + sovGov(uint64(m.Revision))
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2658,6 +3543,33 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if m.TallyStrategy != 0 {
+		n += 2 + sovGov(uint64(m.TallyStrategy))
+	}
+	l = len(m.MaxVoterPower)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinVoterStake)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.DiscussionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DiscussionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.TimelockGuardian)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.TimelockVetoThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2683,6 +3595,16 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	l = len(m.YesQuorum)
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
@@ -2690,6 +3612,100 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *GovernorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovGov(uint64(m.Revision))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevisionTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3446,17 +4462,144 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageBasedParams == nil {
+				m.MessageBasedParams = &MessageBasedParams{}
+			}
+			if err := m.MessageBasedParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscussionEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiscussionEndTime == nil {
+				m.DiscussionEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DiscussionEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -5234,61 +6377,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TallyStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyStrategy |= TallyStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoterPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5298,31 +6410,27 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxVoterPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoterStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5350,13 +6458,13 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.MinVoterStake = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscussionPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5366,27 +6474,67 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.DiscussionPeriod == nil {
+				m.DiscussionPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DiscussionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockGuardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5414,11 +6562,11 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.TimelockGuardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockVetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5446,7 +6594,982 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesQuorum = string(dAtA[iNdEx:postIndex])
+			m.TimelockVetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevisionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15,7 +15,8 @@ import (
 
 var (
 	_, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
-	_, _                   gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _       sdk.Msg                              = &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernance{}, &MsgUndelegateGovernance{}, &MsgAmendProposal{}, &MsgCancelQueuedProposal{}
+	_, _, _                gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgAmendProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -66,6 +67,36 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) e
 	return sdktx.UnpackInterfaces(unpacker, m.Messages)
 }
 
+// NewMsgAmendProposal creates a new MsgAmendProposal.
+func NewMsgAmendProposal(proposalID uint64, messages []sdk.Msg, proposer, metadata, title, summary string) (*MsgAmendProposal, error) {
+	m := &MsgAmendProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer,
+		Metadata:   metadata,
+		Title:      title,
+		Summary:    summary,
+	}
+
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Messages = anys
+
+	return m, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgAmendProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgAmendProposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
 // NewMsgSubmitMultipleChoiceProposal creates a new MsgSubmitMultipleChoiceProposal.
 func NewMultipleChoiceMsgSubmitProposal(
 	initialDeposit sdk.Coins,
@@ -159,3 +190,28 @@ func (msg *MsgSudoExec) SetSudoedMsg(input sdk.Msg) (*MsgSudoExec, error) {
 
 	return msg, nil
 }
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance
+func NewMsgCreateGovernor(address string, description GovernorDescription) *MsgCreateGovernor {
+	return &MsgCreateGovernor{Address: address, Description: description}
+}
+
+// NewMsgEditGovernor creates a new MsgEditGovernor instance
+func NewMsgEditGovernor(address string, description GovernorDescription) *MsgEditGovernor {
+	return &MsgEditGovernor{Address: address, Description: description}
+}
+
+// NewMsgDelegateGovernance creates a new MsgDelegateGovernance instance
+func NewMsgDelegateGovernance(delegator, governor string) *MsgDelegateGovernance {
+	return &MsgDelegateGovernance{DelegatorAddress: delegator, GovernorAddress: governor}
+}
+
+// NewMsgUndelegateGovernance creates a new MsgUndelegateGovernance instance
+func NewMsgUndelegateGovernance(delegator string) *MsgUndelegateGovernance {
+	return &MsgUndelegateGovernance{DelegatorAddress: delegator}
+}
+
+// NewMsgCancelQueuedProposal creates a new MsgCancelQueuedProposal instance
+func NewMsgCancelQueuedProposal(guardian string, proposalID uint64) *MsgCancelQueuedProposal {
+	return &MsgCancelQueuedProposal{Guardian: guardian, ProposalId: proposalID}
+}
@@ -15,6 +15,8 @@ import (
 const (
 	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
 	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
+	DefaultDiscussionPeriod               time.Duration = 0                  // drafts disabled
+	DefaultExecutionDelay                 time.Duration = 0                  // timelock disabled
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
@@ -39,6 +41,10 @@ var (
 	DefaultOptimisticRejectedThreshold         = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses        = []string(nil)
 	DefaultProposalExecutionGas         uint64 = 10_000_000 // ten million
+	DefaultTallyStrategy                       = TallyStrategy_TALLY_STRATEGY_STAKE_WEIGHTED
+	DefaultMaxVoterPower                       = sdkmath.LegacyNewDecWithPrec(1, 1)
+	DefaultMinVoterStake                       = sdkmath.OneInt()
+	DefaultTimelockVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
 )
 
 // NewParams creates a new Params instance with given values.
@@ -51,6 +57,9 @@ func NewParams(
 	optimisticAuthorizedAddresses []string,
 	proposalExecutionGas uint64,
 ) Params {
+	discussionPeriod := DefaultDiscussionPeriod
+	executionDelay := DefaultExecutionDelay
+
 	return Params{
 		MinDeposit:                    minDeposit,
 		ExpeditedMinDeposit:           expeditedminDeposit,
@@ -74,6 +83,12 @@ func NewParams(
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 		ProposalExecutionGas:          proposalExecutionGas,
+		TallyStrategy:                 DefaultTallyStrategy,
+		MaxVoterPower:                 DefaultMaxVoterPower.String(),
+		MinVoterStake:                 DefaultMinVoterStake.String(),
+		DiscussionPeriod:              &discussionPeriod,
+		ExecutionDelay:                &executionDelay,
+		TimelockVetoThreshold:         DefaultTimelockVetoThreshold.String(),
 	}
 }
 
@@ -275,6 +290,61 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	if _, ok := TallyStrategy_name[int32(p.TallyStrategy)]; !ok {
+		return fmt.Errorf("invalid tally strategy: %s", p.TallyStrategy)
+	}
+
+	// the strategy params are only required by the strategy using them
+	if len(p.MaxVoterPower) != 0 || p.TallyStrategy == TallyStrategy_TALLY_STRATEGY_CAPPED {
+		maxVoterPower, err := sdkmath.LegacyNewDecFromStr(p.MaxVoterPower)
+		if err != nil {
+			return fmt.Errorf("invalid max voter power: %w", err)
+		}
+		if !maxVoterPower.IsPositive() {
+			return fmt.Errorf("max voter power must be positive: %s", maxVoterPower)
+		}
+		if maxVoterPower.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("max voter power too large: %s", maxVoterPower)
+		}
+	}
+
+	if len(p.MinVoterStake) != 0 || p.TallyStrategy == TallyStrategy_TALLY_STRATEGY_ONE_ACCOUNT_ONE_VOTE {
+		minVoterStake, ok := sdkmath.NewIntFromString(p.MinVoterStake)
+		if !ok {
+			return fmt.Errorf("invalid min voter stake: %s", p.MinVoterStake)
+		}
+		if minVoterStake.IsNegative() {
+			return fmt.Errorf("min voter stake cannot be negative: %s", minVoterStake)
+		}
+	}
+
+	if p.DiscussionPeriod != nil && p.DiscussionPeriod.Seconds() < 0 {
+		return fmt.Errorf("discussion period cannot be negative: %s", p.DiscussionPeriod)
+	}
+
+	if p.ExecutionDelay != nil && p.ExecutionDelay.Seconds() < 0 {
+		return fmt.Errorf("execution delay cannot be negative: %s", p.ExecutionDelay)
+	}
+
+	if len(p.TimelockGuardian) != 0 {
+		if _, err := addressCodec.StringToBytes(p.TimelockGuardian); err != nil {
+			return fmt.Errorf("timelock guardian address is invalid: %s", p.TimelockGuardian)
+		}
+	}
+
+	if len(p.TimelockVetoThreshold) != 0 || (p.ExecutionDelay != nil && p.ExecutionDelay.Seconds() > 0) {
+		timelockVetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.TimelockVetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid timelock veto threshold string: %w", err)
+		}
+		if !timelockVetoThreshold.IsPositive() {
+			return fmt.Errorf("timelock veto threshold must be positive: %s", timelockVetoThreshold)
+		}
+		if timelockVetoThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("timelock veto threshold too large: %s", timelockVetoThreshold)
+		}
+	}
+
 	return nil
 }
 
@@ -331,6 +401,14 @@ func (p MessageBasedParams) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	if err := p.MinAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid min amount: %w", err)
+	}
+
+	if p.ExecutionDelay != nil && p.ExecutionDelay.Seconds() < 0 {
+		return fmt.Errorf("execution delay cannot be negative: %s", p.ExecutionDelay)
+	}
+
 	return nil
 }
 
@@ -432,5 +510,90 @@ func (p MessageBasedParams) Equal(params *MessageBasedParams) (bool, error) {
 		return false, nil
 	}
 
-	return true, nil
+	if p.ExecutionDelay != nil && params.ExecutionDelay != nil {
+		if p.ExecutionDelay.Seconds() != params.ExecutionDelay.Seconds() {
+			return false, nil
+		}
+	} else if p.ExecutionDelay == nil && params.ExecutionDelay != nil ||
+		p.ExecutionDelay != nil && params.ExecutionDelay == nil {
+		return false, nil
+	}
+
+	return p.MinAmount.Equal(params.MinAmount), nil
+}
+
+// IsEmpty returns true if none of the message based params is set.
+func (p MessageBasedParams) IsEmpty() bool {
+	return p.VotingPeriod == nil && p.Quorum == "" && p.YesQuorum == "" &&
+		p.Threshold == "" && p.VetoThreshold == "" && p.MinAmount.Empty() &&
+		p.ExecutionDelay == nil
+}
+
+// AppliesTo returns true if the params apply to a message of the given amount.
+// Params without a minimum amount apply to all messages.
+func (p MessageBasedParams) AppliesTo(amount sdk.Coins) bool {
+	if p.MinAmount.Empty() {
+		return true
+	}
+
+	return amount.IsAnyGTE(p.MinAmount)
+}
+
+// Stricter returns the strictest combination of two valid message based params:
+// the longest voting period and execution delay, the highest quorum, yes quorum
+// and threshold, and the lowest veto threshold. The minimum amount is not
+// carried over.
+func (p MessageBasedParams) Stricter(params MessageBasedParams) (MessageBasedParams, error) {
+	// parseDec parses a decimal, unset decimals being considered zero.
+	parseDec := func(name, dec string) (sdkmath.LegacyDec, error) {
+		d, err := sdkmath.LegacyNewDecFromStr(dec)
+		if err != nil {
+			if !errors.IsOf(err, sdkmath.ErrLegacyEmptyDecimalStr) {
+				return sdkmath.LegacyDec{}, fmt.Errorf("invalid %s string: %w", name, err)
+			}
+
+			d = sdkmath.LegacyZeroDec()
+		}
+		return d, nil
+	}
+
+	votingPeriod := p.VotingPeriod
+	if votingPeriod == nil || params.VotingPeriod != nil && *params.VotingPeriod > *votingPeriod {
+		votingPeriod = params.VotingPeriod
+	}
+
+	executionDelay := p.ExecutionDelay
+	if executionDelay == nil || params.ExecutionDelay != nil && *params.ExecutionDelay > *executionDelay {
+		executionDelay = params.ExecutionDelay
+	}
+
+	stricter := MessageBasedParams{VotingPeriod: votingPeriod, ExecutionDelay: executionDelay}
+	for _, f := range []struct {
+		name   string
+		a, b   string
+		result *string
+		lower  bool
+	}{
+		{"quorum", p.Quorum, params.Quorum, &stricter.Quorum, false},
+		{"yes quorum", p.YesQuorum, params.YesQuorum, &stricter.YesQuorum, false},
+		{"vote threshold", p.Threshold, params.Threshold, &stricter.Threshold, false},
+		{"veto threshold", p.VetoThreshold, params.VetoThreshold, &stricter.VetoThreshold, true},
+	} {
+		a, err := parseDec(f.name, f.a)
+		if err != nil {
+			return MessageBasedParams{}, err
+		}
+		b, err := parseDec(f.name, f.b)
+		if err != nil {
+			return MessageBasedParams{}, err
+		}
+
+		if f.lower {
+			*f.result = sdkmath.LegacyMinDec(a, b).String()
+		} else {
+			*f.result = sdkmath.LegacyMaxDec(a, b).String()
+		}
+	}
+
+	return stricter, nil
 }
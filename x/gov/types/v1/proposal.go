@@ -21,6 +21,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusQueued        = ProposalStatus_PROPOSAL_STATUS_QUEUED
 )
 
 // NewProposal creates a new Proposal instance
@@ -74,11 +75,27 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// InDiscussionPeriod returns true if the proposal is a draft whose discussion
+// period has not ended at the given time.
+func (p Proposal) InDiscussionPeriod(blockTime time.Time) bool {
+	return p.Status == StatusDepositPeriod && p.DiscussionEndTime != nil && blockTime.Before(*p.DiscussionEndTime)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
 }
 
+// GetMsgs returns the messages of the proposal revision
+func (r ProposalRevision) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(r.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ProposalRevision) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, r.Messages)
+}
+
 // Proposals is an array of proposal
 type Proposals []*Proposal
 
@@ -137,7 +154,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusQueued {
 		return true
 	}
 	return false
@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterInterface((*DecisionPolicy)(nil), nil)
 	registrar.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy")
 	registrar.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy")
+	registrar.RegisterConcrete(&RoleDecisionPolicy{}, "cosmos-sdk/RoleDecisionPolicy")
 
 	legacy.RegisterAminoMsg(registrar, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -58,5 +59,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&RoleDecisionPolicy{},
 	)
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/codec"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the group module's genesis state.
@@ -16,30 +19,44 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		panic(err)
 	}
 
-	store := k.KVStoreService.OpenKVStore(ctx)
-
-	if err := k.groupTable.Import(store, genesisState.Groups, genesisState.GroupSeq); err != nil {
+	if err := k.GroupSeq.Set(ctx, genesisState.GroupSeq); err != nil {
 		return errors.Wrap(err, "groups")
 	}
+	for _, g := range genesisState.Groups {
+		if err := k.setGroup(ctx, *g); err != nil {
+			return errors.Wrap(err, "groups")
+		}
+	}
 
-	if err := k.groupMemberTable.Import(store, genesisState.GroupMembers, 0); err != nil {
-		return errors.Wrap(err, "group members")
+	for _, m := range genesisState.GroupMembers {
+		if err := k.setGroupMember(ctx, *m); err != nil {
+			return errors.Wrap(err, "group members")
+		}
 	}
 
-	if err := k.groupPolicyTable.Import(store, genesisState.GroupPolicies, 0); err != nil {
-		return errors.Wrap(err, "group policies")
+	for _, p := range genesisState.GroupPolicies {
+		if err := k.setGroupPolicy(ctx, *p); err != nil {
+			return errors.Wrap(err, "group policies")
+		}
 	}
 
-	if err := k.groupPolicySeq.InitVal(store, genesisState.GroupPolicySeq); err != nil {
+	if err := k.GroupPolicySeq.Set(ctx, genesisState.GroupPolicySeq); err != nil {
 		return errors.Wrap(err, "group policy account seq")
 	}
 
-	if err := k.proposalTable.Import(store, genesisState.Proposals, genesisState.ProposalSeq); err != nil {
+	if err := k.ProposalSeq.Set(ctx, genesisState.ProposalSeq); err != nil {
 		return errors.Wrap(err, "proposals")
 	}
+	for _, p := range genesisState.Proposals {
+		if err := k.setProposal(ctx, *p); err != nil {
+			return errors.Wrap(err, "proposals")
+		}
+	}
 
-	if err := k.voteTable.Import(store, genesisState.Votes, 0); err != nil {
-		return errors.Wrap(err, "votes")
+	for _, v := range genesisState.Votes {
+		if err := k.setVote(ctx, *v); err != nil {
+			return errors.Wrap(err, "votes")
+		}
 	}
 
 	return nil
@@ -49,46 +66,58 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.GenesisState, error) {
 	genesisState := group.NewGenesisState()
 
-	var groups []*group.GroupInfo
-
-	store := k.KVStoreService.OpenKVStore(ctx)
-
-	groupSeq, err := k.groupTable.Export(store, &groups)
+	var err error
+	genesisState.GroupSeq, err = k.GroupSeq.Peek(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "groups")
+	}
+	err = k.GroupTable.Walk(ctx, nil, func(_ uint64, g group.GroupInfo) (bool, error) {
+		genesisState.Groups = append(genesisState.Groups, &g)
+		return false, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "groups")
 	}
-	genesisState.Groups = groups
-	genesisState.GroupSeq = groupSeq
 
-	var groupMembers []*group.GroupMember
-	_, err = k.groupMemberTable.Export(store, &groupMembers)
+	err = k.GroupMemberTable.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], m group.GroupMember) (bool, error) {
+		genesisState.GroupMembers = append(genesisState.GroupMembers, &m)
+		return false, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "group members")
 	}
-	genesisState.GroupMembers = groupMembers
 
-	var groupPolicies []*group.GroupPolicyInfo
-	_, err = k.groupPolicyTable.Export(store, &groupPolicies)
+	err = k.GroupPolicyTable.Walk(ctx, nil, func(_ sdk.AccAddress, p group.GroupPolicyInfo) (bool, error) {
+		genesisState.GroupPolicies = append(genesisState.GroupPolicies, &p)
+		return false, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "group policies")
 	}
-	genesisState.GroupPolicies = groupPolicies
-	genesisState.GroupPolicySeq = k.groupPolicySeq.CurVal(store)
+	genesisState.GroupPolicySeq, err = k.GroupPolicySeq.Peek(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "group policy account seq")
+	}
 
-	var proposals []*group.Proposal
-	proposalSeq, err := k.proposalTable.Export(store, &proposals)
+	genesisState.ProposalSeq, err = k.ProposalSeq.Peek(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposals")
+	}
+	err = k.ProposalTable.Walk(ctx, nil, func(_ uint64, p group.Proposal) (bool, error) {
+		genesisState.Proposals = append(genesisState.Proposals, &p)
+		return false, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "proposals")
 	}
-	genesisState.Proposals = proposals
-	genesisState.ProposalSeq = proposalSeq
 
-	var votes []*group.Vote
-	_, err = k.voteTable.Export(store, &votes)
+	err = k.VoteTable.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], v group.Vote) (bool, error) {
+		genesisState.Votes = append(genesisState.Votes, &v)
+		return false, nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "votes")
 	}
-	genesisState.Votes = votes
 
 	return genesisState, nil
 }
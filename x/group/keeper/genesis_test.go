@@ -139,7 +139,7 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &group.GenesisState{
 		GroupSeq:       2,
 		Groups:         []*group.GroupInfo{{Id: 1, Admin: accStrAddr, Metadata: "1", Version: 1, TotalWeight: "1"}, {Id: 2, Admin: accStrAddr, Metadata: "2", Version: 2, TotalWeight: "2"}},
-		GroupMembers:   []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: memberStrAddr, Weight: "1", Metadata: "member metadata"}}, {GroupId: 2, Member: &group.Member{Address: memberStrAddr, Weight: "2", Metadata: "member metadata"}}},
+		GroupMembers:   []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: memberStrAddr, Weight: "1", Metadata: "member metadata", Roles: []group.MemberRole{{Name: "council", Weight: "1"}}}}, {GroupId: 2, Member: &group.Member{Address: memberStrAddr, Weight: "2", Metadata: "member metadata", Roles: []group.MemberRole{{Name: "council", Weight: "2"}, {Name: "treasury", Weight: "1"}}}}},
 		GroupPolicySeq: 1,
 		GroupPolicies:  []*group.GroupPolicyInfo{groupPolicy},
 		ProposalSeq:    1,
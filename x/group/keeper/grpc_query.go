@@ -2,17 +2,22 @@ package keeper
 
 import (
 	"context"
-	"math"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
-	"cosmossdk.io/x/group/internal/orm"
 
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -31,9 +36,8 @@ func (k Keeper) GroupInfo(ctx context.Context, request *group.QueryGroupInfoRequ
 
 // getGroupInfo gets the group info of the given group id.
 func (k Keeper) getGroupInfo(ctx context.Context, id uint64) (group.GroupInfo, error) {
-	var obj group.GroupInfo
-	_, err := k.groupTable.GetOne(k.KVStoreService.OpenKVStore(ctx), id, &obj)
-	return obj, err
+	obj, err := k.GroupTable.Get(ctx, id)
+	return obj, mapNotFound(err)
 }
 
 // GroupPolicyInfo queries info about a group policy.
@@ -53,20 +57,18 @@ func (k Keeper) GroupPolicyInfo(ctx context.Context, request *group.QueryGroupPo
 
 // getGroupPolicyInfo gets the group policy info of the given account address.
 func (k Keeper) getGroupPolicyInfo(ctx context.Context, accountAddress string) (group.GroupPolicyInfo, error) {
-	var obj group.GroupPolicyInfo
-	return obj, k.groupPolicyTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.GroupPolicyInfo{Address: accountAddress}, k.accKeeper.AddressCodec()), &obj)
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(accountAddress)
+	if err != nil {
+		return group.GroupPolicyInfo{}, err
+	}
+	obj, err := k.GroupPolicyTable.Get(ctx, addr)
+	return obj, mapNotFound(err)
 }
 
 // GroupMembers queries all members of a group.
 func (k Keeper) GroupMembers(ctx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	groupID := request.GroupId
-	it, err := k.getGroupMembers(ctx, groupID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(it, request.Pagination, &members)
+	members, pageRes, err := k.getGroupMembers(ctx, groupID, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -77,9 +79,9 @@ func (k Keeper) GroupMembers(ctx context.Context, request *group.QueryGroupMembe
 	}, nil
 }
 
-// getGroupMembers returns an iterator for the given group id and page request.
-func (k Keeper) getGroupMembers(ctx context.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupMemberByGroupIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), id, pageRequest)
+// getGroupMembers returns the members of the given group id for the given page request.
+func (k Keeper) getGroupMembers(ctx context.Context, id uint64, pageRequest *query.PageRequest) ([]*group.GroupMember, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.GroupMemberTable, GroupMemberByGroupIndexKey, collections.Uint64Key, id, pageRequest)
 }
 
 // GroupsByAdmin queries all groups where a given address is admin.
@@ -88,13 +90,7 @@ func (k Keeper) GroupsByAdmin(ctx context.Context, request *group.QueryGroupsByA
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupsByAdmin(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
+	groups, pageRes, err := k.getGroupsByAdmin(ctx, addr, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -105,21 +101,15 @@ func (k Keeper) GroupsByAdmin(ctx context.Context, request *group.QueryGroupsByA
 	}, nil
 }
 
-// getGroupsByAdmin returns an iterator for the given admin account address and page request.
-func (k Keeper) getGroupsByAdmin(ctx context.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupByAdminIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), admin.Bytes(), pageRequest)
+// getGroupsByAdmin returns the groups of the given admin account address for the given page request.
+func (k Keeper) getGroupsByAdmin(ctx context.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) ([]*group.GroupInfo, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.GroupTable, GroupByAdminIndexKey, sdk.AccAddressKey, admin, pageRequest)
 }
 
 // GroupPoliciesByGroup queries all groups policies of a given group.
 func (k Keeper) GroupPoliciesByGroup(ctx context.Context, request *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
 	groupID := request.GroupId
-	it, err := k.getGroupPoliciesByGroup(ctx, groupID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := k.getGroupPoliciesByGroup(ctx, groupID, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -130,9 +120,9 @@ func (k Keeper) GroupPoliciesByGroup(ctx context.Context, request *group.QueryGr
 	}, nil
 }
 
-// getGroupPoliciesByGroup returns an iterator for the given group id and page request.
-func (k Keeper) getGroupPoliciesByGroup(ctx context.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByGroupIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), id, pageRequest)
+// getGroupPoliciesByGroup returns the group policies of the given group id for the given page request.
+func (k Keeper) getGroupPoliciesByGroup(ctx context.Context, id uint64, pageRequest *query.PageRequest) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.GroupPolicyTable, GroupPolicyByGroupIndexKey, collections.Uint64Key, id, pageRequest)
 }
 
 // GroupPoliciesByAdmin queries all groups policies where a given address is
@@ -142,13 +132,7 @@ func (k Keeper) GroupPoliciesByAdmin(ctx context.Context, request *group.QueryGr
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupPoliciesByAdmin(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := k.getGroupPoliciesByAdmin(ctx, addr, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +143,9 @@ func (k Keeper) GroupPoliciesByAdmin(ctx context.Context, request *group.QueryGr
 	}, nil
 }
 
-// getGroupPoliciesByAdmin returns an iterator for the given admin account address and page request.
-func (k Keeper) getGroupPoliciesByAdmin(ctx context.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByAdminIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), admin.Bytes(), pageRequest)
+// getGroupPoliciesByAdmin returns the group policies of the given admin account address for the given page request.
+func (k Keeper) getGroupPoliciesByAdmin(ctx context.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.GroupPolicyTable, GroupPolicyByAdminIndexKey, sdk.AccAddressKey, admin, pageRequest)
 }
 
 // Proposal queries a proposal.
@@ -181,13 +165,7 @@ func (k Keeper) ProposalsByGroupPolicy(ctx context.Context, request *group.Query
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getProposalsByGroupPolicy(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var proposals []*group.Proposal
-	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	proposals, pageRes, err := k.getProposalsByGroupPolicy(ctx, addr, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -198,16 +176,16 @@ func (k Keeper) ProposalsByGroupPolicy(ctx context.Context, request *group.Query
 	}, nil
 }
 
-// getProposalsByGroupPolicy returns an iterator for the given account address and page request.
-func (k Keeper) getProposalsByGroupPolicy(ctx context.Context, account sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.proposalByGroupPolicyIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), account.Bytes(), pageRequest)
+// getProposalsByGroupPolicy returns the proposals of the given account address for the given page request.
+func (k Keeper) getProposalsByGroupPolicy(ctx context.Context, account sdk.AccAddress, pageRequest *query.PageRequest) ([]*group.Proposal, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.ProposalTable, ProposalByGroupPolicyIndexKey, sdk.AccAddressKey, account, pageRequest)
 }
 
 // getProposal gets the proposal info of the given proposal id.
 func (k Keeper) getProposal(ctx context.Context, proposalID uint64) (group.Proposal, error) {
-	var p group.Proposal
-	if _, err := k.proposalTable.GetOne(k.KVStoreService.OpenKVStore(ctx), proposalID, &p); err != nil {
-		return group.Proposal{}, errorsmod.Wrap(err, "load proposal")
+	p, err := k.ProposalTable.Get(ctx, proposalID)
+	if err != nil {
+		return group.Proposal{}, errorsmod.Wrap(mapNotFound(err), "load proposal")
 	}
 	return p, nil
 }
@@ -231,13 +209,7 @@ func (k Keeper) VoteByProposalVoter(ctx context.Context, request *group.QueryVot
 // VotesByProposal queries all votes on a proposal.
 func (k Keeper) VotesByProposal(ctx context.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
 	proposalID := request.ProposalId
-	it, err := k.getVotesByProposal(ctx, proposalID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := k.getVotesByProposal(ctx, proposalID, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -254,13 +226,7 @@ func (k Keeper) VotesByVoter(ctx context.Context, request *group.QueryVotesByVot
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getVotesByVoter(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := k.getVotesByVoter(ctx, addr, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -282,13 +248,7 @@ func (k Keeper) GroupsByMember(ctx context.Context, request *group.QueryGroupsBy
 		return nil, err
 	}
 
-	iter, err := k.groupMemberByMemberIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), member, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(iter, request.Pagination, &members)
+	members, pageRes, err := paginateIndex(ctx, k.KVStoreService, k.GroupMemberTable, GroupMemberByMemberIndexKey, sdk.AccAddressKey, member, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -310,18 +270,22 @@ func (k Keeper) GroupsByMember(ctx context.Context, request *group.QueryGroupsBy
 
 // getVote gets the vote info for the given proposal id and voter address.
 func (k Keeper) getVote(ctx context.Context, proposalID uint64, voter string) (group.Vote, error) {
-	var v group.Vote
-	return v, k.voteTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: voter}, k.accKeeper.AddressCodec()), &v)
+	key, err := k.voteKey(proposalID, voter)
+	if err != nil {
+		return group.Vote{}, err
+	}
+	v, err := k.VoteTable.Get(ctx, key)
+	return v, mapNotFound(err)
 }
 
-// getVotesByProposal returns an iterator for the given proposal id and page request.
-func (k Keeper) getVotesByProposal(ctx context.Context, proposalID uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByProposalIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), proposalID, pageRequest)
+// getVotesByProposal returns the votes of the given proposal id for the given page request.
+func (k Keeper) getVotesByProposal(ctx context.Context, proposalID uint64, pageRequest *query.PageRequest) ([]*group.Vote, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.VoteTable, VoteByProposalIndexKey, collections.Uint64Key, proposalID, pageRequest)
 }
 
-// getVotesByVoter returns an iterator for the given voter address and page request.
-func (k Keeper) getVotesByVoter(ctx context.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByVoterIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), voter.Bytes(), pageRequest)
+// getVotesByVoter returns the votes of the given voter address for the given page request.
+func (k Keeper) getVotesByVoter(ctx context.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) ([]*group.Vote, *query.PageResponse, error) {
+	return paginateIndex(ctx, k.KVStoreService, k.VoteTable, VoteByVoterIndexKey, sdk.AccAddressKey, voter, pageRequest)
 }
 
 // TallyResult computes the live tally result of a proposal.
@@ -354,14 +318,9 @@ func (k Keeper) TallyResult(ctx context.Context, request *group.QueryTallyResult
 
 // Groups returns all the groups present in the state.
 func (k Keeper) Groups(ctx context.Context, request *group.QueryGroupsRequest) (*group.QueryGroupsResponse, error) {
-	it, err := k.groupTable.PrefixScan(k.KVStoreService.OpenKVStore(ctx), 1, math.MaxUint64)
-	if err != nil {
-		return nil, err
-	}
-	defer it.Close()
-
-	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
+	groups, pageRes, err := query.CollectionPaginate(ctx, k.GroupTable, request.Pagination, func(_ uint64, g group.GroupInfo) (*group.GroupInfo, error) {
+		return &g, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -371,3 +330,48 @@ func (k Keeper) Groups(ctx context.Context, request *group.QueryGroupsRequest) (
 		Pagination: pageRes,
 	}, nil
 }
+
+// mapNotFound returns sdkerrors.ErrNotFound in place of collections.ErrNotFound,
+// which is the error x/group returns for missing state objects.
+func mapNotFound(err error) error {
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return sdkerrors.ErrNotFound
+	}
+	return err
+}
+
+// paginateIndex paginates the values of an indexed map referenced by refKey in
+// one of its Multi indexes, indexPrefix being the prefix of the index.
+func paginateIndex[R, K, V, I any](
+	ctx context.Context,
+	storeService corestore.KVStoreService,
+	m *collections.IndexedMap[K, V, I],
+	indexPrefix collections.Prefix,
+	refCodec collcodec.KeyCodec[R],
+	refKey R,
+	pageRequest *query.PageRequest,
+) ([]*V, *query.PageResponse, error) {
+	refBz := make([]byte, refCodec.SizeNonTerminal(refKey))
+	if _, err := refCodec.EncodeNonTerminal(refBz, refKey); err != nil {
+		return nil, nil, err
+	}
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), slices.Concat(indexPrefix.Bytes(), refBz))
+
+	var values []*V
+	pageRes, err := query.Paginate(store, pageRequest, func(key, _ []byte) error {
+		_, pk, err := m.KeyCodec().Decode(key)
+		if err != nil {
+			return err
+		}
+		value, err := m.Get(ctx, pk)
+		if err != nil {
+			return err
+		}
+		values = append(values, &value)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, pageRes, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/group"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/invariants"
+)
+
+// StateInvariants returns the checker of the relational invariants of the group state:
+// every member, policy, proposal and vote references an existing parent, and the
+// secondary indexes reference exactly the entries of their collection.
+func (k Keeper) StateInvariants() (*invariants.Checker, error) {
+	ac := k.accKeeper.AddressCodec()
+
+	groups := invariants.Of("groups", k.GroupTable)
+	members := invariants.Of("group_members", k.GroupMemberTable)
+	policies := invariants.Of("group_policies", k.GroupPolicyTable)
+	proposals := invariants.Of("proposals", k.ProposalTable)
+	votes := invariants.Of("votes", k.VoteTable)
+
+	return invariants.NewChecker(group.ModuleName, k.Schema,
+		invariants.ForeignKey("member_group", members, groups,
+			func(pk collections.Pair[uint64, sdk.AccAddress], _ group.GroupMember) (uint64, bool, error) {
+				return pk.K1(), true, nil
+			}),
+		invariants.ForeignKey("policy_group", policies, groups,
+			func(_ sdk.AccAddress, p group.GroupPolicyInfo) (uint64, bool, error) {
+				return p.GroupId, true, nil
+			}),
+		invariants.ForeignKey("proposal_policy", proposals, policies,
+			func(_ uint64, p group.Proposal) (sdk.AccAddress, bool, error) {
+				addr, err := ac.StringToBytes(p.GroupPolicyAddress)
+				return addr, true, err
+			}),
+		invariants.ForeignKey("vote_proposal", votes, proposals,
+			func(pk collections.Pair[uint64, sdk.AccAddress], _ group.Vote) (uint64, bool, error) {
+				return pk.K1(), true, nil
+			}),
+
+		invariants.MultiIndex("groups_by_admin", groups, "groups_by_admin", k.GroupTable.Indexes.Admin, groupAdmin(ac)),
+		invariants.MultiIndex("group_members_by_group", members, "group_members_by_group", k.GroupMemberTable.Indexes.Group, groupMemberGroup),
+		invariants.MultiIndex("group_members_by_member", members, "group_members_by_member", k.GroupMemberTable.Indexes.Member, groupMemberAddress),
+		invariants.MultiIndex("group_policies_by_group", policies, "group_policies_by_group", k.GroupPolicyTable.Indexes.Group, groupPolicyGroup),
+		invariants.MultiIndex("group_policies_by_admin", policies, "group_policies_by_admin", k.GroupPolicyTable.Indexes.Admin, groupPolicyAdmin(ac)),
+		invariants.MultiIndex("proposals_by_group_policy", proposals, "proposals_by_group_policy", k.ProposalTable.Indexes.GroupPolicy, proposalGroupPolicy(ac)),
+		invariants.MultiIndex("proposals_by_voting_period_end", proposals, "proposals_by_voting_period_end", k.ProposalTable.Indexes.VotingPeriodEnd, proposalVotingPeriodEnd),
+		invariants.MultiIndex("votes_by_proposal", votes, "votes_by_proposal", k.VoteTable.Indexes.Proposal, voteProposal),
+		invariants.MultiIndex("votes_by_voter", votes, "votes_by_voter", k.VoteTable.Indexes.Voter, voteVoter),
+	)
+}
@@ -0,0 +1,22 @@
+package keeper_test
+
+func (s *TestSuite) TestStateInvariants() {
+	checker, err := s.groupKeeper.StateInvariants()
+	s.Require().NoError(err)
+
+	violations, err := checker.Check(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(violations)
+
+	// removing the group leaves its members and policy dangling.
+	s.Require().NoError(s.groupKeeper.GroupTable.Remove(s.ctx, s.groupID))
+
+	violations, err = checker.Check(s.ctx)
+	s.Require().NoError(err)
+	broken := make(map[string]int)
+	for _, v := range violations {
+		s.Require().Equal("group", v.Module)
+		broken[v.Invariant]++
+	}
+	s.Require().Equal(map[string]int{"member_group": 2, "policy_group": 1}, broken)
+}
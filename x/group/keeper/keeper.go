@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
-	"cosmossdk.io/x/group/internal/orm"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,34 +46,216 @@ const (
 	VoteByVoterIndexPrefix    byte = 0x42
 )
 
-type Keeper struct {
-	appmodule.Environment
-	accKeeper group.AccountKeeper
+// Collection prefixes. The collections keep the layout of the tables written
+// by the former x/group ORM: rows are stored under a two bytes table prefix,
+// sequences under the 0x1 key of their own prefix, and secondary index keys
+// are the reference key followed by the primary key of the row.
+var (
+	GroupTableKey        = collections.NewPrefix([]byte{GroupTablePrefix, 0x0})
+	GroupSeqKey          = collections.NewPrefix([]byte{GroupTableSeqPrefix, 0x1})
+	GroupByAdminIndexKey = collections.NewPrefix([]byte{GroupByAdminIndexPrefix})
+
+	GroupMemberTableKey         = collections.NewPrefix([]byte{GroupMemberTablePrefix, 0x0})
+	GroupMemberByGroupIndexKey  = collections.NewPrefix([]byte{GroupMemberByGroupIndexPrefix})
+	GroupMemberByMemberIndexKey = collections.NewPrefix([]byte{GroupMemberByMemberIndexPrefix})
+
+	GroupPolicyTableKey        = collections.NewPrefix([]byte{GroupPolicyTablePrefix, 0x0})
+	GroupPolicySeqKey          = collections.NewPrefix([]byte{GroupPolicyTableSeqPrefix, 0x1})
+	GroupPolicyByGroupIndexKey = collections.NewPrefix([]byte{GroupPolicyByGroupIndexPrefix})
+	GroupPolicyByAdminIndexKey = collections.NewPrefix([]byte{GroupPolicyByAdminIndexPrefix})
+
+	ProposalTableKey              = collections.NewPrefix([]byte{ProposalTablePrefix, 0x0})
+	ProposalSeqKey                = collections.NewPrefix([]byte{ProposalTableSeqPrefix, 0x1})
+	ProposalByGroupPolicyIndexKey = collections.NewPrefix([]byte{ProposalByGroupPolicyIndexPrefix})
+	ProposalsByVotingPeriodEndKey = collections.NewPrefix([]byte{ProposalsByVotingPeriodEndPrefix})
+
+	VoteTableKey           = collections.NewPrefix([]byte{VoteTablePrefix, 0x0})
+	VoteByProposalIndexKey = collections.NewPrefix([]byte{VoteByProposalIndexPrefix})
+	VoteByVoterIndexKey    = collections.NewPrefix([]byte{VoteByVoterIndexPrefix})
+)
 
-	// Group Table
-	groupTable        orm.AutoUInt64Table
-	groupByAdminIndex orm.Index
+// GroupsIndexes defines the secondary indexes of the groups collection.
+type GroupsIndexes struct {
+	Admin *indexes.Multi[sdk.AccAddress, uint64, group.GroupInfo]
+}
 
-	// Group Member Table
-	groupMemberTable         orm.PrimaryKeyTable
-	groupMemberByGroupIndex  orm.Index
-	groupMemberByMemberIndex orm.Index
+func (i GroupsIndexes) IndexesList() []collections.Index[uint64, group.GroupInfo] {
+	return []collections.Index[uint64, group.GroupInfo]{i.Admin}
+}
 
-	// Group Policy Table
-	groupPolicySeq          orm.Sequence
-	groupPolicyTable        orm.PrimaryKeyTable
-	groupPolicyByGroupIndex orm.Index
-	groupPolicyByAdminIndex orm.Index
+func newGroupsIndexes(sb *collections.SchemaBuilder, ac address.Codec) GroupsIndexes {
+	return GroupsIndexes{
+		Admin: indexes.NewMulti(
+			sb, GroupByAdminIndexKey, "groups_by_admin",
+			sdk.AccAddressKey, collections.Uint64Key,
+			groupAdmin(ac),
+		),
+	}
+}
 
-	// Proposal Table
-	proposalTable              orm.AutoUInt64Table
-	proposalByGroupPolicyIndex orm.Index
-	proposalsByVotingPeriodEnd orm.Index
+// GroupMembersIndexes defines the secondary indexes of the group members collection.
+type GroupMembersIndexes struct {
+	Group  *indexes.Multi[uint64, collections.Pair[uint64, sdk.AccAddress], group.GroupMember]
+	Member *indexes.Multi[sdk.AccAddress, collections.Pair[uint64, sdk.AccAddress], group.GroupMember]
+}
 
-	// Vote Table
-	voteTable           orm.PrimaryKeyTable
-	voteByProposalIndex orm.Index
-	voteByVoterIndex    orm.Index
+func (i GroupMembersIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.GroupMember] {
+	return []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.GroupMember]{i.Group, i.Member}
+}
+
+func newGroupMembersIndexes(sb *collections.SchemaBuilder) GroupMembersIndexes {
+	pkCodec := collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey)
+	return GroupMembersIndexes{
+		Group: indexes.NewMulti(
+			sb, GroupMemberByGroupIndexKey, "group_members_by_group",
+			collections.Uint64Key, pkCodec,
+			groupMemberGroup,
+		),
+		Member: indexes.NewMulti(
+			sb, GroupMemberByMemberIndexKey, "group_members_by_member",
+			sdk.AccAddressKey, pkCodec,
+			groupMemberAddress,
+		),
+	}
+}
+
+// GroupPoliciesIndexes defines the secondary indexes of the group policies collection.
+type GroupPoliciesIndexes struct {
+	Group *indexes.Multi[uint64, sdk.AccAddress, group.GroupPolicyInfo]
+	Admin *indexes.Multi[sdk.AccAddress, sdk.AccAddress, group.GroupPolicyInfo]
+}
+
+func (i GroupPoliciesIndexes) IndexesList() []collections.Index[sdk.AccAddress, group.GroupPolicyInfo] {
+	return []collections.Index[sdk.AccAddress, group.GroupPolicyInfo]{i.Group, i.Admin}
+}
+
+func newGroupPoliciesIndexes(sb *collections.SchemaBuilder, ac address.Codec) GroupPoliciesIndexes {
+	pkCodec := sdk.LengthPrefixedAddressKey(sdk.AccAddressKey) //nolint:staticcheck // group policy addresses were stored length prefixed by the ORM.
+	return GroupPoliciesIndexes{
+		Group: indexes.NewMulti(
+			sb, GroupPolicyByGroupIndexKey, "group_policies_by_group",
+			collections.Uint64Key, pkCodec,
+			groupPolicyGroup,
+		),
+		Admin: indexes.NewMulti(
+			sb, GroupPolicyByAdminIndexKey, "group_policies_by_admin",
+			sdk.AccAddressKey, pkCodec,
+			groupPolicyAdmin(ac),
+		),
+	}
+}
+
+// ProposalsIndexes defines the secondary indexes of the proposals collection.
+type ProposalsIndexes struct {
+	GroupPolicy     *indexes.Multi[sdk.AccAddress, uint64, group.Proposal]
+	VotingPeriodEnd *indexes.Multi[time.Time, uint64, group.Proposal]
+}
+
+func (i ProposalsIndexes) IndexesList() []collections.Index[uint64, group.Proposal] {
+	return []collections.Index[uint64, group.Proposal]{i.GroupPolicy, i.VotingPeriodEnd}
+}
+
+func newProposalsIndexes(sb *collections.SchemaBuilder, ac address.Codec) ProposalsIndexes {
+	return ProposalsIndexes{
+		GroupPolicy: indexes.NewMulti(
+			sb, ProposalByGroupPolicyIndexKey, "proposals_by_group_policy",
+			sdk.AccAddressKey, collections.Uint64Key,
+			proposalGroupPolicy(ac),
+		),
+		VotingPeriodEnd: indexes.NewMulti(
+			sb, ProposalsByVotingPeriodEndKey, "proposals_by_voting_period_end",
+			sdk.TimeKey, collections.Uint64Key, //nolint:staticcheck // keeps the nanosecond precision of the former index.
+			proposalVotingPeriodEnd,
+		),
+	}
+}
+
+// VotesIndexes defines the secondary indexes of the votes collection.
+type VotesIndexes struct {
+	Proposal *indexes.Multi[uint64, collections.Pair[uint64, sdk.AccAddress], group.Vote]
+	Voter    *indexes.Multi[sdk.AccAddress, collections.Pair[uint64, sdk.AccAddress], group.Vote]
+}
+
+func (i VotesIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.Vote] {
+	return []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.Vote]{i.Proposal, i.Voter}
+}
+
+func newVotesIndexes(sb *collections.SchemaBuilder) VotesIndexes {
+	pkCodec := collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey)
+	return VotesIndexes{
+		Proposal: indexes.NewMulti(
+			sb, VoteByProposalIndexKey, "votes_by_proposal",
+			collections.Uint64Key, pkCodec,
+			voteProposal,
+		),
+		Voter: indexes.NewMulti(
+			sb, VoteByVoterIndexKey, "votes_by_voter",
+			sdk.AccAddressKey, pkCodec,
+			voteVoter,
+		),
+	}
+}
+
+// The functions below return the reference keys of the secondary indexes,
+// they are shared with the state invariants.
+
+func groupAdmin(ac address.Codec) func(uint64, group.GroupInfo) (sdk.AccAddress, error) {
+	return func(_ uint64, g group.GroupInfo) (sdk.AccAddress, error) {
+		return ac.StringToBytes(g.Admin)
+	}
+}
+
+func groupMemberGroup(pk collections.Pair[uint64, sdk.AccAddress], _ group.GroupMember) (uint64, error) {
+	return pk.K1(), nil
+}
+
+func groupMemberAddress(pk collections.Pair[uint64, sdk.AccAddress], _ group.GroupMember) (sdk.AccAddress, error) {
+	return pk.K2(), nil
+}
+
+func groupPolicyGroup(_ sdk.AccAddress, p group.GroupPolicyInfo) (uint64, error) {
+	return p.GroupId, nil
+}
+
+func groupPolicyAdmin(ac address.Codec) func(sdk.AccAddress, group.GroupPolicyInfo) (sdk.AccAddress, error) {
+	return func(_ sdk.AccAddress, p group.GroupPolicyInfo) (sdk.AccAddress, error) {
+		return ac.StringToBytes(p.Admin)
+	}
+}
+
+func proposalGroupPolicy(ac address.Codec) func(uint64, group.Proposal) (sdk.AccAddress, error) {
+	return func(_ uint64, p group.Proposal) (sdk.AccAddress, error) {
+		return ac.StringToBytes(p.GroupPolicyAddress)
+	}
+}
+
+func proposalVotingPeriodEnd(_ uint64, p group.Proposal) (time.Time, error) {
+	return p.VotingPeriodEnd, nil
+}
+
+func voteProposal(pk collections.Pair[uint64, sdk.AccAddress], _ group.Vote) (uint64, error) {
+	return pk.K1(), nil
+}
+
+func voteVoter(pk collections.Pair[uint64, sdk.AccAddress], _ group.Vote) (sdk.AccAddress, error) {
+	return pk.K2(), nil
+}
+
+type Keeper struct {
+	appmodule.Environment
+	accKeeper group.AccountKeeper
+
+	Schema collections.Schema
+	// GroupSeq, GroupPolicySeq and ProposalSeq hold the last ID in use,
+	// see nextSequenceValue.
+	GroupSeq         collections.Sequence
+	GroupTable       *collections.IndexedMap[uint64, group.GroupInfo, GroupsIndexes]
+	GroupMemberTable *collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], group.GroupMember, GroupMembersIndexes]
+	GroupPolicySeq   collections.Sequence
+	GroupPolicyTable *collections.IndexedMap[sdk.AccAddress, group.GroupPolicyInfo, GroupPoliciesIndexes]
+	ProposalSeq      collections.Sequence
+	ProposalTable    *collections.IndexedMap[uint64, group.Proposal, ProposalsIndexes]
+	VoteTable        *collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], group.Vote, VotesIndexes]
 
 	config group.Config
 
@@ -80,11 +264,53 @@ type Keeper struct {
 
 // NewKeeper creates a new group keeper.
 func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.AccountKeeper, config group.Config) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	ac := accKeeper.AddressCodec()
 	k := Keeper{
 		Environment: env,
 		accKeeper:   accKeeper,
 		cdc:         cdc,
+
+		GroupSeq: collections.NewSequence(sb, GroupSeqKey, "group_seq"),
+		GroupTable: collections.NewIndexedMap(
+			sb, GroupTableKey, "groups",
+			collections.Uint64Key.WithName("id"),
+			codec.CollValue[group.GroupInfo](cdc),
+			newGroupsIndexes(sb, ac),
+		),
+		GroupMemberTable: collections.NewIndexedMap(
+			sb, GroupMemberTableKey, "group_members",
+			collections.NamedPairKeyCodec("group_id", collections.Uint64Key, "member", sdk.AccAddressKey),
+			codec.CollValue[group.GroupMember](cdc),
+			newGroupMembersIndexes(sb),
+		),
+		GroupPolicySeq: collections.NewSequence(sb, GroupPolicySeqKey, "group_policy_seq"),
+		GroupPolicyTable: collections.NewIndexedMap(
+			sb, GroupPolicyTableKey, "group_policies",
+			sdk.LengthPrefixedAddressKey(sdk.AccAddressKey).WithName("address"), //nolint:staticcheck // group policy addresses were stored length prefixed by the ORM.
+			codec.CollValue[group.GroupPolicyInfo](cdc),
+			newGroupPoliciesIndexes(sb, ac),
+		),
+		ProposalSeq: collections.NewSequence(sb, ProposalSeqKey, "proposal_seq"),
+		ProposalTable: collections.NewIndexedMap(
+			sb, ProposalTableKey, "proposals",
+			collections.Uint64Key.WithName("id"),
+			codec.CollValue[group.Proposal](cdc),
+			newProposalsIndexes(sb, ac),
+		),
+		VoteTable: collections.NewIndexedMap(
+			sb, VoteTableKey, "votes",
+			collections.NamedPairKeyCodec("proposal_id", collections.Uint64Key, "voter", sdk.AccAddressKey),
+			codec.CollValue[group.Vote](cdc),
+			newVotesIndexes(sb),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
 
 	/*
 		Example of group params:
@@ -113,157 +339,133 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.config = config
 
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc, k.accKeeper.AddressCodec())
+	return k
+}
+
+// GetGroupSequence returns the current value of the group table sequence
+func (k Keeper) GetGroupSequence(ctx context.Context) uint64 {
+	seq, err := k.GroupSeq.Peek(ctx)
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.groupByAdminIndex, err = orm.NewIndex(groupTable, GroupByAdminIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		addr, err := accKeeper.AddressCodec().StringToBytes(val.(*group.GroupInfo).Admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	return seq
+}
+
+// GetGroupPolicySeq returns the current value of the group policy table sequence
+func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
+	seq, err := k.GroupPolicySeq.Peek(ctx)
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.groupTable = *groupTable
+	return seq
+}
 
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberTablePrefix}, &group.GroupMember{}, cdc, k.accKeeper.AddressCodec())
+// nextSequenceValue increments the sequence and returns its new value.
+// Group sequences store the last value in use, as the former ORM did, so that
+// IDs start at 1.
+func nextSequenceValue(ctx context.Context, seq collections.Sequence) (uint64, error) {
+	last, err := seq.Next(ctx)
 	if err != nil {
-		panic(err.Error())
+		return 0, err
 	}
-	k.groupMemberByGroupIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
-	if err != nil {
-		panic(err.Error())
+	return last + 1, nil
+}
+
+// setGroup validates and stores a group.
+func (k Keeper) setGroup(ctx context.Context, g group.GroupInfo) error {
+	if err := g.ValidateBasic(); err != nil {
+		return err
 	}
-	k.groupMemberByMemberIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		memberAddr := val.(*group.GroupMember).Member.Address
-		addr, err := accKeeper.AddressCodec().StringToBytes(memberAddr)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	return k.GroupTable.Set(ctx, g.Id, g)
+}
+
+// groupMemberKey returns the primary key of a group member.
+func (k Keeper) groupMemberKey(groupID uint64, member string) (collections.Pair[uint64, sdk.AccAddress], error) {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(member)
 	if err != nil {
-		panic(err.Error())
+		return collections.Pair[uint64, sdk.AccAddress]{}, err
 	}
-	k.groupMemberTable = *groupMemberTable
+	return collections.Join(groupID, sdk.AccAddress(addr)), nil
+}
 
-	// Group Policy Table
-	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
-	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc, k.accKeeper.AddressCodec())
-	if err != nil {
-		panic(err.Error())
+// setGroupMember validates and stores a group member.
+func (k Keeper) setGroupMember(ctx context.Context, m group.GroupMember) error {
+	if err := m.ValidateBasic(); err != nil {
+		return err
 	}
-	k.groupPolicyByGroupIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.GroupPolicyInfo).GroupId}, nil
-	}, group.GroupPolicyInfo{}.GroupId)
+	key, err := k.groupMemberKey(m.GroupId, m.Member.Address)
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.groupPolicyByAdminIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByAdminIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		admin := value.(*group.GroupPolicyInfo).Admin
-		addr, err := accKeeper.AddressCodec().StringToBytes(admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	return k.GroupMemberTable.Set(ctx, key, m)
+}
+
+// removeGroupMember deletes a group member from state.
+func (k Keeper) removeGroupMember(ctx context.Context, groupID uint64, member string) error {
+	key, err := k.groupMemberKey(groupID, member)
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.groupPolicyTable = *groupPolicyTable
+	return k.GroupMemberTable.Remove(ctx, key)
+}
 
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{ProposalTablePrefix}, ProposalTableSeqPrefix, &group.Proposal{}, cdc, k.accKeeper.AddressCodec())
-	if err != nil {
-		panic(err.Error())
+// setGroupPolicy validates and stores a group policy.
+func (k Keeper) setGroupPolicy(ctx context.Context, p group.GroupPolicyInfo) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
 	}
-	k.proposalByGroupPolicyIndex, err = orm.NewIndex(proposalTable, ProposalByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		account := value.(*group.Proposal).GroupPolicyAddress
-		addr, err := accKeeper.AddressCodec().StringToBytes(account)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(p.Address)
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.proposalsByVotingPeriodEnd, err = orm.NewIndex(proposalTable, ProposalsByVotingPeriodEndPrefix, func(value interface{}) ([]interface{}, error) {
-		votingPeriodEnd := value.(*group.Proposal).VotingPeriodEnd
-		return []interface{}{sdk.FormatTimeBytes(votingPeriodEnd)}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
+	return k.GroupPolicyTable.Set(ctx, addr, p)
+}
+
+// setProposal validates and stores a proposal.
+func (k Keeper) setProposal(ctx context.Context, p group.Proposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
 	}
-	k.proposalTable = *proposalTable
+	return k.ProposalTable.Set(ctx, p.Id, p)
+}
 
-	// Vote Table
-	voteTable, err := orm.NewPrimaryKeyTable([2]byte{VoteTablePrefix}, &group.Vote{}, cdc, k.accKeeper.AddressCodec())
+// voteKey returns the primary key of a vote.
+func (k Keeper) voteKey(proposalID uint64, voter string) (collections.Pair[uint64, sdk.AccAddress], error) {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(voter)
 	if err != nil {
-		panic(err.Error())
+		return collections.Pair[uint64, sdk.AccAddress]{}, err
 	}
-	k.voteByProposalIndex, err = orm.NewIndex(voteTable, VoteByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.Vote).ProposalId}, nil
-	}, group.Vote{}.ProposalId)
-	if err != nil {
-		panic(err.Error())
+	return collections.Join(proposalID, sdk.AccAddress(addr)), nil
+}
+
+// setVote validates and stores a vote.
+func (k Keeper) setVote(ctx context.Context, v group.Vote) error {
+	if err := v.ValidateBasic(); err != nil {
+		return err
 	}
-	k.voteByVoterIndex, err = orm.NewIndex(voteTable, VoteByVoterIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		addr, err := accKeeper.AddressCodec().StringToBytes(value.(*group.Vote).Voter)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	key, err := k.voteKey(v.ProposalId, v.Voter)
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.voteTable = *voteTable
-
-	return k
+	return k.VoteTable.Set(ctx, key, v)
 }
 
-// GetGroupSequence returns the current value of the group table sequence
-func (k Keeper) GetGroupSequence(ctx context.Context) uint64 {
-	return k.groupTable.Sequence().CurVal(k.KVStoreService.OpenKVStore(ctx))
-}
-
-// GetGroupPolicySeq returns the current value of the group policy table sequence
-func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
-	return k.groupPolicySeq.CurVal(k.KVStoreService.OpenKVStore(ctx))
-}
-
-// proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
+// proposalsByVPEnd returns all proposals whose voting_period_end is before the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (proposals []group.Proposal, err error) {
-	timeBytes := sdk.FormatTimeBytes(endTime)
-	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(k.KVStoreService.OpenKVStore(ctx), nil, timeBytes)
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndExclusive(collections.Join(endTime, uint64(0)))
+	it, err := k.ProposalTable.Indexes.VotingPeriodEnd.Iterate(ctx, rng)
 	if err != nil {
 		return proposals, err
 	}
 	defer it.Close()
 
-	for {
-		// Important: this following line cannot be outside of the for loop.
-		// It seems that when one unmarshals into the same `group.Proposal`
-		// reference, then gogoproto somehow "adds" the new bytes to the old
-		// object for some fields. When running simulations, for proposals with
-		// each 1-2 proposers, after a couple of loop iterations we got to a
-		// proposal with 60k+ proposers.
-		// So we're declaring a local variable that gets GCed.
-		//
-		// Also see `x/group/types/proposal_test.go`, TestGogoUnmarshalProposal().
-		var proposal group.Proposal
-		_, err := it.LoadNext(&proposal)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for ; it.Valid(); it.Next() {
+		id, err := it.PrimaryKey()
+		if err != nil {
+			return proposals, err
 		}
+		proposal, err := k.ProposalTable.Get(ctx, id)
 		if err != nil {
 			return proposals, err
 		}
@@ -275,7 +477,7 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
-	err := k.proposalTable.Delete(k.KVStoreService.OpenKVStore(ctx), proposalID)
+	err := k.ProposalTable.Remove(ctx, proposalID)
 	if err != nil {
 		return err
 	}
@@ -297,7 +499,7 @@ func (k Keeper) abortProposals(ctx context.Context, groupPolicyAddr sdk.AccAddre
 		if proposalInfo.Status == group.PROPOSAL_STATUS_SUBMITTED {
 			proposalInfo.Status = group.PROPOSAL_STATUS_ABORTED
 
-			if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposalInfo.Id, &proposalInfo); err != nil {
+			if err := k.setProposal(ctx, proposalInfo); err != nil {
 				return err
 			}
 		}
@@ -307,19 +509,19 @@ func (k Keeper) abortProposals(ctx context.Context, groupPolicyAddr sdk.AccAddre
 
 // proposalsByGroupPolicy returns all proposals for a given group policy.
 func (k Keeper) proposalsByGroupPolicy(ctx context.Context, groupPolicyAddr sdk.AccAddress) ([]group.Proposal, error) {
-	proposalIt, err := k.proposalByGroupPolicyIndex.Get(k.KVStoreService.OpenKVStore(ctx), groupPolicyAddr.Bytes())
+	proposalIt, err := k.ProposalTable.Indexes.GroupPolicy.MatchExact(ctx, groupPolicyAddr)
 	if err != nil {
 		return nil, err
 	}
 	defer proposalIt.Close()
 
 	var proposals []group.Proposal
-	for {
-		var proposalInfo group.Proposal
-		_, err = proposalIt.LoadNext(&proposalInfo)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for ; proposalIt.Valid(); proposalIt.Next() {
+		id, err := proposalIt.PrimaryKey()
+		if err != nil {
+			return proposals, err
 		}
+		proposalInfo, err := k.ProposalTable.Get(ctx, id)
 		if err != nil {
 			return proposals, err
 		}
@@ -331,14 +533,17 @@ func (k Keeper) proposalsByGroupPolicy(ctx context.Context, groupPolicyAddr sdk.
 
 // pruneVotes prunes all votes for a proposal from state.
 func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
-	votes, err := k.votesByProposal(ctx, proposalID)
+	it, err := k.VoteTable.Indexes.Proposal.MatchExact(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	keys, err := it.PrimaryKeys()
 	if err != nil {
 		return err
 	}
 
-	for _, v := range votes {
-		err = k.voteTable.Delete(k.KVStoreService.OpenKVStore(ctx), &v)
-		if err != nil {
+	for _, key := range keys {
+		if err := k.VoteTable.Remove(ctx, key); err != nil {
 			return err
 		}
 	}
@@ -346,29 +551,6 @@ func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 	return nil
 }
 
-// votesByProposal returns all votes for a given proposal.
-func (k Keeper) votesByProposal(ctx context.Context, proposalID uint64) ([]group.Vote, error) {
-	it, err := k.voteByProposalIndex.Get(k.KVStoreService.OpenKVStore(ctx), proposalID)
-	if err != nil {
-		return nil, err
-	}
-	defer it.Close()
-
-	var votes []group.Vote
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
-		if err != nil {
-			return votes, err
-		}
-		votes = append(votes, vote)
-	}
-	return votes, nil
-}
-
 // PruneProposals prunes all proposals that are expired, i.e. whose
 // `voting_period + max_execution_period` is greater than the current block
 // time.
@@ -439,7 +621,7 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context) error {
 				return errorsmod.Wrap(err, "doTallyAndUpdate")
 			}
 
-			if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposal.Id, &proposal); err != nil {
+			if err := k.setProposal(ctx, proposal); err != nil {
 				return errorsmod.Wrap(err, "proposal update")
 			}
 		}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
 	v2 "cosmossdk.io/x/group/migrations/v2"
+	v3 "cosmossdk.io/x/group/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -18,11 +21,21 @@ func NewMigrator(keeper Keeper) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx context.Context) error {
+	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, m.keeper.cdc, m.keeper.accKeeper.AddressCodec())
+	if err != nil {
+		return err
+	}
+
 	return v2.Migrate(
 		ctx,
 		m.keeper.KVStoreService,
 		m.keeper.accKeeper,
-		m.keeper.groupPolicySeq,
-		m.keeper.groupPolicyTable,
+		orm.NewSequence(GroupPolicyTableSeqPrefix),
+		*groupPolicyTable,
 	)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	return v3.Migrate(ctx, m.keeper.KVStoreService)
+}
@@ -9,12 +9,12 @@ import (
 	"slices"
 	"strings"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	govtypes "cosmossdk.io/x/gov/types"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
 	"cosmossdk.io/x/group/internal/math"
-	"cosmossdk.io/x/group/internal/orm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -61,30 +61,33 @@ func (k Keeper) CreateGroup(ctx context.Context, msg *group.MsgCreateGroup) (*gr
 		}
 	}
 
-	// Create a new group in the groupTable.
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-	groupInfo := &group.GroupInfo{
-		Id:          k.groupTable.Sequence().PeekNextVal(kvStore),
+	// Create a new group.
+	groupID, err := nextSequenceValue(ctx, k.GroupSeq)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "could not create group")
+	}
+	groupInfo := group.GroupInfo{
+		Id:          groupID,
 		Admin:       msg.Admin,
 		Metadata:    msg.Metadata,
 		Version:     1,
 		TotalWeight: totalWeight.String(),
 		CreatedAt:   k.HeaderService.HeaderInfo(ctx).Time,
 	}
-	groupID, err := k.groupTable.Create(kvStore, groupInfo)
-	if err != nil {
+	if err := k.setGroup(ctx, groupInfo); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group")
 	}
 
-	// Create new group members in the groupMemberTable.
+	// Create new group members.
 	for i, m := range msg.Members {
-		err := k.groupMemberTable.Create(kvStore, &group.GroupMember{
+		err := k.setGroupMember(ctx, group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
 				Address:  m.Address,
 				Weight:   m.Weight,
 				Metadata: m.Metadata,
 				AddedAt:  k.HeaderService.HeaderInfo(ctx).Time,
+				Roles:    m.Roles,
 			},
 		})
 		if err != nil {
@@ -112,7 +115,6 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 		return nil, errorsmod.Wrap(err, "members")
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	action := func(g *group.GroupInfo) error {
 		totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
 		if err != nil {
@@ -129,16 +131,21 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 					Address:  member.Address,
 					Weight:   member.Weight,
 					Metadata: member.Metadata,
+					Roles:    member.Roles,
 				},
 			}
 
 			// Checking if the group member is already part of the group
+			key, err := k.groupMemberKey(msg.GroupId, member.Address)
+			if err != nil {
+				return errorsmod.Wrap(err, "get group member")
+			}
 			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&groupMember, k.accKeeper.AddressCodec()), &prevGroupMember); {
+			prevGroupMember, err := k.GroupMemberTable.Get(ctx, key)
+			switch {
 			case err == nil:
 				found = true
-			case sdkerrors.ErrNotFound.Is(err):
+			case errorsmod.IsOf(err, collections.ErrNotFound):
 				found = false
 			default:
 				return errorsmod.Wrap(err, "get group member")
@@ -167,8 +174,8 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 					return err
 				}
 
-				// Delete group member in the groupMemberTable.
-				if err := k.groupMemberTable.Delete(kvStore, &groupMember); err != nil {
+				// Delete group member.
+				if err := k.GroupMemberTable.Remove(ctx, key); err != nil {
 					return errorsmod.Wrap(err, "delete member")
 				}
 				continue
@@ -184,14 +191,14 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				if err != nil {
 					return err
 				}
-				// Save updated group member in the groupMemberTable.
+				// Save updated group member.
 				groupMember.Member.AddedAt = prevGroupMember.Member.AddedAt
-				if err := k.groupMemberTable.Update(kvStore, &groupMember); err != nil {
+				if err := k.setGroupMember(ctx, groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
 			} else { // else handle create.
 				groupMember.Member.AddedAt = k.HeaderService.HeaderInfo(ctx).Time
-				if err := k.groupMemberTable.Create(kvStore, &groupMember); err != nil {
+				if err := k.setGroupMember(ctx, groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
 			}
@@ -205,7 +212,7 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 		if totalWeight.IsZero() {
 			return errorsmod.Wrap(errors.ErrInvalid, "group must not be empty")
 		}
-		// Update group in the groupTable.
+		// Update group.
 		g.TotalWeight = totalWeight.String()
 		g.Version++
 
@@ -213,7 +220,7 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 			return err
 		}
 
-		return k.groupTable.Update(kvStore, g.Id, g)
+		return k.setGroup(ctx, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "members updated"); err != nil {
@@ -240,12 +247,11 @@ func (k Keeper) UpdateGroupAdmin(ctx context.Context, msg *group.MsgUpdateGroupA
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "new admin address")
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	action := func(g *group.GroupInfo) error {
 		g.Admin = msg.NewAdmin
 		g.Version++
 
-		return k.groupTable.Update(kvStore, g.Id, g)
+		return k.setGroup(ctx, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "admin updated"); err != nil {
@@ -268,11 +274,10 @@ func (k Keeper) UpdateGroupMetadata(ctx context.Context, msg *group.MsgUpdateGro
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "admin address")
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	action := func(g *group.GroupInfo) error {
 		g.Metadata = msg.Metadata
 		g.Version++
-		return k.groupTable.Update(kvStore, g.Id, g)
+		return k.setGroup(ctx, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "metadata updated"); err != nil {
@@ -372,14 +377,15 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 		return nil, err
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-
 	// Generate account address of group policy.
 	var accountAddr sdk.AccAddress
 	// loop here in the rare case where a ADR-028-derived address creates a
 	// collision with an existing address.
 	for {
-		nextAccVal := k.groupPolicySeq.NextVal(kvStore)
+		nextAccVal, err := nextSequenceValue(ctx, k.GroupPolicySeq)
+		if err != nil {
+			return nil, err
+		}
 		derivationKey := make([]byte, 8)
 		binary.BigEndian.PutUint64(derivationKey, nextAccVal)
 
@@ -424,7 +430,7 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 		return nil, err
 	}
 
-	if err := k.groupPolicyTable.Create(kvStore, &groupPolicy); err != nil {
+	if err := k.setGroupPolicy(ctx, groupPolicy); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group policy")
 	}
 
@@ -444,11 +450,10 @@ func (k Keeper) UpdateGroupPolicyAdmin(ctx context.Context, msg *group.MsgUpdate
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "new admin address")
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Admin = msg.NewAdmin
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+		return k.setGroupPolicy(ctx, *groupPolicy)
 	}
 
 	if err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy admin updated"); err != nil {
@@ -468,7 +473,6 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(ctx context.Context, msg *group.
 		return nil, errorsmod.Wrap(err, "decision policy")
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupInfo, err := k.getGroupInfo(ctx, groupPolicy.GroupId)
 		if err != nil {
@@ -486,7 +490,7 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(ctx context.Context, msg *group.
 		}
 
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+		return k.setGroupPolicy(ctx, *groupPolicy)
 	}
 
 	if err = k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy's decision policy updated"); err != nil {
@@ -498,12 +502,11 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(ctx context.Context, msg *group.
 
 func (k Keeper) UpdateGroupPolicyMetadata(ctx context.Context, msg *group.MsgUpdateGroupPolicyMetadata) (*group.MsgUpdateGroupPolicyMetadataResponse, error) {
 	metadata := msg.GetMetadata()
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Metadata = metadata
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+		return k.setGroupPolicy(ctx, *groupPolicy)
 	}
 
 	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
@@ -570,7 +573,6 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	policyAcc, err := k.getGroupPolicyInfo(ctx, msg.GroupPolicyAddress)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "load group policy: %s", msg.GroupPolicyAddress)
@@ -583,7 +585,15 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 
 	// Only members of the group can submit a new proposal.
 	for _, proposer := range msg.Proposers {
-		if !k.groupMemberTable.Has(kvStore, orm.PrimaryKey(&group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: proposer}}, k.accKeeper.AddressCodec())) {
+		key, err := k.groupMemberKey(groupInfo.Id, proposer)
+		if err != nil {
+			return nil, err
+		}
+		isMember, err := k.GroupMemberTable.Has(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
 			return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "not in group: %s", proposer)
 		}
 	}
@@ -603,8 +613,13 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	lastProposalID, err := k.ProposalSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	m := &group.Proposal{
-		Id:                 k.proposalTable.Sequence().PeekNextVal(kvStore),
+		Id:                 lastProposalID + 1,
 		GroupPolicyAddress: msg.GroupPolicyAddress,
 		Metadata:           msg.Metadata,
 		Proposers:          msg.Proposers,
@@ -623,7 +638,10 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
 
-	id, err := k.proposalTable.Create(kvStore, m)
+	if err := k.setProposal(ctx, *m); err != nil {
+		return nil, errorsmod.Wrap(err, "create proposal")
+	}
+	id, err := nextSequenceValue(ctx, k.ProposalSeq)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
@@ -673,7 +691,6 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy admin / proposer address: %s", msg.Address)
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	proposal, err := k.getProposal(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
@@ -695,7 +712,7 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 	}
 
 	proposal.Status = group.PROPOSAL_STATUS_WITHDRAWN
-	if err := k.proposalTable.Update(kvStore, msg.ProposalId, &proposal); err != nil {
+	if err := k.setProposal(ctx, proposal); err != nil {
 		return nil, err
 	}
 
@@ -728,7 +745,6 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	proposal, err := k.getProposal(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
@@ -755,7 +771,7 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 
 	// Count and store votes.
 	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
-	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
+	if _, err := k.getGroupMember(ctx, &voter); err != nil {
 		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
 	}
 	newVote := group.Vote{
@@ -766,9 +782,19 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		SubmitTime: k.HeaderService.HeaderInfo(ctx).Time,
 	}
 
-	// The ORM will return an error if the vote already exists,
-	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(kvStore, &newVote); err != nil {
+	// Make sure that a voter hasn't already voted.
+	voteKey, err := k.voteKey(msg.ProposalId, msg.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "store vote")
+	}
+	hasVoted, err := k.VoteTable.Has(ctx, voteKey)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "store vote")
+	}
+	if hasVoted {
+		return nil, errorsmod.Wrap(errors.ErrORMUniqueConstraint, "store vote")
+	}
+	if err := k.setVote(ctx, newVote); err != nil {
 		return nil, errorsmod.Wrap(err, "store vote")
 	}
 
@@ -801,9 +827,22 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
-	if err != nil {
-		return errorsmod.Wrap(err, "policy allow")
+	var result group.DecisionPolicyResult
+	if rolePolicy, ok := policy.(group.RoleAwareDecisionPolicy); ok {
+		roleTallies, err := k.RoleTally(ctx, *p, policyInfo.GroupId, rolePolicy.GetRoles())
+		if err != nil {
+			return err
+		}
+
+		result, err = rolePolicy.AllowRoles(roleTallies)
+		if err != nil {
+			return errorsmod.Wrap(err, "policy allow")
+		}
+	} else {
+		result, err = policy.Allow(tallyResult, groupInfo.TotalWeight)
+		if err != nil {
+			return errorsmod.Wrap(err, "policy allow")
+		}
 	}
 
 	// If the result was final (i.e. enough votes to pass) or if the voting
@@ -879,7 +918,7 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 		}
 	}
 
-	// Update proposal in proposalTable
+	// Update proposal in state.
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
@@ -896,8 +935,7 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 			return nil, err
 		}
 	} else {
-		store := k.KVStoreService.OpenKVStore(ctx)
-		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
+		if err := k.setProposal(ctx, proposal); err != nil {
 			return nil, err
 		}
 	}
@@ -954,10 +992,8 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, err
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-
-	// delete group member in the groupMemberTable.
-	if err := k.groupMemberTable.Delete(kvStore, gm); err != nil {
+	// delete group member.
+	if err := k.removeGroupMember(ctx, gm.GroupId, gm.Member.Address); err != nil {
 		return nil, errorsmod.Wrap(err, "group member")
 	}
 
@@ -969,7 +1005,7 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, err
 	}
 
-	if err := k.groupTable.Update(kvStore, groupInfo.Id, &groupInfo); err != nil {
+	if err := k.setGroup(ctx, groupInfo); err != nil {
 		return nil, err
 	}
 
@@ -984,13 +1020,15 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 }
 
 func (k Keeper) getGroupMember(ctx context.Context, member *group.GroupMember) (*group.GroupMember, error) {
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-	var groupMember group.GroupMember
-	switch err := k.groupMemberTable.GetOne(kvStore,
-		orm.PrimaryKey(member, k.accKeeper.AddressCodec()), &groupMember); {
+	key, err := k.groupMemberKey(member.GroupId, member.Member.Address)
+	if err != nil {
+		return nil, err
+	}
+	groupMember, err := k.GroupMemberTable.Get(ctx, key)
+	switch {
 	case err == nil:
 		break
-	case sdkerrors.ErrNotFound.Is(err):
+	case errorsmod.IsOf(err, collections.ErrNotFound):
 		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not part of group %d", member.Member.Address, member.GroupId)
 	default:
 		return nil, err
@@ -1068,19 +1106,18 @@ func (k Keeper) doUpdateGroup(ctx context.Context, groupID uint64, reqGroupAdmin
 // validateDecisionPolicies loops through all decision policies from the group,
 // and calls each of their Validate() method.
 func (k Keeper) validateDecisionPolicies(ctx context.Context, g group.GroupInfo) error {
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-	it, err := k.groupPolicyByGroupIndex.Get(kvStore, g.Id)
+	it, err := k.GroupPolicyTable.Indexes.Group.MatchExact(ctx, g.Id)
 	if err != nil {
 		return err
 	}
 	defer it.Close()
 
-	for {
-		var groupPolicy group.GroupPolicyInfo
-		_, err = it.LoadNext(&groupPolicy)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for ; it.Valid(); it.Next() {
+		addr, err := it.PrimaryKey()
+		if err != nil {
+			return err
 		}
+		groupPolicy, err := k.GroupPolicyTable.Get(ctx, addr)
 		if err != nil {
 			return err
 		}
@@ -1115,8 +1152,8 @@ func (k Keeper) validateProposers(proposers []string) error {
 }
 
 // validateMembers checks that all members addresses are valid.
-// additionally it verifies that there is no duplicate address
-// and the member weight is non-negative.
+// additionally it verifies that there is no duplicate address,
+// the member weight is non-negative and the member roles are valid.
 // Note: in state, a member's weight MUST be positive. However, in some Msgs,
 // it's possible to set a zero member weight, for example in
 // MsgUpdateGroupMembers to denote that we're removing a member.
@@ -1136,6 +1173,10 @@ func (k Keeper) validateMembers(members []group.MemberRequest) error {
 		if _, err := math.NewNonNegativeDecFromString(member.Weight); err != nil {
 			return errorsmod.Wrap(err, "weight must be non negative")
 		}
+
+		if err := group.ValidateMemberRoles(member.Roles); err != nil {
+			return errorsmod.Wrapf(err, "member %s", member.Address)
+		}
 		index[member.Address] = struct{}{}
 	}
 	return nil
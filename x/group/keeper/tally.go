@@ -3,12 +3,12 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
-	"cosmossdk.io/x/group/errors"
-	"cosmossdk.io/x/group/internal/orm"
+	"cosmossdk.io/x/group/internal/math"
 
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Tally is a function that tallies a proposal by iterating through its votes,
@@ -23,9 +23,7 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 		return p.FinalTallyResult, nil
 	}
 
-	kvStore := k.KVStoreService.OpenKVStore(ctx)
-
-	it, err := k.voteByProposalIndex.Get(kvStore, p.Id)
+	it, err := k.VoteTable.Indexes.Proposal.MatchExact(ctx, p.Id)
 	if err != nil {
 		return group.TallyResult{}, err
 	}
@@ -33,24 +31,19 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 
 	tallyResult := group.DefaultTallyResult()
 
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for ; it.Valid(); it.Next() {
+		key, err := it.PrimaryKey()
+		if err != nil {
+			return group.TallyResult{}, err
 		}
+		vote, err := k.VoteTable.Get(ctx, key)
 		if err != nil {
 			return group.TallyResult{}, err
 		}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}, k.accKeeper.AddressCodec()), &member)
-
+		member, err := k.GroupMemberTable.Get(ctx, collections.Join(groupID, key.K2()))
 		switch {
-		case sdkerrors.ErrNotFound.Is(err):
+		case errorsmod.IsOf(err, collections.ErrNotFound):
 			// If the member left the group after voting, then we simply skip the
 			// vote.
 			continue
@@ -66,3 +59,62 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 
 	return tallyResult, nil
 }
+
+// RoleTally tallies the votes of a proposal for each of the given roles, by
+// iterating through the group members holding them. The votes of each member
+// are weighted by the member's weight within the role.
+func (k Keeper) RoleTally(ctx context.Context, p group.Proposal, groupID uint64, roles []string) (map[string]group.RoleTallyResult, error) {
+	roleTallies := make(map[string]group.RoleTallyResult, len(roles))
+	for _, role := range roles {
+		roleTallies[role] = group.RoleTallyResult{TallyResult: group.DefaultTallyResult(), TotalWeight: "0"}
+	}
+
+	it, err := k.GroupMemberTable.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](groupID))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		vote, err := k.VoteTable.Get(ctx, collections.Join(p.Id, kv.Key.K2()))
+		hasVoted := err == nil
+		if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		for _, memberRole := range kv.Value.Member.Roles {
+			roleTally, ok := roleTallies[memberRole.Name]
+			if !ok {
+				continue
+			}
+
+			totalWeight, err := math.NewNonNegativeDecFromString(roleTally.TotalWeight)
+			if err != nil {
+				return nil, err
+			}
+			weight, err := math.NewPositiveDecFromString(memberRole.Weight)
+			if err != nil {
+				return nil, err
+			}
+			totalWeight, err = totalWeight.Add(weight)
+			if err != nil {
+				return nil, err
+			}
+			roleTally.TotalWeight = totalWeight.String()
+
+			if hasVoted {
+				if err := roleTally.Add(vote, memberRole.Weight); err != nil {
+					return nil, errorsmod.Wrap(err, "add new vote")
+				}
+			}
+			roleTallies[memberRole.Name] = roleTally
+		}
+	}
+
+	return roleTallies, nil
+}
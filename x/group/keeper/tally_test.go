@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/core/header"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 
@@ -83,3 +84,119 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoleDecisionPolicy() {
+	votingPeriod := time.Minute
+	members := []group.MemberRequest{
+		{Address: s.addrsStr[1], Weight: "1", Roles: []group.MemberRole{{Name: "council", Weight: "1"}, {Name: "treasury", Weight: "1"}}},
+		{Address: s.addrsStr[2], Weight: "1", Roles: []group.MemberRole{{Name: "council", Weight: "1"}}},
+		{Address: s.addrsStr[3], Weight: "1", Roles: []group.MemberRole{{Name: "council", Weight: "1"}}},
+		{Address: s.addrsStr[4], Weight: "1", Roles: []group.MemberRole{{Name: "veto", Weight: "1"}}},
+	}
+	policy := group.NewRoleDecisionPolicy(
+		[]group.RoleThreshold{{Role: "council", Threshold: "2"}, {Role: "treasury", Threshold: "1"}},
+		"veto",
+		votingPeriod,
+		0,
+	)
+
+	// member roles are validated
+	_, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   s.addrsStr[0],
+		Members: []group.MemberRequest{{Address: s.addrsStr[1], Weight: "1", Roles: []group.MemberRole{{Name: "council", Weight: "1"}, {Name: "council", Weight: "2"}}}},
+	})
+	s.Require().ErrorContains(err, "duplicate value")
+
+	specs := map[string]struct {
+		votes           map[string]group.VoteOption
+		elapsed         time.Duration
+		expStatus       group.ProposalStatus
+		expCouncilTally group.TallyResult
+	}{
+		"all roles approve": {
+			votes:     map[string]group.VoteOption{s.addrsStr[1]: group.VOTE_OPTION_YES, s.addrsStr[2]: group.VOTE_OPTION_YES},
+			elapsed:   votingPeriod + time.Second,
+			expStatus: group.PROPOSAL_STATUS_ACCEPTED,
+			expCouncilTally: group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
+		"approval is not final during the voting period": {
+			votes:     map[string]group.VoteOption{s.addrsStr[1]: group.VOTE_OPTION_YES, s.addrsStr[2]: group.VOTE_OPTION_YES},
+			expStatus: group.PROPOSAL_STATUS_SUBMITTED,
+			expCouncilTally: group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
+		"treasury does not approve": {
+			votes:     map[string]group.VoteOption{s.addrsStr[1]: group.VOTE_OPTION_NO, s.addrsStr[2]: group.VOTE_OPTION_YES, s.addrsStr[3]: group.VOTE_OPTION_YES},
+			expStatus: group.PROPOSAL_STATUS_REJECTED,
+			expCouncilTally: group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "1",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
+		"vetoed": {
+			votes:     map[string]group.VoteOption{s.addrsStr[1]: group.VOTE_OPTION_YES, s.addrsStr[2]: group.VOTE_OPTION_YES, s.addrsStr[4]: group.VOTE_OPTION_NO_WITH_VETO},
+			expStatus: group.PROPOSAL_STATUS_REJECTED,
+			expCouncilTally: group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
+	}
+
+	for msg, spec := range specs {
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			s.ctx = sdkCtx
+			policyAddr, groupID := s.createGroupAndGroupPolicy(s.addrs[0], members, policy)
+
+			proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{s.addrsStr[1]},
+			})
+			s.Require().NoError(err)
+
+			for voter, option := range spec.votes {
+				_, err := s.groupKeeper.Vote(sdkCtx, &group.MsgVote{
+					ProposalId: proposalRes.ProposalId,
+					Voter:      voter,
+					Option:     option,
+				})
+				s.Require().NoError(err)
+			}
+
+			proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			roleTallies, err := s.groupKeeper.RoleTally(sdkCtx, *proposal.Proposal, groupID, policy.(group.RoleAwareDecisionPolicy).GetRoles())
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expCouncilTally, roleTallies["council"].TallyResult)
+			s.Require().Equal("3", roleTallies["council"].TotalWeight)
+
+			ctx := sdkCtx.WithHeaderInfo(header.Info{Time: sdkCtx.HeaderInfo().Time.Add(spec.elapsed)})
+			execRes, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: s.addrsStr[1]})
+			s.Require().NoError(err)
+
+			// accepted proposals are pruned once successfully executed
+			if spec.expStatus == group.PROPOSAL_STATUS_ACCEPTED {
+				s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+				return
+			}
+
+			proposal, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expStatus, proposal.Proposal.Status)
+		})
+	}
+}
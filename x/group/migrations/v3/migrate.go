@@ -0,0 +1,80 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalsByVotingPeriodEndPrefix is the prefix of the proposals by voting
+// period end index.
+const ProposalsByVotingPeriodEndPrefix byte = 0x33
+
+// Migrate migrates the x/group module state from the consensus version 2 to version 3.
+// The x/group state is now handled through collections, which reuse the layout
+// of the former ORM tables. The only exception is the proposals by voting
+// period end index whose reference key was a length prefixed time, it is
+// rewritten with the time key encoding so it decodes as a time.
+func Migrate(ctx context.Context, storeService store.KVStoreService) error {
+	kvStore := storeService.OpenKVStore(ctx)
+	entries, err := legacyVotingPeriodEndEntries(kvStore)
+	if err != nil {
+		return err
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	index := collections.NewKeySet(
+		sb, collections.NewPrefix([]byte{ProposalsByVotingPeriodEndPrefix}), "proposals_by_voting_period_end",
+		collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), //nolint:staticcheck // keeps the nanosecond precision of the former index.
+	)
+	for _, e := range entries {
+		if err := kvStore.Delete(e.key); err != nil {
+			return err
+		}
+		if err := index.Set(ctx, collections.Join(e.votingPeriodEnd, e.proposalID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type votingPeriodEndEntry struct {
+	key             []byte
+	votingPeriodEnd time.Time
+	proposalID      uint64
+}
+
+// legacyVotingPeriodEndEntries reads the proposals by voting period end index
+// keys written by the ORM: prefix | len(time) | time | proposal id.
+func legacyVotingPeriodEndEntries(kvStore store.KVStore) ([]votingPeriodEndEntry, error) {
+	it, err := kvStore.Iterator([]byte{ProposalsByVotingPeriodEndPrefix}, []byte{ProposalsByVotingPeriodEndPrefix + 1})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var entries []votingPeriodEndEntry
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) < 2 || len(key) != 2+int(key[1])+8 {
+			return nil, fmt.Errorf("invalid proposals by voting period end index key %X", key)
+		}
+		timeBz, idBz := key[2:2+int(key[1])], key[2+int(key[1]):]
+		votingPeriodEnd, err := sdk.ParseTimeBytes(timeBz)
+		if err != nil {
+			return nil, err
+		}
+		_, proposalID, err := collections.Uint64Key.Decode(idBz)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, votingPeriodEndEntry{key: key, votingPeriodEnd: votingPeriodEnd, proposalID: proposalID})
+	}
+	return entries, nil
+}
@@ -0,0 +1,59 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	v3 "cosmossdk.io/x/group/migrations/v3"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("group")
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	ends := map[uint64]time.Time{
+		1: now,
+		2: now.Add(time.Hour),
+		3: now.Add(-time.Hour),
+	}
+
+	// write the index keys the way the ORM did: prefix | len(time) | time | proposal id.
+	kvStore := storeService.OpenKVStore(ctx)
+	for id, end := range ends {
+		key := append([]byte{v3.ProposalsByVotingPeriodEndPrefix}, address.MustLengthPrefix(sdk.FormatTimeBytes(end))...)
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+		require.NoError(t, kvStore.Set(key, []byte{}))
+	}
+
+	require.NoError(t, v3.Migrate(ctx, storeService))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	index := collections.NewKeySet(
+		sb, collections.NewPrefix([]byte{v3.ProposalsByVotingPeriodEndPrefix}), "proposals_by_voting_period_end",
+		collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), //nolint:staticcheck // same codec as the keeper index.
+	)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	it, err := index.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := it.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[time.Time, uint64]{
+		collections.Join(ends[3], uint64(3)),
+		collections.Join(ends[1], uint64(1)),
+		collections.Join(ends[2], uint64(2)),
+	}, keys)
+}
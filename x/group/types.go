@@ -2,6 +2,7 @@ package group
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -245,6 +246,159 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// RoleTallyResult is the tally result of the votes of the group members
+// holding a role, weighted by their weight within the role.
+type RoleTallyResult struct {
+	TallyResult
+	// TotalWeight is the sum of the role weights of all the group members
+	// holding the role.
+	TotalWeight string
+}
+
+// RoleAwareDecisionPolicy is a DecisionPolicy whose result depends on the
+// votes of the group members holding given roles.
+type RoleAwareDecisionPolicy interface {
+	DecisionPolicy
+
+	// GetRoles returns the roles whose tally results are required by AllowRoles.
+	GetRoles() []string
+	// AllowRoles is like Allow, but based on the tally result of each of the
+	// roles returned by GetRoles.
+	AllowRoles(roleTallies map[string]RoleTallyResult) (DecisionPolicyResult, error)
+}
+
+// Implements RoleAwareDecisionPolicy Interface
+var _ RoleAwareDecisionPolicy = &RoleDecisionPolicy{}
+
+// NewRoleDecisionPolicy creates a new role DecisionPolicy
+func NewRoleDecisionPolicy(thresholds []RoleThreshold, vetoRole string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &RoleDecisionPolicy{thresholds, vetoRole, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of RoleDecisionPolicy
+func (p RoleDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of RoleDecisionPolicy
+func (p RoleDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetRoles returns the roles of the thresholds and the veto role of RoleDecisionPolicy
+func (p RoleDecisionPolicy) GetRoles() []string {
+	roles := make([]string, 0, len(p.Thresholds)+1)
+	for _, t := range p.Thresholds {
+		roles = append(roles, t.Role)
+	}
+	if p.VetoRole != "" {
+		roles = append(roles, p.VetoRole)
+	}
+	return roles
+}
+
+// ValidateBasic does basic validation on RoleDecisionPolicy
+func (p RoleDecisionPolicy) ValidateBasic() error {
+	if len(p.Thresholds) == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "role thresholds")
+	}
+
+	index := make(map[string]struct{}, len(p.Thresholds))
+	for _, t := range p.Thresholds {
+		if strings.TrimSpace(t.Role) == "" {
+			return errorsmod.Wrap(errors.ErrEmpty, "threshold role")
+		}
+		if _, exists := index[t.Role]; exists {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "threshold role: %s", t.Role)
+		}
+		if _, err := math.NewPositiveDecFromString(t.Threshold); err != nil {
+			return errorsmod.Wrapf(err, "threshold of role %s", t.Role)
+		}
+		index[t.Role] = struct{}{}
+	}
+
+	if p.VetoRole != "" && strings.TrimSpace(p.VetoRole) == "" {
+		return errorsmod.Wrap(errors.ErrInvalid, "veto role cannot be blank")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Validate validates the policy against the group.
+func (p *RoleDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow always fails for RoleDecisionPolicy, as it requires the tally result
+// of each role, see AllowRoles.
+func (p RoleDecisionPolicy) Allow(TallyResult, string) (DecisionPolicyResult, error) {
+	return DecisionPolicyResult{}, errorsmod.Wrap(errors.ErrInvalid, "role decision policy requires role tally results")
+}
+
+// AllowRoles allows a proposal to pass when, for each role threshold, the
+// tally of yes votes of the role equals or exceeds the threshold, unless a
+// member holding the veto role vetoed it.
+func (p RoleDecisionPolicy) AllowRoles(roleTallies map[string]RoleTallyResult) (DecisionPolicyResult, error) {
+	if p.VetoRole != "" {
+		vetoCount, err := math.NewNonNegativeDecFromString(roleTallies[p.VetoRole].NoWithVetoCount)
+		if err != nil {
+			return DecisionPolicyResult{}, errorsmod.Wrap(err, "veto count")
+		}
+		if !vetoCount.IsZero() {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+	}
+
+	allow := true
+	for _, t := range p.Thresholds {
+		result, err := allowRoleThreshold(t.Threshold, roleTallies[t.Role])
+		if err != nil {
+			return DecisionPolicyResult{}, errorsmod.Wrapf(err, "role %s", t.Role)
+		}
+
+		// a single role that cannot reach its threshold anymore rejects the proposal
+		if !result.Allow && result.Final {
+			return result, nil
+		}
+		allow = allow && result.Allow
+	}
+
+	// Unless vetoed, the result is only final once all the thresholds are reached.
+	return DecisionPolicyResult{Allow: allow, Final: allow && p.VetoRole == ""}, nil
+}
+
+// allowRoleThreshold applies a threshold to the tally result of a role, as a
+// ThresholdDecisionPolicy does to the tally result of the group. Contrary to
+// the group's total weight, the total weight of a role can be zero, in which
+// case the threshold can never be reached.
+func allowRoleThreshold(threshold string, roleTally RoleTallyResult) (DecisionPolicyResult, error) {
+	totalWeight := roleTally.TotalWeight
+	if totalWeight == "" {
+		totalWeight = "0"
+	}
+	totalWeightDec, err := math.NewNonNegativeDecFromString(totalWeight)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "role total weight")
+	}
+	if totalWeightDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	tallyResult := roleTally.TallyResult
+	if tallyResult.YesCount == "" {
+		tallyResult = DefaultTallyResult()
+	}
+
+	return ThresholdDecisionPolicy{Threshold: threshold}.Allow(tallyResult, totalWeight)
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -382,6 +536,29 @@ func (g GroupMember) ValidateBasic() error {
 		return errorsmod.Wrap(err, "weight must be non negative")
 	}
 
+	if err := ValidateMemberRoles(g.Member.Roles); err != nil {
+		return errorsmod.Wrap(err, "group member's roles")
+	}
+
+	return nil
+}
+
+// ValidateMemberRoles checks that the roles of a member have a name and a
+// positive weight, and that there is no duplicate role.
+func ValidateMemberRoles(roles []MemberRole) error {
+	index := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		if strings.TrimSpace(role.Name) == "" {
+			return errorsmod.Wrap(errors.ErrEmpty, "role name")
+		}
+		if _, exists := index[role.Name]; exists {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "role: %s", role.Name)
+		}
+		if _, err := math.NewPositiveDecFromString(role.Weight); err != nil {
+			return errorsmod.Wrapf(err, "weight of role %s", role.Name)
+		}
+		index[role.Name] = struct{}{}
+	}
 	return nil
 }
 
@@ -394,6 +571,7 @@ func MemberToMemberRequest(m *Member) MemberRequest {
 		Address:  m.Address,
 		Weight:   m.Weight,
 		Metadata: m.Metadata,
+		Roles:    m.Roles,
 	}
 }
 
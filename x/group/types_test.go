@@ -408,3 +408,147 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	testCases := []struct {
+		name   string
+		policy group.RoleDecisionPolicy
+		expErr bool
+	}{
+		{
+			"no thresholds",
+			group.RoleDecisionPolicy{VetoRole: "veto", Windows: windows},
+			true,
+		},
+		{
+			"empty threshold role",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "", Threshold: "1"}}, Windows: windows},
+			true,
+		},
+		{
+			"duplicate threshold role",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "1"}, {Role: "council", Threshold: "2"}}, Windows: windows},
+			true,
+		},
+		{
+			"zero threshold",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "0"}}, Windows: windows},
+			true,
+		},
+		{
+			"blank veto role",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "1"}}, VetoRole: " ", Windows: windows},
+			true,
+		},
+		{
+			"zero voting period",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "1"}}, Windows: &group.DecisionPolicyWindows{}},
+			true,
+		},
+		{
+			"all good",
+			group.RoleDecisionPolicy{Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "2"}, {Role: "treasury", Threshold: "1"}}, VetoRole: "veto", Windows: windows},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleDecisionPolicyAllow(t *testing.T) {
+	roleTally := func(yes, no, veto, totalWeight string) group.RoleTallyResult {
+		return group.RoleTallyResult{
+			TallyResult: group.TallyResult{YesCount: yes, NoCount: no, AbstainCount: "0", NoWithVetoCount: veto},
+			TotalWeight: totalWeight,
+		}
+	}
+	policy := group.RoleDecisionPolicy{
+		Thresholds: []group.RoleThreshold{{Role: "council", Threshold: "2"}, {Role: "treasury", Threshold: "1"}},
+		Windows:    &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+	}
+	vetoPolicy := policy
+	vetoPolicy.VetoRole = "veto"
+
+	testCases := []struct {
+		name        string
+		policy      group.RoleDecisionPolicy
+		roleTallies map[string]group.RoleTallyResult
+		result      group.DecisionPolicyResult
+	}{
+		{
+			"all thresholds reached",
+			policy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("2", "1", "0", "3"),
+				"treasury": roleTally("1", "0", "0", "1"),
+			},
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"one threshold not reached yet",
+			policy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("2", "0", "0", "3"),
+				"treasury": roleTally("0", "0", "0", "1"),
+			},
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"one threshold cannot be reached anymore",
+			policy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("0", "2", "0", "3"),
+				"treasury": roleTally("1", "0", "0", "1"),
+			},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"role without members",
+			policy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("2", "0", "0", "3"),
+				"treasury": roleTally("0", "0", "0", "0"),
+			},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"all thresholds reached, not final until the end of the voting period with a veto role",
+			vetoPolicy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("2", "0", "0", "3"),
+				"treasury": roleTally("1", "0", "0", "1"),
+				"veto":     roleTally("0", "0", "0", "1"),
+			},
+			group.DecisionPolicyResult{Allow: true, Final: false},
+		},
+		{
+			"vetoed",
+			vetoPolicy,
+			map[string]group.RoleTallyResult{
+				"council":  roleTally("2", "0", "0", "3"),
+				"treasury": roleTally("1", "0", "0", "1"),
+				"veto":     roleTally("0", "0", "1", "1"),
+			},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.policy.AllowRoles(tc.roleTallies)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, result)
+		})
+	}
+
+	_, err := policy.Allow(group.DefaultTallyResult(), "10")
+	require.Error(t, err)
+}
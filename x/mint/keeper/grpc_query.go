@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/x/mint/types"
 )
 
@@ -45,3 +48,21 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// InflationProjections returns the projected provisions of the next periods of the inflation curve.
+func (q queryServer) InflationProjections(ctx context.Context, req *types.QueryInflationProjectionsRequest) (*types.QueryInflationProjectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.NumPeriods == 0 || req.NumPeriods > types.MaxInflationProjections {
+		return nil, status.Errorf(codes.InvalidArgument, "number of periods must be between 1 and %d", types.MaxInflationProjections)
+	}
+
+	projections, err := q.k.InflationProjections(ctx, req.NumPeriods)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryInflationProjectionsResponse{Projections: projections}, nil
+}
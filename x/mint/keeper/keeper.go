@@ -104,11 +104,23 @@ func (k *Keeper) MintFn(ctx context.Context, minter *types.Minter, epochId strin
 
 // DefaultMintFn returns a default mint function. It requires the Staking module and the mint keeper.
 // The default Mintfn has a requirement on staking as it uses bond to calculate inflation.
+// When an inflation curve is set in the params, it is used in place of the inflation calculation
+// function and evaluated either every block or at the start of its epochs.
 func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper, k *Keeper) types.MintFn {
 	return func(ctx context.Context, env appmodule.Environment, minter *types.Minter, epochId string, epochNumber int64) error {
-		// the default mint function is called every block, so we only check if epochId is "block" which is
-		// a special value to indicate that this is not an epoch minting, but a regular block minting.
-		if epochId != "block" {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		curve := params.InflationCurve
+		if curve == nil {
+			// without a curve the default mint function is called every block, so we only check if epochId is "block"
+			// which is a special value to indicate that this is not an epoch minting, but a regular block minting.
+			if epochId != "block" {
+				return nil
+			}
+		} else if !curve.IsEvaluatedAt(epochId) {
 			return nil
 		}
 
@@ -122,15 +134,25 @@ func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper,
 			return err
 		}
 
-		params, err := k.Params.Get(ctx)
-		if err != nil {
-			return err
-		}
+		var mintedCoin sdk.Coin
+		if curve == nil {
+			minter.Inflation = ic(ctx, *minter, params, bondedRatio)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
+			mintedCoin = minter.BlockProvision(params)
+		} else {
+			// the curve periods are the block heights or the epoch numbers
+			period := epochNumber
+			if epochId == "block" {
+				period = env.HeaderService.HeaderInfo(ctx).Height
+			}
 
-		minter.Inflation = ic(ctx, *minter, params, bondedRatio)
-		minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
+			provision, err := curve.Evaluate(minter, params, period, stakingTokenSupply, bondedRatio)
+			if err != nil {
+				return err
+			}
+			mintedCoin = sdk.NewCoin(params.MintDenom, provision)
+		}
 
-		mintedCoin := minter.BlockProvision(params)
 		mintedCoins := sdk.NewCoins(mintedCoin)
 		maxSupply := params.MaxSupply
 		totalSupply := stakingTokenSupply
@@ -185,3 +207,59 @@ func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper,
 		)
 	}
 }
+
+// InflationProjections returns the inflation and provisions of the next numPeriods
+// periods of the inflation curve. The bonded ratio is assumed constant and the supply
+// grows with the provisions, bounded by the max supply.
+func (k *Keeper) InflationProjections(ctx context.Context, numPeriods uint32) ([]types.InflationProjection, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.InflationCurve == nil {
+		return nil, types.ErrNoInflationCurve
+	}
+	curve := *params.InflationCurve
+
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := minter.CurveState()
+	period := state.Period + 1
+	if state.Period == 0 {
+		if curve.EpochIdentifier != "" {
+			return nil, types.ErrInflationCurveNotEvaluated
+		}
+		period = k.HeaderService.HeaderInfo(ctx).Height + 1
+	}
+
+	// the bonded ratio keeping the last error of the curve
+	bondedRatio := params.GoalBonded
+	if !state.LastError.IsNil() {
+		bondedRatio = params.GoalBonded.Sub(state.LastError)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	projections := make([]types.InflationProjection, 0, numPeriods)
+	for i := int64(0); i < int64(numPeriods); i++ {
+		provision, err := curve.Evaluate(&minter, params, period+i, supply, bondedRatio)
+		if err != nil {
+			return nil, err
+		}
+
+		if !params.MaxSupply.IsZero() {
+			provision = math.MinInt(provision, math.MaxInt(params.MaxSupply.Sub(supply), math.ZeroInt()))
+		}
+		supply = supply.Add(provision)
+
+		projections = append(projections, types.InflationProjection{
+			Period:    period + i,
+			Inflation: minter.Inflation,
+			Provision: sdk.NewCoin(params.MintDenom, provision),
+		})
+	}
+
+	return projections, nil
+}
@@ -150,6 +150,62 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.Equal(newMinter, unchangedMinter)
 }
 
+func (s *KeeperTestSuite) TestInflationCurve() {
+	supply := math.NewInt(1_000_000)
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(supply, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(50, 2), nil).AnyTimes()
+	s.NoError(s.mintKeeper.SetMintFn(keeper.DefaultMintFn(types.DefaultInflationCalculationFn, s.stakingKeeper, s.mintKeeper)))
+
+	// no projections without a curve
+	_, err := s.mintKeeper.InflationProjections(s.ctx, 1)
+	s.ErrorIs(err, types.ErrNoInflationCurve)
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	params.MaxSupply = supply.AddRaw(1500)
+	params.InflationCurve = &types.InflationCurve{
+		EpochIdentifier: "day",
+		EpochsPerYear:   365,
+		Curve:           &types.InflationCurve_Halving{Halving: &types.HalvingCurve{InitialProvision: math.NewInt(1000), HalvingInterval: 2}},
+	}
+	s.NoError(params.Validate())
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	_, err = s.mintKeeper.InflationProjections(s.ctx, 1)
+	s.ErrorIs(err, types.ErrInflationCurveNotEvaluated)
+
+	// the curve is not evaluated every block nor at other epochs
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "block", -1))
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "week", 1))
+	s.Empty(minter.Data)
+
+	// epoch 3 is after one halving
+	minted := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(500)))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, minted).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 3))
+	s.Equal(math.LegacyNewDec(500*365), minter.AnnualProvisions)
+	s.NoError(s.mintKeeper.Minter.Set(s.ctx, minter))
+
+	// the projections start at the next epoch and are bounded by the max supply
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, supply.AddRaw(500)))
+	projections, err := s.mintKeeper.InflationProjections(s.ctx, 4)
+	s.NoError(err)
+	s.Len(projections, 4)
+	for i, exp := range []int64{250, 250, 125, 125} {
+		s.Equal(int64(4+i), projections[i].Period)
+		s.Equal(math.NewInt(exp), projections[i].Provision.Amount)
+	}
+
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, supply.AddRaw(1400)))
+	projections, err = s.mintKeeper.InflationProjections(s.ctx, 2)
+	s.NoError(err)
+	s.Equal(math.NewInt(100), projections[0].Provision.Amount)
+	s.True(projections[1].Provision.IsZero())
+}
+
 func (s *KeeperTestSuite) TestMigrator() {
 	m := keeper.NewMigrator(s.mintKeeper)
 	s.NoError(m.Migrate1to2(s.ctx)) // just to get the coverage up
@@ -12,6 +12,12 @@ import (
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/mint/Params")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/mint/MsgUpdateParams")
+
+	registrar.RegisterInterface((*isInflationCurve_Curve)(nil), nil)
+	registrar.RegisterConcrete(&InflationCurve_Halving{}, "cosmos-sdk/InflationCurve/Halving")
+	registrar.RegisterConcrete(&InflationCurve_Pid{}, "cosmos-sdk/InflationCurve/PID")
+	registrar.RegisterConcrete(&InflationCurve_LinearDecay{}, "cosmos-sdk/InflationCurve/LinearDecay")
+	registrar.RegisterConcrete(&InflationCurve_Piecewise{}, "cosmos-sdk/InflationCurve/Piecewise")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
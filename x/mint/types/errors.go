@@ -2,4 +2,8 @@ package types
 
 import "cosmossdk.io/errors"
 
-var ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+var (
+	ErrInvalidSigner              = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrNoInflationCurve           = errors.Register(ModuleName, 2, "no inflation curve configured")
+	ErrInflationCurveNotEvaluated = errors.Register(ModuleName, 3, "inflation curve not evaluated yet")
+)
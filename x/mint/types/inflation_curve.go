@@ -0,0 +1,232 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// MaxInflationProjections is the maximum number of periods returned by the
+// InflationProjections query.
+const MaxInflationProjections = 1000
+
+// blockEpochID is the epoch id the mint function is called with at every block.
+const blockEpochID = "block"
+
+// IsEvaluatedAt returns true if the curve is evaluated when the mint function is
+// called with the given epoch id, "block" being every block.
+func (c InflationCurve) IsEvaluatedAt(epochID string) bool {
+	if c.EpochIdentifier == "" {
+		return epochID == blockEpochID
+	}
+
+	return epochID == c.EpochIdentifier
+}
+
+// PeriodsPerYear returns the expected number of curve evaluations per year.
+func (c InflationCurve) PeriodsPerYear(params Params) uint64 {
+	if c.EpochIdentifier == "" {
+		return params.BlocksPerYear
+	}
+
+	return c.EpochsPerYear
+}
+
+// Validate does the sanity check on the inflation curve.
+func (c InflationCurve) Validate() error {
+	if c.EpochIdentifier != "" && c.EpochsPerYear == 0 {
+		return fmt.Errorf("epochs per year must be positive for an epoch inflation curve: %d", c.EpochsPerYear)
+	}
+
+	switch curve := c.Curve.(type) {
+	case *InflationCurve_Halving:
+		if curve.Halving.InitialProvision.IsNil() || curve.Halving.InitialProvision.IsNegative() {
+			return fmt.Errorf("halving initial provision cannot be negative: %s", curve.Halving.InitialProvision)
+		}
+		if curve.Halving.HalvingInterval == 0 {
+			return errors.New("halving interval must be positive")
+		}
+	case *InflationCurve_Pid:
+		for _, gain := range []math.LegacyDec{curve.Pid.Kp, curve.Pid.Ki, curve.Pid.Kd} {
+			if gain.IsNil() || gain.IsNegative() {
+				return fmt.Errorf("pid gains cannot be negative: %s", gain)
+			}
+		}
+	case *InflationCurve_LinearDecay:
+		if err := validateCurveInflation(curve.LinearDecay.InitialInflation); err != nil {
+			return err
+		}
+		if err := validateCurveInflation(curve.LinearDecay.FinalInflation); err != nil {
+			return err
+		}
+		if curve.LinearDecay.EndPeriod <= curve.LinearDecay.StartPeriod {
+			return fmt.Errorf("linear decay end period %d must be greater than its start period %d",
+				curve.LinearDecay.EndPeriod, curve.LinearDecay.StartPeriod)
+		}
+	case *InflationCurve_Piecewise:
+		if len(curve.Piecewise.Segments) == 0 {
+			return errors.New("piecewise curve must have at least one segment")
+		}
+		for i, segment := range curve.Piecewise.Segments {
+			if i > 0 && segment.StartPeriod <= curve.Piecewise.Segments[i-1].StartPeriod {
+				return fmt.Errorf("piecewise segments must have strictly increasing start periods: %d", segment.StartPeriod)
+			}
+			if err := validateCurveInflation(segment.Inflation); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.New("inflation curve must be set")
+	}
+
+	return nil
+}
+
+func validateCurveInflation(v math.LegacyDec) error {
+	if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("curve inflation must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+// Evaluate evaluates the curve at period given the current supply and bonded ratio.
+// It updates the inflation, the annual provisions and the curve state of the minter,
+// and returns the amount to mint for the period.
+func (c InflationCurve) Evaluate(minter *Minter, params Params, period int64, supply math.Int, bondedRatio math.LegacyDec) (math.Int, error) {
+	state := minter.CurveState()
+	periodsPerYear := math.LegacyNewDec(int64(c.PeriodsPerYear(params)))
+	bondedError := params.GoalBonded.Sub(bondedRatio)
+
+	var provision math.Int
+	switch curve := c.Curve.(type) {
+	case *InflationCurve_Halving:
+		provision = curve.Halving.provision(period)
+		minter.AnnualProvisions = math.LegacyNewDecFromInt(provision).Mul(periodsPerYear)
+		minter.Inflation = math.LegacyZeroDec()
+		if supply.IsPositive() {
+			minter.Inflation = minter.AnnualProvisions.QuoInt(supply)
+		}
+	case *InflationCurve_Pid:
+		minter.Inflation = curve.Pid.nextInflation(&state, params, bondedError, periodsPerYear, minter.Inflation)
+	case *InflationCurve_LinearDecay:
+		minter.Inflation = curve.LinearDecay.inflation(period)
+	case *InflationCurve_Piecewise:
+		minter.Inflation = curve.Piecewise.inflation(period)
+	default:
+		return math.Int{}, errors.New("inflation curve must be set")
+	}
+
+	if _, ok := c.Curve.(*InflationCurve_Halving); !ok {
+		minter.AnnualProvisions = minter.Inflation.MulInt(supply)
+		provision = minter.AnnualProvisions.Quo(periodsPerYear).TruncateInt()
+	}
+
+	// the integral follows the inflation of the other curves so that switching to the
+	// PID curve continues from the current inflation.
+	if _, ok := c.Curve.(*InflationCurve_Pid); !ok {
+		state.Integral = minter.Inflation
+		state.LastError = bondedError
+	}
+	state.Period = period
+
+	data, err := state.Marshal()
+	if err != nil {
+		return math.Int{}, err
+	}
+	minter.Data = data
+
+	return provision, nil
+}
+
+// CurveState returns the inflation curve state stored in the minter data. An empty
+// state is returned if the curve has not been evaluated yet.
+func (m Minter) CurveState() InflationCurveState {
+	var state InflationCurveState
+	if len(m.Data) == 0 {
+		return state
+	}
+
+	// data not written by the inflation curve is discarded.
+	if err := state.Unmarshal(m.Data); err != nil {
+		return InflationCurveState{}
+	}
+
+	return state
+}
+
+// provision returns the amount minted at period, the initial provision halved once
+// per elapsed halving interval.
+func (c HalvingCurve) provision(period int64) math.Int {
+	halvings := uint64(0)
+	if period > 0 && uint64(period) > c.StartPeriod {
+		halvings = (uint64(period) - c.StartPeriod) / c.HalvingInterval
+	}
+	if halvings >= 256 {
+		return math.ZeroInt()
+	}
+
+	divisor := new(big.Int).Lsh(big.NewInt(1), uint(halvings))
+	return c.InitialProvision.Quo(math.NewIntFromBigInt(divisor))
+}
+
+// nextInflation returns the inflation of the next period. The integral term starts
+// from the current inflation and is bounded by the min and max inflation.
+func (c PIDCurve) nextInflation(state *InflationCurveState, params Params, bondedError, periodsPerYear, inflation math.LegacyDec) math.LegacyDec {
+	if state.Integral.IsNil() {
+		state.Integral = inflation
+	}
+	if state.LastError.IsNil() {
+		state.LastError = bondedError
+	}
+
+	integral := clampInflation(state.Integral.Add(c.Ki.Mul(bondedError).Quo(periodsPerYear)), params)
+	derivative := bondedError.Sub(state.LastError)
+
+	state.Integral = integral
+	state.LastError = bondedError
+
+	return clampInflation(integral.Add(c.Kp.Mul(bondedError)).Add(c.Kd.Mul(derivative)), params)
+}
+
+func clampInflation(inflation math.LegacyDec, params Params) math.LegacyDec {
+	if inflation.GT(params.InflationMax) {
+		return params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		return params.InflationMin
+	}
+
+	return inflation
+}
+
+// inflation returns the inflation at period, linearly interpolated between the
+// start and end periods.
+func (c LinearDecayCurve) inflation(period int64) math.LegacyDec {
+	switch {
+	case period <= 0 || uint64(period) <= c.StartPeriod:
+		return c.InitialInflation
+	case uint64(period) >= c.EndPeriod:
+		return c.FinalInflation
+	}
+
+	elapsed := math.LegacyNewDec(int64(uint64(period) - c.StartPeriod))
+	duration := math.LegacyNewDec(int64(c.EndPeriod - c.StartPeriod))
+	return c.InitialInflation.Add(c.FinalInflation.Sub(c.InitialInflation).Mul(elapsed).Quo(duration))
+}
+
+// inflation returns the inflation of the last segment starting at or before period,
+// zero before the first segment.
+func (c PiecewiseCurve) inflation(period int64) math.LegacyDec {
+	inflation := math.LegacyZeroDec()
+	for _, segment := range c.Segments {
+		if period < 0 || segment.StartPeriod > uint64(period) {
+			break
+		}
+		inflation = segment.Inflation
+	}
+
+	return inflation
+}
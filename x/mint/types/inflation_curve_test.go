@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestInflationCurveValidate(t *testing.T) {
+	dec := math.LegacyNewDecWithPrec
+
+	tests := []struct {
+		name   string
+		curve  InflationCurve
+		expErr bool
+	}{
+		{"no curve", InflationCurve{}, true},
+		{"halving", InflationCurve{Curve: &InflationCurve_Halving{&HalvingCurve{InitialProvision: math.NewInt(100), HalvingInterval: 10}}}, false},
+		{"halving zero interval", InflationCurve{Curve: &InflationCurve_Halving{&HalvingCurve{InitialProvision: math.NewInt(100)}}}, true},
+		{"epoch without epochs per year", InflationCurve{EpochIdentifier: "day", Curve: &InflationCurve_Halving{&HalvingCurve{InitialProvision: math.NewInt(100), HalvingInterval: 10}}}, true},
+		{"pid", InflationCurve{Curve: &InflationCurve_Pid{&PIDCurve{Kp: dec(1, 1), Ki: dec(2, 1), Kd: math.LegacyZeroDec()}}}, false},
+		{"pid negative gain", InflationCurve{Curve: &InflationCurve_Pid{&PIDCurve{Kp: dec(-1, 1), Ki: dec(2, 1), Kd: math.LegacyZeroDec()}}}, true},
+		{"linear decay", InflationCurve{Curve: &InflationCurve_LinearDecay{&LinearDecayCurve{InitialInflation: dec(1, 1), FinalInflation: dec(2, 2), StartPeriod: 1, EndPeriod: 10}}}, false},
+		{"linear decay empty range", InflationCurve{Curve: &InflationCurve_LinearDecay{&LinearDecayCurve{InitialInflation: dec(1, 1), FinalInflation: dec(2, 2), StartPeriod: 10, EndPeriod: 10}}}, true},
+		{"piecewise", InflationCurve{Curve: &InflationCurve_Piecewise{&PiecewiseCurve{Segments: []PiecewiseCurveSegment{{0, dec(1, 1)}, {10, dec(5, 2)}}}}}, false},
+		{"piecewise unsorted", InflationCurve{Curve: &InflationCurve_Piecewise{&PiecewiseCurve{Segments: []PiecewiseCurveSegment{{10, dec(1, 1)}, {10, dec(5, 2)}}}}}, true},
+		{"piecewise inflation too large", InflationCurve{Curve: &InflationCurve_Piecewise{&PiecewiseCurve{Segments: []PiecewiseCurveSegment{{0, math.LegacyNewDec(2)}}}}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.InflationCurve = &tc.curve
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInflationCurveEvaluate(t *testing.T) {
+	dec := math.LegacyNewDecWithPrec
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	supply := math.NewInt(1_000_000)
+
+	// halving: the provision is halved every interval from the start period
+	curve := InflationCurve{Curve: &InflationCurve_Halving{&HalvingCurve{InitialProvision: math.NewInt(1000), StartPeriod: 10, HalvingInterval: 5}}}
+	for period, exp := range map[int64]int64{1: 1000, 14: 1000, 15: 500, 20: 250, 25: 125} {
+		minter := DefaultInitialMinter()
+		provision, err := curve.Evaluate(&minter, params, period, supply, params.GoalBonded)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(exp), provision)
+		require.Equal(t, math.LegacyNewDec(exp*100), minter.AnnualProvisions)
+	}
+
+	// linear decay: the inflation is interpolated between the start and end periods
+	curve = InflationCurve{Curve: &InflationCurve_LinearDecay{&LinearDecayCurve{InitialInflation: dec(10, 2), FinalInflation: dec(2, 2), StartPeriod: 100, EndPeriod: 200}}}
+	for period, exp := range map[int64]math.LegacyDec{50: dec(10, 2), 150: dec(6, 2), 250: dec(2, 2)} {
+		minter := DefaultInitialMinter()
+		provision, err := curve.Evaluate(&minter, params, period, supply, params.GoalBonded)
+		require.NoError(t, err)
+		require.Equal(t, exp, minter.Inflation)
+		require.Equal(t, exp.MulInt(supply).QuoInt64(100).TruncateInt(), provision)
+	}
+
+	// piecewise: the inflation of the last segment started
+	curve = InflationCurve{Curve: &InflationCurve_Piecewise{&PiecewiseCurve{Segments: []PiecewiseCurveSegment{{10, dec(8, 2)}, {20, dec(4, 2)}}}}}
+	for period, exp := range map[int64]math.LegacyDec{5: math.LegacyZeroDec(), 10: dec(8, 2), 19: dec(8, 2), 30: dec(4, 2)} {
+		minter := DefaultInitialMinter()
+		_, err := curve.Evaluate(&minter, params, period, supply, params.GoalBonded)
+		require.NoError(t, err)
+		require.Equal(t, exp, minter.Inflation)
+	}
+}
+
+func TestInflationCurvePID(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 10
+	params.InflationMin = math.LegacyNewDecWithPrec(2, 2)
+	params.InflationMax = math.LegacyNewDecWithPrec(20, 2)
+	supply := math.NewInt(1_000_000)
+
+	// the integral gain matching the default inflation calculation
+	curve := InflationCurve{Curve: &InflationCurve_Pid{&PIDCurve{
+		Kp: math.LegacyZeroDec(),
+		Ki: params.InflationRateChange.Quo(params.GoalBonded),
+		Kd: math.LegacyZeroDec(),
+	}}}
+
+	minter := InitialMinter(math.LegacyNewDecWithPrec(10, 2))
+	expMinter := minter
+	bondedRatio := math.LegacyNewDecWithPrec(50, 2)
+	for period := int64(1); period <= 5; period++ {
+		_, err := curve.Evaluate(&minter, params, period, supply, bondedRatio)
+		require.NoError(t, err)
+		expMinter.Inflation = expMinter.NextInflationRate(params, bondedRatio)
+		require.True(t, expMinter.Inflation.Sub(minter.Inflation).Abs().LTE(math.LegacyNewDecWithPrec(1, 15)))
+	}
+
+	state := minter.CurveState()
+	require.Equal(t, int64(5), state.Period)
+	require.Equal(t, params.GoalBonded.Sub(bondedRatio), state.LastError)
+
+	// the inflation is bounded by the max inflation
+	for period := int64(6); period <= 100; period++ {
+		_, err := curve.Evaluate(&minter, params, period, supply, math.LegacyZeroDec())
+		require.NoError(t, err)
+	}
+	require.Equal(t, params.InflationMax, minter.Inflation)
+}
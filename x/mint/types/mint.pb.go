@@ -93,6 +93,9 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// inflation_curve is the on-chain configured inflation curve used by the default mint
+	// function in place of its inflation calculation function, unused if not set.
+	InflationCurve *InflationCurve `protobuf:"bytes,8,opt,name=inflation_curve,json=inflationCurve,proto3" json:"inflation_curve,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -142,45 +145,523 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationCurve() *InflationCurve {
+	if m != nil {
+		return m.InflationCurve
+	}
+	return nil
+}
+
+// InflationCurve defines a built-in inflation curve and when it is evaluated.
+type InflationCurve struct {
+	// epoch_identifier is the x/epochs identifier of the epochs at the start of which the
+	// curve is evaluated, it is evaluated every block if empty.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epochs_per_year is the expected number of epochs per year, used in place of
+	// blocks_per_year when the curve is evaluated per epoch.
+	EpochsPerYear uint64 `protobuf:"varint,2,opt,name=epochs_per_year,json=epochsPerYear,proto3" json:"epochs_per_year,omitempty"`
+	// curve is the curve implementation and its parameters.
+	//
+	// Types that are valid to be assigned to Curve:
+	//	*InflationCurve_Halving
+	//	*InflationCurve_Pid
+	//	*InflationCurve_LinearDecay
+	//	*InflationCurve_Piecewise
+	Curve isInflationCurve_Curve `protobuf_oneof:"curve"`
+}
+
+func (m *InflationCurve) Reset()         { *m = InflationCurve{} }
+func (m *InflationCurve) String() string { return proto.CompactTextString(m) }
+func (*InflationCurve) ProtoMessage()    {}
+func (*InflationCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationCurve.Merge(m, src)
+}
+func (m *InflationCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationCurve proto.InternalMessageInfo
+
+type isInflationCurve_Curve interface {
+	isInflationCurve_Curve()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type InflationCurve_Halving struct {
+	Halving *HalvingCurve `protobuf:"bytes,3,opt,name=halving,proto3,oneof" json:"halving,omitempty"`
+}
+type InflationCurve_Pid struct {
+	Pid *PIDCurve `protobuf:"bytes,4,opt,name=pid,proto3,oneof" json:"pid,omitempty"`
+}
+type InflationCurve_LinearDecay struct {
+	LinearDecay *LinearDecayCurve `protobuf:"bytes,5,opt,name=linear_decay,json=linearDecay,proto3,oneof" json:"linear_decay,omitempty"`
+}
+type InflationCurve_Piecewise struct {
+	Piecewise *PiecewiseCurve `protobuf:"bytes,6,opt,name=piecewise,proto3,oneof" json:"piecewise,omitempty"`
+}
+
+func (*InflationCurve_Halving) isInflationCurve_Curve()     {}
+func (*InflationCurve_Pid) isInflationCurve_Curve()         {}
+func (*InflationCurve_LinearDecay) isInflationCurve_Curve() {}
+func (*InflationCurve_Piecewise) isInflationCurve_Curve()   {}
+
+func (m *InflationCurve) GetCurve() isInflationCurve_Curve {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+func (m *InflationCurve) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *InflationCurve) GetEpochsPerYear() uint64 {
+	if m != nil {
+		return m.EpochsPerYear
+	}
+	return 0
+}
+
+func (m *InflationCurve) GetHalving() *HalvingCurve {
+	if x, ok := m.GetCurve().(*InflationCurve_Halving); ok {
+		return x.Halving
+	}
+	return nil
+}
+
+func (m *InflationCurve) GetPid() *PIDCurve {
+	if x, ok := m.GetCurve().(*InflationCurve_Pid); ok {
+		return x.Pid
+	}
+	return nil
+}
+
+func (m *InflationCurve) GetLinearDecay() *LinearDecayCurve {
+	if x, ok := m.GetCurve().(*InflationCurve_LinearDecay); ok {
+		return x.LinearDecay
+	}
+	return nil
+}
+
+func (m *InflationCurve) GetPiecewise() *PiecewiseCurve {
+	if x, ok := m.GetCurve().(*InflationCurve_Piecewise); ok {
+		return x.Piecewise
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InflationCurve) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InflationCurve_Halving)(nil),
+		(*InflationCurve_Pid)(nil),
+		(*InflationCurve_LinearDecay)(nil),
+		(*InflationCurve_Piecewise)(nil),
+	}
+}
+
+// HalvingCurve mints a fixed provision per period, halved every halving_interval
+// periods, bounding the total supply minted.
+type HalvingCurve struct {
+	// initial_provision is the amount minted per period before the first halving.
+	InitialProvision cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3,customtype=cosmossdk.io/math.Int" json:"initial_provision"`
+	// start_period is the period from which the halving intervals are counted.
+	StartPeriod uint64 `protobuf:"varint,2,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	// halving_interval is the number of periods between two halvings.
+	HalvingInterval uint64 `protobuf:"varint,3,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+}
+
+func (m *HalvingCurve) Reset()         { *m = HalvingCurve{} }
+func (m *HalvingCurve) String() string { return proto.CompactTextString(m) }
+func (*HalvingCurve) ProtoMessage()    {}
+func (*HalvingCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *HalvingCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingCurve.Merge(m, src)
+}
+func (m *HalvingCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingCurve proto.InternalMessageInfo
+
+func (m *HalvingCurve) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+func (m *HalvingCurve) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+// PIDCurve adjusts the inflation towards the goal_bonded ratio with a
+// proportional-integral-derivative controller, bounded by inflation_min and
+// inflation_max. The error is goal_bonded minus the bonded ratio.
+type PIDCurve struct {
+	// kp is the proportional gain.
+	Kp cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=kp,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"kp"`
+	// ki is the integral gain, per year.
+	Ki cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ki,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ki"`
+	// kd is the derivative gain.
+	Kd cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=kd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"kd"`
+}
+
+func (m *PIDCurve) Reset()         { *m = PIDCurve{} }
+func (m *PIDCurve) String() string { return proto.CompactTextString(m) }
+func (*PIDCurve) ProtoMessage()    {}
+func (*PIDCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *PIDCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PIDCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PIDCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PIDCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PIDCurve.Merge(m, src)
+}
+func (m *PIDCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PIDCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PIDCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PIDCurve proto.InternalMessageInfo
+
+// LinearDecayCurve decreases the inflation linearly from initial_inflation at
+// start_period to final_inflation at end_period.
+type LinearDecayCurve struct {
+	InitialInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_inflation,json=initialInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_inflation"`
+	FinalInflation   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=final_inflation,json=finalInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"final_inflation"`
+	StartPeriod      uint64                      `protobuf:"varint,3,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	EndPeriod        uint64                      `protobuf:"varint,4,opt,name=end_period,json=endPeriod,proto3" json:"end_period,omitempty"`
+}
+
+func (m *LinearDecayCurve) Reset()         { *m = LinearDecayCurve{} }
+func (m *LinearDecayCurve) String() string { return proto.CompactTextString(m) }
+func (*LinearDecayCurve) ProtoMessage()    {}
+func (*LinearDecayCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{5}
+}
+func (m *LinearDecayCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinearDecayCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinearDecayCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinearDecayCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearDecayCurve.Merge(m, src)
+}
+func (m *LinearDecayCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinearDecayCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearDecayCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinearDecayCurve proto.InternalMessageInfo
+
+func (m *LinearDecayCurve) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+func (m *LinearDecayCurve) GetEndPeriod() uint64 {
+	if m != nil {
+		return m.EndPeriod
+	}
+	return 0
+}
+
+// PiecewiseCurve defines the inflation with a table of segments, the inflation of
+// a period is the one of the last segment starting at or before it.
+type PiecewiseCurve struct {
+	// segments are sorted by strictly increasing start period.
+	Segments []PiecewiseCurveSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments"`
+}
+
+func (m *PiecewiseCurve) Reset()         { *m = PiecewiseCurve{} }
+func (m *PiecewiseCurve) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseCurve) ProtoMessage()    {}
+func (*PiecewiseCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{6}
+}
+func (m *PiecewiseCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseCurve.Merge(m, src)
+}
+func (m *PiecewiseCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseCurve proto.InternalMessageInfo
+
+func (m *PiecewiseCurve) GetSegments() []PiecewiseCurveSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+// PiecewiseCurveSegment defines the inflation from a start period.
+type PiecewiseCurveSegment struct {
+	StartPeriod uint64                      `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3" json:"start_period,omitempty"`
+	Inflation   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *PiecewiseCurveSegment) Reset()         { *m = PiecewiseCurveSegment{} }
+func (m *PiecewiseCurveSegment) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseCurveSegment) ProtoMessage()    {}
+func (*PiecewiseCurveSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{7}
+}
+func (m *PiecewiseCurveSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseCurveSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseCurveSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseCurveSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseCurveSegment.Merge(m, src)
+}
+func (m *PiecewiseCurveSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseCurveSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseCurveSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseCurveSegment proto.InternalMessageInfo
+
+func (m *PiecewiseCurveSegment) GetStartPeriod() uint64 {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return 0
+}
+
+// InflationCurveState is the state of the inflation curve stored in the minter data.
+type InflationCurveState struct {
+	// period is the last period the curve was evaluated at.
+	Period int64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// integral is the integral term of the PID controller.
+	Integral cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=integral,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral"`
+	// last_error is the last difference between goal_bonded and the bonded ratio.
+	LastError cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_error"`
+}
+
+func (m *InflationCurveState) Reset()         { *m = InflationCurveState{} }
+func (m *InflationCurveState) String() string { return proto.CompactTextString(m) }
+func (*InflationCurveState) ProtoMessage()    {}
+func (*InflationCurveState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{8}
+}
+func (m *InflationCurveState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationCurveState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationCurveState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationCurveState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationCurveState.Merge(m, src)
+}
+func (m *InflationCurveState) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationCurveState) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationCurveState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationCurveState proto.InternalMessageInfo
+
+func (m *InflationCurveState) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationCurve)(nil), "cosmos.mint.v1beta1.InflationCurve")
+	proto.RegisterType((*HalvingCurve)(nil), "cosmos.mint.v1beta1.HalvingCurve")
+	proto.RegisterType((*PIDCurve)(nil), "cosmos.mint.v1beta1.PIDCurve")
+	proto.RegisterType((*LinearDecayCurve)(nil), "cosmos.mint.v1beta1.LinearDecayCurve")
+	proto.RegisterType((*PiecewiseCurve)(nil), "cosmos.mint.v1beta1.PiecewiseCurve")
+	proto.RegisterType((*PiecewiseCurveSegment)(nil), "cosmos.mint.v1beta1.PiecewiseCurveSegment")
+	proto.RegisterType((*InflationCurveState)(nil), "cosmos.mint.v1beta1.InflationCurveState")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x6b, 0x28, 0x45, 0x35, 0x9b, 0x60, 0x1e, 0x93, 0xb2, 0xa1, 0x65, 0xd5, 0x0e, 0xa8,
-	0x1a, 0x5a, 0xa3, 0x6a, 0x12, 0x07, 0x8e, 0xa5, 0x97, 0x21, 0x26, 0xaa, 0x70, 0x40, 0x80, 0x44,
-	0xf4, 0x36, 0x31, 0x99, 0x69, 0x6c, 0x47, 0xb6, 0x37, 0xa5, 0x5f, 0x81, 0x13, 0x1f, 0x83, 0xe3,
-	0x0e, 0x5c, 0xf8, 0x06, 0xbb, 0x20, 0x4d, 0x9c, 0x10, 0x87, 0x09, 0xb5, 0x87, 0x7d, 0x0d, 0x14,
-	0x3b, 0xca, 0xf8, 0x73, 0xa2, 0xec, 0x12, 0x39, 0xcf, 0xe3, 0xf7, 0xf7, 0x3c, 0x89, 0x6c, 0xec,
-	0xc7, 0x52, 0x73, 0xa9, 0x03, 0xce, 0x84, 0x09, 0x8e, 0xfb, 0x63, 0x6a, 0xa0, 0x6f, 0x5f, 0x7a,
-	0xb9, 0x92, 0x46, 0x92, 0x55, 0xe7, 0xf7, 0xac, 0x54, 0xf9, 0x1b, 0x77, 0x53, 0x99, 0x4a, 0xeb,
-	0x07, 0xe5, 0xca, 0x6d, 0xdd, 0x58, 0x77, 0x5b, 0x23, 0x67, 0x54, 0x73, 0xce, 0x5a, 0x01, 0xce,
-	0x84, 0x0c, 0xec, 0xd3, 0x49, 0xdb, 0x5f, 0x10, 0x6e, 0x1d, 0x30, 0x61, 0xa8, 0x22, 0xcf, 0x70,
-	0x9b, 0x89, 0xb7, 0x19, 0x18, 0x26, 0x85, 0x87, 0x3a, 0xa8, 0xdb, 0x1e, 0xf4, 0x4f, 0xcf, 0xb7,
-	0x1a, 0xdf, 0xcf, 0xb7, 0xee, 0x39, 0x8c, 0x4e, 0x26, 0x3d, 0x26, 0x03, 0x0e, 0xe6, 0xb0, 0xf7,
-	0x94, 0xa6, 0x10, 0x4f, 0x87, 0x34, 0xfe, 0xfa, 0x69, 0x17, 0x57, 0x29, 0x43, 0x1a, 0x87, 0x97,
-	0x0c, 0xf2, 0x06, 0xaf, 0x80, 0x10, 0x47, 0x90, 0x95, 0x5d, 0x8e, 0x99, 0x66, 0x52, 0x68, 0xef,
-	0xda, 0xa2, 0xe0, 0x3b, 0x8e, 0x35, 0xaa, 0x51, 0x84, 0xe0, 0x66, 0x02, 0x06, 0xbc, 0xeb, 0x1d,
-	0xd4, 0x5d, 0x0a, 0xed, 0x7a, 0xfb, 0x73, 0x13, 0xb7, 0x46, 0xa0, 0x80, 0x6b, 0xb2, 0x89, 0x71,
-	0xf9, 0xbb, 0xa2, 0x84, 0x0a, 0xc9, 0xdd, 0x07, 0x85, 0xed, 0x52, 0x19, 0x96, 0x02, 0x79, 0x87,
-	0xd7, 0xea, 0xaa, 0x91, 0x02, 0x43, 0xa3, 0xf8, 0x10, 0x44, 0x4a, 0xab, 0x86, 0x0f, 0xff, 0xb9,
-	0xe1, 0xc7, 0x8b, 0x93, 0x1d, 0x14, 0xae, 0xd6, 0xd0, 0x10, 0x0c, 0x7d, 0x6c, 0x91, 0xe4, 0x35,
-	0x5e, 0xbe, 0xcc, 0xe2, 0x50, 0xd8, 0xca, 0x8b, 0x67, 0x2c, 0xd5, 0xb0, 0x03, 0x28, 0xfe, 0x80,
-	0x33, 0xe1, 0x35, 0xaf, 0x0a, 0xce, 0x04, 0x79, 0x81, 0x6f, 0xa5, 0x12, 0xb2, 0x68, 0x2c, 0x45,
-	0x42, 0x13, 0xef, 0xc6, 0x7f, 0xa1, 0x71, 0x89, 0x1a, 0x58, 0x12, 0xb9, 0x8f, 0x6f, 0x8f, 0x33,
-	0x19, 0x4f, 0x74, 0x94, 0x53, 0x15, 0x4d, 0x29, 0x28, 0xaf, 0xd5, 0x41, 0xdd, 0x66, 0xb8, 0xec,
-	0xe4, 0x11, 0x55, 0x2f, 0x29, 0x28, 0xf2, 0x04, 0x63, 0x0e, 0x45, 0xa4, 0x8f, 0xf2, 0x3c, 0x9b,
-	0x7a, 0x37, 0x6d, 0xfe, 0x83, 0x2a, 0x7f, 0xed, 0xef, 0xfc, 0x7d, 0x61, 0x7e, 0x49, 0xde, 0x17,
-	0x26, 0x6c, 0x73, 0x28, 0x9e, 0xdb, 0xe9, 0x47, 0x9b, 0xef, 0x2f, 0x4e, 0x76, 0x3c, 0xe7, 0xed,
-	0xea, 0x64, 0x12, 0x14, 0xee, 0xc2, 0xb9, 0x03, 0x33, 0xd8, 0x3b, 0x9d, 0xf9, 0xe8, 0x6c, 0xe6,
-	0xa3, 0x1f, 0x33, 0x1f, 0x7d, 0x98, 0xfb, 0x8d, 0xb3, 0xb9, 0xdf, 0xf8, 0x36, 0xf7, 0x1b, 0xaf,
-	0xd6, 0x7f, 0x0b, 0xaa, 0xa6, 0xcc, 0x34, 0xa7, 0x7a, 0xdc, 0xb2, 0xf7, 0x68, 0xef, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf6, 0x1e, 0x9c, 0x7d, 0xc2, 0x03, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x24, 0x4d, 0xeb, 0x97, 0x6c, 0xd3, 0x4e, 0x29, 0xf2, 0x2e, 0x6a, 0x36, 0x6b,
+	0x04, 0x0a, 0x45, 0x9b, 0xd0, 0xac, 0xc4, 0x01, 0x89, 0x4b, 0x1a, 0xd0, 0x66, 0xd5, 0x8a, 0xe0,
+	0x22, 0x21, 0x40, 0x60, 0x4d, 0xed, 0x69, 0x3a, 0xc4, 0x9e, 0xb1, 0xec, 0x69, 0x48, 0x3e, 0x01,
+	0x12, 0xa7, 0x3d, 0xf3, 0x09, 0x38, 0xee, 0x81, 0x1b, 0x5f, 0x60, 0x2f, 0xa0, 0x15, 0x27, 0xc4,
+	0x61, 0x85, 0xda, 0xc3, 0x1e, 0xf9, 0x0a, 0xc8, 0x33, 0xb6, 0x93, 0xb4, 0x11, 0xda, 0x4d, 0xf6,
+	0x12, 0xc5, 0xff, 0x79, 0xef, 0xf7, 0x66, 0xde, 0x7b, 0xf3, 0x6c, 0xa8, 0x39, 0x3c, 0xf2, 0x79,
+	0xd4, 0xf2, 0x29, 0x13, 0xad, 0xd1, 0xc1, 0x29, 0x11, 0xf8, 0x40, 0x3e, 0x34, 0x83, 0x90, 0x0b,
+	0x8e, 0x76, 0xd4, 0x7a, 0x53, 0x4a, 0xc9, 0xfa, 0x9d, 0x37, 0x06, 0x7c, 0xc0, 0xe5, 0x7a, 0x2b,
+	0xfe, 0xa7, 0x4c, 0xef, 0xdc, 0x56, 0xa6, 0xb6, 0x5a, 0x48, 0xfc, 0xd4, 0xd2, 0x36, 0xf6, 0x29,
+	0xe3, 0x2d, 0xf9, 0xab, 0x24, 0xf3, 0x77, 0x0d, 0x4a, 0xc7, 0x94, 0x09, 0x12, 0xa2, 0xcf, 0x40,
+	0xa7, 0xec, 0xcc, 0xc3, 0x82, 0x72, 0x66, 0x68, 0x75, 0xad, 0xa1, 0x77, 0x0e, 0x9e, 0x3e, 0xbf,
+	0x9b, 0xfb, 0xfb, 0xf9, 0xdd, 0xb7, 0x14, 0x26, 0x72, 0x87, 0x4d, 0xca, 0x5b, 0x3e, 0x16, 0xe7,
+	0xcd, 0x23, 0x32, 0xc0, 0xce, 0xa4, 0x4b, 0x9c, 0x3f, 0x7f, 0xbd, 0x0f, 0x49, 0x94, 0x2e, 0x71,
+	0xac, 0x29, 0x03, 0x7d, 0x07, 0xdb, 0x98, 0xb1, 0x0b, 0xec, 0xc5, 0x7b, 0x19, 0xd1, 0x88, 0x72,
+	0x16, 0x19, 0xf9, 0x65, 0xc1, 0x5b, 0x8a, 0xd5, 0xcf, 0x50, 0x08, 0x41, 0xd1, 0xc5, 0x02, 0x1b,
+	0x85, 0xba, 0xd6, 0xa8, 0x58, 0xf2, 0xbf, 0xf9, 0xe3, 0x1a, 0x94, 0xfa, 0x38, 0xc4, 0x7e, 0x84,
+	0xf6, 0x00, 0xe2, 0x74, 0xd9, 0x2e, 0x61, 0xdc, 0x57, 0x07, 0xb2, 0xf4, 0x58, 0xe9, 0xc6, 0x02,
+	0xfa, 0x1e, 0x76, 0xb3, 0xad, 0xda, 0x21, 0x16, 0xc4, 0x76, 0xce, 0x31, 0x1b, 0x90, 0x64, 0x87,
+	0x1f, 0xbe, 0xf2, 0x0e, 0x7f, 0x79, 0xf1, 0x64, 0x5f, 0xb3, 0x76, 0x32, 0xa8, 0x85, 0x05, 0x39,
+	0x94, 0x48, 0xf4, 0x0d, 0xdc, 0x9a, 0xc6, 0xf2, 0xf1, 0xd8, 0x28, 0xac, 0x14, 0xa3, 0x92, 0xc1,
+	0x8e, 0xf1, 0xf8, 0x1a, 0x9c, 0x32, 0xa3, 0xf8, 0xba, 0xe0, 0x94, 0xa1, 0x2f, 0xa1, 0x3c, 0xe0,
+	0xd8, 0xb3, 0x4f, 0x39, 0x73, 0x89, 0x6b, 0xac, 0xad, 0x84, 0x86, 0x18, 0xd5, 0x91, 0x24, 0xf4,
+	0x2e, 0x54, 0x4f, 0x3d, 0xee, 0x0c, 0x23, 0x3b, 0x20, 0xa1, 0x3d, 0x21, 0x38, 0x34, 0x4a, 0x75,
+	0xad, 0x51, 0xb4, 0x6e, 0x29, 0xb9, 0x4f, 0xc2, 0xaf, 0x08, 0x0e, 0xd1, 0x23, 0x00, 0x1f, 0x8f,
+	0xed, 0xe8, 0x22, 0x08, 0xbc, 0x89, 0xb1, 0x2e, 0xe3, 0xbf, 0x9f, 0xc4, 0xdf, 0xbd, 0x19, 0xbf,
+	0xc7, 0xc4, 0x4c, 0xe4, 0x1e, 0x13, 0x96, 0xee, 0xe3, 0xf1, 0x89, 0xf4, 0x46, 0x47, 0x50, 0x9d,
+	0x66, 0xca, 0xb9, 0x08, 0x47, 0xc4, 0xd8, 0xa8, 0x6b, 0x8d, 0x72, 0xfb, 0xed, 0xe6, 0x82, 0xfb,
+	0xd5, 0xec, 0xa5, 0xb6, 0x87, 0xb1, 0xa9, 0xb5, 0x49, 0xe7, 0x9e, 0x3f, 0xda, 0xfb, 0xe9, 0xc5,
+	0x93, 0x7d, 0x43, 0x39, 0xde, 0x8f, 0xdc, 0x61, 0x6b, 0xac, 0xae, 0xaf, 0x6a, 0x3f, 0xf3, 0xdf,
+	0x3c, 0x6c, 0xce, 0x13, 0xd0, 0x7b, 0xb0, 0x45, 0x02, 0xee, 0x9c, 0xdb, 0xd4, 0x25, 0x4c, 0xd0,
+	0x33, 0x4a, 0xc2, 0xa4, 0x2f, 0xab, 0x52, 0xef, 0x65, 0x72, 0x9c, 0x1e, 0x29, 0xcd, 0xa4, 0x27,
+	0xaf, 0xd2, 0xa3, 0xe4, 0x34, 0x3d, 0x1f, 0xc3, 0xfa, 0x39, 0xf6, 0x46, 0x94, 0x0d, 0x64, 0x4f,
+	0x95, 0xdb, 0xf7, 0x16, 0x1e, 0xe5, 0xa1, 0xb2, 0x91, 0xdb, 0x78, 0x98, 0xb3, 0x52, 0x1f, 0x74,
+	0x00, 0x85, 0x80, 0xba, 0xb2, 0x63, 0xca, 0xed, 0xbd, 0x85, 0xae, 0xfd, 0x5e, 0x37, 0x75, 0x8b,
+	0x6d, 0xd1, 0x23, 0xa8, 0x78, 0x94, 0x11, 0x1c, 0xda, 0x2e, 0x71, 0xf0, 0x44, 0xb6, 0x44, 0xb9,
+	0xfd, 0xce, 0x42, 0xdf, 0x23, 0x69, 0xd8, 0x8d, 0xed, 0x52, 0x46, 0xd9, 0x9b, 0x6a, 0xe8, 0x10,
+	0xf4, 0x80, 0x12, 0x87, 0xfc, 0x40, 0x23, 0x62, 0x94, 0xfe, 0xa7, 0x14, 0xfd, 0xd4, 0x2a, 0xc5,
+	0x4c, 0xfd, 0x3a, 0xeb, 0xb0, 0x26, 0x6b, 0x69, 0xfe, 0xa6, 0x41, 0x65, 0xf6, 0xa0, 0xe8, 0x5b,
+	0xd8, 0xa6, 0x8c, 0x0a, 0x3a, 0x3b, 0x81, 0x92, 0xc9, 0xf6, 0xc1, 0x2b, 0xb4, 0x90, 0x6a, 0xde,
+	0xad, 0x04, 0x95, 0x0d, 0x20, 0x74, 0x0f, 0x2a, 0x91, 0xc0, 0xa1, 0x88, 0x4b, 0x44, 0xb9, 0x9b,
+	0x14, 0xa8, 0x2c, 0xb5, 0xbe, 0x94, 0xe2, 0x8a, 0x27, 0xa9, 0xb6, 0xe5, 0x90, 0x1d, 0x61, 0x4f,
+	0xd6, 0xa9, 0x68, 0x55, 0x13, 0xbd, 0x97, 0xc8, 0xe6, 0xa5, 0x06, 0x1b, 0x69, 0xae, 0xd1, 0xa7,
+	0x90, 0x1f, 0x06, 0x86, 0xb6, 0xd2, 0x6d, 0xcb, 0x0f, 0x03, 0xc9, 0xa1, 0x2b, 0x4e, 0xb4, 0xfc,
+	0x90, 0x4a, 0x8e, 0xbb, 0xe2, 0xd4, 0xca, 0x0f, 0x5d, 0xf3, 0xe7, 0x3c, 0x6c, 0x5d, 0x6f, 0x0a,
+	0xe4, 0x4c, 0xcb, 0x74, 0xfd, 0x05, 0xb4, 0x6c, 0xac, 0xb4, 0x58, 0xd9, 0x05, 0x44, 0x36, 0x54,
+	0xcf, 0x28, 0x9b, 0x0b, 0xb1, 0x5a, 0x5a, 0x36, 0x25, 0x6e, 0x1a, 0xe0, 0x7a, 0x37, 0x14, 0x6e,
+	0x76, 0xc3, 0x1e, 0x00, 0x61, 0x6e, 0x6a, 0x50, 0x94, 0x06, 0x3a, 0x61, 0xae, 0x5a, 0x36, 0x1d,
+	0xd8, 0x9c, 0xef, 0x73, 0xf4, 0x39, 0x6c, 0x44, 0x64, 0xe0, 0x13, 0x26, 0x22, 0x43, 0xab, 0x17,
+	0x1a, 0xe5, 0xf6, 0xfe, 0x4b, 0x5c, 0x8f, 0x13, 0xe5, 0xd2, 0xd1, 0xe3, 0x93, 0xa9, 0xcd, 0x66,
+	0x18, 0xf3, 0xb1, 0x06, 0xbb, 0x0b, 0xcd, 0x6f, 0x1c, 0x40, 0xbb, 0x79, 0x80, 0x2f, 0x40, 0x7f,
+	0x5d, 0xe9, 0x9b, 0x82, 0xcc, 0x3f, 0x34, 0xd8, 0x99, 0x9f, 0x94, 0x27, 0x02, 0x0b, 0x82, 0xde,
+	0x84, 0xd2, 0xcc, 0x56, 0x0a, 0x56, 0xf2, 0x84, 0x8e, 0x61, 0x23, 0xbe, 0x4c, 0x83, 0x10, 0x7b,
+	0xcb, 0x7f, 0x4e, 0x64, 0x08, 0xd4, 0x07, 0xf0, 0x70, 0x24, 0x6c, 0x12, 0x86, 0x3c, 0x34, 0x0a,
+	0xcb, 0x02, 0xf5, 0x18, 0xf2, 0x49, 0xcc, 0xe8, 0x3c, 0x78, 0x7a, 0x59, 0xd3, 0x9e, 0x5d, 0xd6,
+	0xb4, 0x7f, 0x2e, 0x6b, 0xda, 0xe3, 0xab, 0x5a, 0xee, 0xd9, 0x55, 0x2d, 0xf7, 0xd7, 0x55, 0x2d,
+	0xf7, 0xf5, 0xed, 0x39, 0x5e, 0xf2, 0xc2, 0x10, 0x93, 0x80, 0x44, 0xa7, 0x25, 0xf9, 0x41, 0xf6,
+	0xe0, 0xbf, 0x01, 0x00, 0xeb, 0xe5, 0x9d, 0xc3, 0x0b, 0x0a, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -253,6 +734,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InflationCurve != nil {
+		{
+			size, err := m.InflationCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -318,67 +811,1608 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InflationCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+
+func (m *InflationCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Curve != nil {
+		{
+			size := m.Curve.Size()
+			i -= size
+			if _, err := m.Curve.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	if m.EpochsPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochsPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InflationCurve_Halving) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurve_Halving) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Halving != nil {
+		{
+			size, err := m.Halving.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InflationCurve_Pid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurve_Pid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Pid != nil {
+		{
+			size, err := m.Pid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InflationCurve_LinearDecay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurve_LinearDecay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LinearDecay != nil {
+		{
+			size, err := m.LinearDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InflationCurve_Piecewise) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurve_Piecewise) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Piecewise != nil {
+		{
+			size, err := m.Piecewise.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HalvingCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HalvingCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HalvingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.InflationRateChange.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if m.StartPeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.InitialProvision.Size()
+		i -= size
+		if _, err := m.InitialProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PIDCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PIDCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PIDCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Kd.Size()
+		i -= size
+		if _, err := m.Kd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ki.Size()
+		i -= size
+		if _, err := m.Ki.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Kp.Size()
+		i -= size
+		if _, err := m.Kp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearDecayCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearDecayCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearDecayCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndPeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartPeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.FinalInflation.Size()
+		i -= size
+		if _, err := m.FinalInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialInflation.Size()
+		i -= size
+		if _, err := m.InitialInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseCurveSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseCurveSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseCurveSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartPeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationCurveState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationCurveState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationCurveState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastError.Size()
+		i -= size
+		if _, err := m.LastError.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.InflationCurve != nil {
+		l = m.InflationCurve.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *InflationCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EpochsPerYear != 0 {
+		n += 1 + sovMint(uint64(m.EpochsPerYear))
+	}
+	if m.Curve != nil {
+		n += m.Curve.Size()
+	}
+	return n
+}
+
+func (m *InflationCurve_Halving) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Halving != nil {
+		l = m.Halving.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+func (m *InflationCurve_Pid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pid != nil {
+		l = m.Pid.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+func (m *InflationCurve_LinearDecay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinearDecay != nil {
+		l = m.LinearDecay.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+func (m *InflationCurve_Piecewise) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Piecewise != nil {
+		l = m.Piecewise.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+func (m *HalvingCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartPeriod != 0 {
+		n += 1 + sovMint(uint64(m.StartPeriod))
+	}
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	return n
+}
+
+func (m *PIDCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Kp.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Ki.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Kd.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *LinearDecayCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.FinalInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartPeriod != 0 {
+		n += 1 + sovMint(uint64(m.StartPeriod))
+	}
+	if m.EndPeriod != 0 {
+		n += 1 + sovMint(uint64(m.EndPeriod))
+	}
+	return n
+}
+
+func (m *PiecewiseCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PiecewiseCurveSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartPeriod != 0 {
+		n += 1 + sovMint(uint64(m.StartPeriod))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationCurveState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovMint(uint64(m.Period))
+	}
+	l = m.Integral.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.LastError.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InflationCurve == nil {
+				m.InflationCurve = &InflationCurve{}
+			}
+			if err := m.InflationCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			m.EpochsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halving", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HalvingCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Curve = &InflationCurve_Halving{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PIDCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Curve = &InflationCurve_Pid{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LinearDecayCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Curve = &InflationCurve_LinearDecay{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Piecewise", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PiecewiseCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Curve = &InflationCurve_Piecewise{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HalvingCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HalvingCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HalvingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PIDCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PIDCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PIDCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *LinearDecayCurve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,15 +2435,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinearDecayCurve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinearDecayCurve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -437,13 +2471,13 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalInflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -471,15 +2505,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
 			}
-			var byteLen int
+			m.StartPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -489,26 +2523,30 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPeriod", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.EndPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -530,7 +2568,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PiecewiseCurve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -553,17 +2591,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PiecewiseCurve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PiecewiseCurve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -573,29 +2611,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Segments = append(m.Segments, PiecewiseCurveSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseCurveSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseCurveSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseCurveSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPeriod", wireType)
+			}
+			m.StartPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -605,29 +2695,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -655,15 +2730,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationCurveState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationCurveState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationCurveState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -673,29 +2798,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Period |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -723,32 +2833,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
-			}
-			m.BlocksPerYear = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -776,7 +2867,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
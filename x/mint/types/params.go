@@ -65,6 +65,11 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if p.InflationCurve != nil {
+		if err := p.InflationCurve.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
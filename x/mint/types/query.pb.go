@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -270,6 +271,155 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryInflationProjectionsRequest is the request type for the
+// Query/InflationProjections RPC method.
+type QueryInflationProjectionsRequest struct {
+	// num_periods is the number of periods (blocks or epochs) to project.
+	NumPeriods uint32 `protobuf:"varint,1,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *QueryInflationProjectionsRequest) Reset()         { *m = QueryInflationProjectionsRequest{} }
+func (m *QueryInflationProjectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionsRequest) ProtoMessage()    {}
+func (*QueryInflationProjectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryInflationProjectionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionsRequest.Merge(m, src)
+}
+func (m *QueryInflationProjectionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionsRequest proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionsRequest) GetNumPeriods() uint32 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+// QueryInflationProjectionsResponse is the response type for the
+// Query/InflationProjections RPC method.
+type QueryInflationProjectionsResponse struct {
+	// projections are the projections of the next periods.
+	Projections []InflationProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryInflationProjectionsResponse) Reset()         { *m = QueryInflationProjectionsResponse{} }
+func (m *QueryInflationProjectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionsResponse) ProtoMessage()    {}
+func (*QueryInflationProjectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryInflationProjectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionsResponse.Merge(m, src)
+}
+func (m *QueryInflationProjectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionsResponse proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionsResponse) GetProjections() []InflationProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// InflationProjection defines the projected inflation and provision of a period.
+type InflationProjection struct {
+	// period is the block height or epoch number.
+	Period    int64                       `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	Provision types.Coin                  `protobuf:"bytes,3,opt,name=provision,proto3" json:"provision"`
+}
+
+func (m *InflationProjection) Reset()         { *m = InflationProjection{} }
+func (m *InflationProjection) String() string { return proto.CompactTextString(m) }
+func (*InflationProjection) ProtoMessage()    {}
+func (*InflationProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *InflationProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationProjection.Merge(m, src)
+}
+func (m *InflationProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationProjection proto.InternalMessageInfo
+
+func (m *InflationProjection) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *InflationProjection) GetProvision() types.Coin {
+	if m != nil {
+		return m.Provision
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +427,55 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryInflationProjectionsRequest)(nil), "cosmos.mint.v1beta1.QueryInflationProjectionsRequest")
+	proto.RegisterType((*QueryInflationProjectionsResponse)(nil), "cosmos.mint.v1beta1.QueryInflationProjectionsResponse")
+	proto.RegisterType((*InflationProjection)(nil), "cosmos.mint.v1beta1.InflationProjection")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0x6a, 0xa4, 0xbc, 0x80, 0xd4, 0x5e, 0x4b, 0x69, 0xdd, 0xd6, 0x09, 0x46, 0x2a,
+	0x56, 0x01, 0x5b, 0x4d, 0x45, 0x47, 0x24, 0xd2, 0x2e, 0x48, 0x0c, 0x21, 0xa2, 0x0b, 0x4b, 0x74,
+	0x71, 0x8f, 0x60, 0x88, 0xef, 0x5c, 0xdb, 0xa9, 0x08, 0x13, 0x20, 0x46, 0x06, 0x24, 0xfe, 0x09,
+	0xd8, 0x18, 0x58, 0xf8, 0x0b, 0xe8, 0x58, 0xc1, 0x82, 0x18, 0x2a, 0x94, 0x20, 0xf1, 0x6f, 0x20,
+	0xdf, 0x5d, 0x7e, 0x38, 0x71, 0x4a, 0x2b, 0x58, 0xa2, 0xf8, 0xfd, 0xf8, 0xbe, 0xef, 0xbd, 0xe7,
+	0xcf, 0x50, 0x70, 0x78, 0xe8, 0xf1, 0xd0, 0xf6, 0x5c, 0x16, 0xd9, 0x87, 0x9b, 0x75, 0x1a, 0x91,
+	0x4d, 0xfb, 0xa0, 0x45, 0x83, 0xb6, 0xe5, 0x07, 0x3c, 0xe2, 0x78, 0x5e, 0x16, 0x58, 0x71, 0x81,
+	0xa5, 0x0a, 0xb4, 0x85, 0x06, 0x6f, 0x70, 0x91, 0xb7, 0xe3, 0x7f, 0xb2, 0x54, 0x5b, 0x6d, 0x70,
+	0xde, 0x68, 0x52, 0x9b, 0xf8, 0xae, 0x4d, 0x18, 0xe3, 0x11, 0x89, 0x5c, 0xce, 0x42, 0x95, 0xd5,
+	0xd3, 0x98, 0x04, 0xaa, 0xcc, 0xcf, 0x11, 0xcf, 0x65, 0xdc, 0x16, 0xbf, 0x2a, 0xb4, 0x2c, 0x5b,
+	0x6a, 0x92, 0x49, 0x09, 0x49, 0xa2, 0xd5, 0x49, 0x48, 0xfb, 0x68, 0x0e, 0x77, 0x99, 0xcc, 0x1b,
+	0x0b, 0x80, 0xef, 0xc7, 0x53, 0x54, 0x48, 0x40, 0xbc, 0xb0, 0x4a, 0x0f, 0x5a, 0x34, 0x8c, 0x8c,
+	0x3d, 0x98, 0x4f, 0x44, 0x43, 0x9f, 0xb3, 0x90, 0xe2, 0xdb, 0x90, 0xf5, 0x45, 0x64, 0x09, 0x15,
+	0x91, 0x99, 0x2f, 0xad, 0x58, 0x29, 0x43, 0x5b, 0xb2, 0xa9, 0x9c, 0x3b, 0x3a, 0x29, 0x64, 0xde,
+	0xff, 0xfe, 0xb8, 0x81, 0xaa, 0xaa, 0xcb, 0xb8, 0x0c, 0x97, 0x04, 0xec, 0x5d, 0xf6, 0xa8, 0x29,
+	0x66, 0xee, 0xf1, 0x31, 0x58, 0x1c, 0x4d, 0x28, 0xca, 0x07, 0x90, 0x73, 0x7b, 0x41, 0xc1, 0x7a,
+	0xa1, 0xbc, 0x1d, 0x03, 0xff, 0x38, 0x29, 0xac, 0x48, 0xf2, 0x70, 0xff, 0xa9, 0xe5, 0x72, 0xdb,
+	0x23, 0xd1, 0x63, 0xeb, 0x1e, 0x6d, 0x10, 0xa7, 0xbd, 0x4b, 0x9d, 0xaf, 0x9f, 0x6e, 0x82, 0xd2,
+	0xb6, 0x4b, 0x1d, 0xa9, 0x62, 0x00, 0x64, 0xe8, 0xb0, 0x2a, 0xf8, 0xee, 0x30, 0xd6, 0x22, 0xcd,
+	0x4a, 0xc0, 0x0f, 0xdd, 0x30, 0x3e, 0x41, 0x4f, 0xcf, 0x6b, 0x04, 0x6b, 0x13, 0x0a, 0x94, 0x2e,
+	0x07, 0xe6, 0x88, 0xc8, 0xd5, 0xfc, 0x7e, 0xf2, 0x1f, 0xf5, 0xcd, 0x92, 0x11, 0x32, 0x63, 0x07,
+	0x8a, 0xc9, 0xb5, 0x54, 0x02, 0xfe, 0x84, 0x3a, 0xd1, 0x90, 0x54, 0x5c, 0x80, 0x3c, 0x6b, 0x79,
+	0x35, 0x9f, 0x06, 0x2e, 0xdf, 0x97, 0x12, 0x2e, 0x56, 0x81, 0xb5, 0xbc, 0x8a, 0x8c, 0x18, 0xcf,
+	0xe1, 0xca, 0x29, 0x20, 0x6a, 0x9c, 0x3d, 0xc8, 0xfb, 0x83, 0xf0, 0x12, 0x2a, 0x4e, 0x9b, 0xf9,
+	0x92, 0x99, 0x7a, 0xde, 0x14, 0x9c, 0xe1, 0x5b, 0x0f, 0xe3, 0x18, 0x5f, 0x10, 0xcc, 0xa7, 0xd4,
+	0xe3, 0x45, 0xc8, 0x4a, 0xc1, 0x42, 0xef, 0x74, 0x55, 0x3d, 0x25, 0xaf, 0x3d, 0x55, 0x44, 0x66,
+	0xee, 0x3f, 0x5c, 0x1b, 0x97, 0x21, 0xd7, 0x3f, 0xd2, 0xd2, 0xb4, 0x78, 0x73, 0x97, 0x7b, 0xa3,
+	0xc5, 0xbe, 0xe8, 0x8f, 0xb6, 0xc3, 0xdd, 0xc4, 0x2c, 0x83, 0xb6, 0xd2, 0xcb, 0x19, 0x98, 0x11,
+	0x6b, 0xc4, 0x2f, 0x10, 0x64, 0xe5, 0x2b, 0x8e, 0xaf, 0xa5, 0x2e, 0x68, 0xdc, 0x4f, 0x9a, 0xf9,
+	0xf7, 0x42, 0x79, 0x08, 0xe3, 0xea, 0xab, 0x6f, 0xbf, 0xde, 0x4d, 0xad, 0xe1, 0x15, 0x3b, 0xed,
+	0x33, 0x20, 0x7d, 0x84, 0xdf, 0x20, 0xc8, 0xf5, 0xd7, 0x8a, 0x37, 0x26, 0x83, 0x8f, 0x1a, 0x4d,
+	0xbb, 0x7e, 0xa6, 0x5a, 0xa5, 0x65, 0x5d, 0x68, 0x29, 0x62, 0x3d, 0x55, 0xcb, 0x60, 0xbf, 0x1f,
+	0x10, 0xcc, 0x8e, 0x1a, 0x05, 0x6f, 0x4e, 0x66, 0x9a, 0xe0, 0x3a, 0xad, 0x74, 0x9e, 0x16, 0xa5,
+	0xd1, 0x12, 0x1a, 0x4d, 0xbc, 0x9e, 0xaa, 0x71, 0xcc, 0xa2, 0xf8, 0x33, 0x82, 0x85, 0x34, 0x27,
+	0xe0, 0x5b, 0x67, 0xd8, 0xcc, 0xb8, 0xfd, 0xb4, 0xed, 0xf3, 0xb6, 0x29, 0xdd, 0x25, 0xa1, 0xfb,
+	0x06, 0xde, 0x38, 0x7d, 0xb7, 0xb5, 0x21, 0x37, 0x95, 0xb7, 0x8e, 0x3a, 0x3a, 0x3a, 0xee, 0xe8,
+	0xe8, 0x67, 0x47, 0x47, 0x6f, 0xbb, 0x7a, 0xe6, 0xb8, 0xab, 0x67, 0xbe, 0x77, 0xf5, 0xcc, 0xc3,
+	0xe5, 0x84, 0x39, 0x9e, 0x49, 0xb0, 0xa8, 0xed, 0xd3, 0xb0, 0x9e, 0x15, 0xdf, 0xf9, 0xad, 0x3f,
+	0x03, 0x00, 0xc9, 0x84, 0xcd, 0x67, 0xc1, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +496,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// InflationProjections returns the projected provisions of the next periods of
+	// the inflation curve.
+	InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +536,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) InflationProjections(ctx context.Context, in *QueryInflationProjectionsRequest, opts ...grpc.CallOption) (*QueryInflationProjectionsResponse, error) {
+	out := new(QueryInflationProjectionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/InflationProjections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +553,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// InflationProjections returns the projected provisions of the next periods of
+	// the inflation curve.
+	InflationProjections(context.Context, *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +571,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) InflationProjections(ctx context.Context, req *QueryInflationProjectionsRequest) (*QueryInflationProjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjections not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +633,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationProjections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/InflationProjections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjections(ctx, req.(*QueryInflationProjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -469,6 +668,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "InflationProjections",
+			Handler:    _Query_InflationProjections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -642,6 +845,119 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInflationProjectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +1029,49 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInflationProjectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.NumPeriods))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1118,6 +1477,295 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInflationProjectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, InflationProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
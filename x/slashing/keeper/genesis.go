@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +60,29 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, history := range data.OffenceHistories {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(history.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, offence := range history.Offences {
+			if err := keeper.recordOffence(ctx, address, offence); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, window := range data.MaintenanceWindows {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(window.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := keeper.MaintenanceWindows.Set(ctx, valAddr, window); err != nil {
+			return err
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +124,41 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	offenceHistories := make([]types.ValidatorOffenceHistory, 0)
+	err = keeper.OffenceHistory.Walk(ctx, nil, func(key collections.Pair[sdk.ConsAddress, uint64], offence types.OffenceRecord) (stop bool, err error) {
+		bechAddr, err := keeper.sk.ConsensusAddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		// offences are iterated by address, then oldest first
+		if n := len(offenceHistories); n > 0 && offenceHistories[n-1].Address == bechAddr {
+			offenceHistories[n-1].Offences = append(offenceHistories[n-1].Offences, offence)
+			return false, nil
+		}
+
+		offenceHistories = append(offenceHistories, types.ValidatorOffenceHistory{
+			Address:  bechAddr,
+			Offences: []types.OffenceRecord{offence},
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	maintenanceWindows := make([]types.MaintenanceWindow, 0)
+	err = keeper.MaintenanceWindows.Walk(ctx, nil, func(_ sdk.ValAddress, window types.MaintenanceWindow) (stop bool, err error) {
+		maintenanceWindows = append(maintenanceWindows, window)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.OffenceHistories = offenceHistories
+	genesis.MaintenanceWindows = maintenanceWindows
+	return genesis, nil
 }
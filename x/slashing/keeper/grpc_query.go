@@ -2,14 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +83,55 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// OffenceHistory returns the offence history of a specific validator.
+func (k Querier) OffenceHistory(ctx context.Context, req *types.QueryOffenceHistoryRequest) (*types.QueryOffenceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	offences, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.OffenceHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ConsAddress, uint64], record types.OffenceRecord) (types.OffenceRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOffenceHistoryResponse{Offences: offences, Pagination: pageRes}, nil
+}
+
+// MaintenanceWindow returns the maintenance window announced by a specific validator.
+func (k Querier) MaintenanceWindow(ctx context.Context, req *types.QueryMaintenanceWindowRequest) (*types.QueryMaintenanceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	window, err := k.GetMaintenanceWindow(ctx, valAddr)
+	if errors.Is(err, types.ErrMaintenanceWindowNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no maintenance window for validator %s", req.ValidatorAddr)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMaintenanceWindowResponse{MaintenanceWindow: window}, nil
+}
@@ -80,6 +80,19 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 
 	modifiedSignInfo := false
 	missed := signed == comet.BlockIDFlagAbsent
+
+	// blocks missed within an announced maintenance window are counted as signed
+	if missed && params.MaxGracePeriod > 0 {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		if err != nil {
+			return err
+		}
+		inMaintenance, err := k.isInMaintenance(ctx, valAddr, k.HeaderService.HeaderInfo(ctx).Time)
+		if err != nil {
+			return err
+		}
+		missed = !inMaintenance
+	}
 	switch {
 	case !previous && missed:
 		// Bitmap value has changed from not missed to missed, so we flip the bit
@@ -149,6 +162,16 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		if err != nil {
 			return err
 		}
+		downtimeJailDur, err := k.DowntimeJailDuration(ctx)
+		if err != nil {
+			return err
+		}
+
+		// repeated downtime offences within the escalation window escalate the penalty
+		slashFractionDowntime, downtimeJailDur, err = k.escalateDowntimePenalty(ctx, params, consAddr, slashFractionDowntime, downtimeJailDur)
+		if err != nil {
+			return err
+		}
 
 		coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 		if err != nil {
@@ -170,11 +193,17 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		if err != nil {
 			return err
 		}
-		downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-		if err != nil {
+		signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
+
+		if err := k.recordOffence(ctx, consAddr, types.OffenceRecord{
+			Height:        height,
+			Time:          k.HeaderService.HeaderInfo(ctx).Time,
+			Reason:        types.AttributeValueMissingSignature,
+			SlashFraction: slashFractionDowntime,
+			JailDuration:  downtimeJailDur,
+		}); err != nil {
 			return err
 		}
-		signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
 
 		// We need to reset the counter & bitmap so that the validator won't be
 		// immediately slashed for downtime upon re-bonding.
@@ -36,6 +36,12 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// OffenceHistory key: ConsAddr+sequence | value: OffenceRecord
+	OffenceHistory collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.OffenceRecord]
+	// OffenceSequence is the sequence of offence records
+	OffenceSequence collections.Sequence
+	// MaintenanceWindows key: ValAddr | value: MaintenanceWindow
+	MaintenanceWindows collections.Map[sdk.ValAddress, types.MaintenanceWindow]
 }
 
 // NewKeeper creates a slashing keeper
@@ -69,6 +75,21 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		OffenceHistory: collections.NewMap(
+			sb,
+			types.OffenceHistoryKeyPrefix,
+			"offence_history",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+			codec.CollValue[types.OffenceRecord](cdc),
+		),
+		OffenceSequence: collections.NewSequence(sb, types.OffenceSequenceKey, "offence_sequence"),
+		MaintenanceWindows: collections.NewMap(
+			sb,
+			types.MaintenanceWindowsKeyPrefix,
+			"maintenance_windows",
+			sdk.ValAddressKey,
+			codec.CollValue[types.MaintenanceWindow](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -116,6 +137,16 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 		return err
 	}
 
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if err := k.recordOffence(ctx, consAddr, types.OffenceRecord{
+		Height:        headerInfo.Height,
+		Time:          headerInfo.Time,
+		Reason:        reasonAttr.Value,
+		SlashFraction: fraction,
+	}); err != nil {
+		return err
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeSlash,
 		event.NewAttribute(types.AttributeKeyAddress, consStr),
@@ -162,7 +162,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"fa402173617c46e3c977827971456ce5d62c8240f973c595d639341b5d858a8d",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +174,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"fa402173617c46e3c977827971456ce5d62c8240f973c595d639341b5d858a8d",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnnounceMaintenance announces the one-time maintenance window of a validator,
+// during which its missed blocks are counted as signed. The window must start at
+// least GracePeriodNotice after the current block time and last at most
+// MaxGracePeriod.
+func (k Keeper) AnnounceMaintenance(ctx context.Context, valAddr sdk.ValAddress, startTime time.Time, duration time.Duration) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxGracePeriod == 0 {
+		return types.ErrGracePeriodDisabled
+	}
+	if duration <= 0 || duration > params.MaxGracePeriod {
+		return types.ErrInvalidGracePeriod.Wrapf("duration must be positive and at most %s, got %s", params.MaxGracePeriod, duration)
+	}
+
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+	if startTime.Before(blockTime.Add(params.GracePeriodNotice)) {
+		return types.ErrInvalidGracePeriod.Wrapf("minimum notice is %s", params.GracePeriodNotice)
+	}
+
+	// the validator must exist
+	if _, err := k.sk.Validator(ctx, valAddr); err != nil {
+		return err
+	}
+
+	has, err := k.MaintenanceWindows.Has(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrGracePeriodUsed
+	}
+
+	valStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	window := types.MaintenanceWindow{
+		ValidatorAddress: valStr,
+		StartTime:        startTime,
+		EndTime:          startTime.Add(duration),
+	}
+	if err := k.MaintenanceWindows.Set(ctx, valAddr, window); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAnnounceMaintenance,
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(types.AttributeKeyStartTime, window.StartTime.Format(time.RFC3339)),
+		event.NewAttribute(types.AttributeKeyEndTime, window.EndTime.Format(time.RFC3339)),
+	)
+}
+
+// GetMaintenanceWindow returns the maintenance window announced by a validator.
+// The window is kept once it has ended as a validator may only announce one.
+func (k Keeper) GetMaintenanceWindow(ctx context.Context, valAddr sdk.ValAddress) (types.MaintenanceWindow, error) {
+	window, err := k.MaintenanceWindows.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MaintenanceWindow{}, types.ErrMaintenanceWindowNotFound
+	}
+	return window, err
+}
+
+// isInMaintenance returns true if the validator is within its maintenance window
+// at the given time.
+func (k Keeper) isInMaintenance(ctx context.Context, valAddr sdk.ValAddress, t time.Time) (bool, error) {
+	window, err := k.MaintenanceWindows.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return !t.Before(window.StartTime) && t.Before(window.EndTime), nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	gogoany "github.com/cosmos/gogoproto/types/any"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAnnounceMaintenance() {
+	require := s.Require()
+
+	_, edPubKey, valAddr := testdata.KeyTestPubAddrED25519()
+	consAddr := sdk.ConsAddress(edPubKey.Address())
+	valStrAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	consStrAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	vpk, err := gogoany.NewAnyWithCacheWithValue(edPubKey)
+	require.NoError(err)
+	validator := stakingtypes.Validator{OperatorAddress: valStrAddr, ConsensusPubkey: vpk, Status: stakingtypes.Bonded}
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valAddr)).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+
+	blockTime := s.ctx.HeaderInfo().Time
+	startTime := blockTime.Add(types.DefaultGracePeriodNotice)
+	msg := types.NewMsgAnnounceMaintenance(valStrAddr, startTime, time.Hour)
+
+	// maintenance windows are disabled by default
+	_, err = s.msgServer.AnnounceMaintenance(s.ctx, msg)
+	require.ErrorIs(err, types.ErrGracePeriodDisabled)
+
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.MaxGracePeriod = 2 * time.Hour
+	require.NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	// the window must be announced in advance and is bounded by the max grace period
+	_, err = s.msgServer.AnnounceMaintenance(s.ctx, types.NewMsgAnnounceMaintenance(valStrAddr, startTime.Add(-time.Second), time.Hour))
+	require.ErrorIs(err, types.ErrInvalidGracePeriod)
+	_, err = s.msgServer.AnnounceMaintenance(s.ctx, types.NewMsgAnnounceMaintenance(valStrAddr, startTime, 3*time.Hour))
+	require.ErrorIs(err, types.ErrInvalidGracePeriod)
+
+	_, err = s.msgServer.AnnounceMaintenance(s.ctx, msg)
+	require.NoError(err)
+
+	// a validator can only announce one maintenance window
+	_, err = s.msgServer.AnnounceMaintenance(s.ctx, types.NewMsgAnnounceMaintenance(valStrAddr, startTime.Add(time.Hour), time.Hour))
+	require.ErrorIs(err, types.ErrGracePeriodUsed)
+
+	res, err := s.queryClient.MaintenanceWindow(s.ctx, &types.QueryMaintenanceWindowRequest{ValidatorAddr: valStrAddr})
+	require.NoError(err)
+	require.Equal(startTime, res.MaintenanceWindow.StartTime)
+	require.Equal(startTime.Add(time.Hour), res.MaintenanceWindow.EndTime)
+
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr,
+		types.NewValidatorSigningInfo(consStrAddr, 0, time.Unix(0, 0), false, 0)))
+	missedAt := func(t time.Time) int64 {
+		headerInfo := s.ctx.HeaderInfo()
+		headerInfo.Height++
+		headerInfo.Time = t
+		s.ctx = s.ctx.WithHeaderInfo(headerInfo)
+		require.NoError(s.slashingKeeper.HandleValidatorSignatureWithParams(s.ctx, params, edPubKey.Address(), 1, comet.BlockIDFlagAbsent))
+
+		info, err := s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, consAddr)
+		require.NoError(err)
+		return info.MissedBlocksCounter
+	}
+
+	// blocks missed within the window are counted as signed
+	require.Equal(int64(1), missedAt(startTime.Add(-time.Second)))
+	require.Equal(int64(1), missedAt(startTime))
+	require.Equal(int64(1), missedAt(startTime.Add(time.Hour-time.Second)))
+	require.Equal(int64(2), missedAt(startTime.Add(time.Hour)))
+}
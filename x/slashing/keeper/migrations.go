@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	v4 "cosmossdk.io/x/slashing/migrations/v4"
+	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,21 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params, m.valCodec)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the downtime escalation and
+// grace period parameters to their defaults.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.DowntimeEscalationWindow = types.DefaultDowntimeEscalationWindow
+	params.DowntimeJailEscalationFactor = types.DefaultDowntimeJailEscalationFactor
+	params.DowntimeSlashEscalationFactor = types.DefaultDowntimeSlashEscalationFactor
+	params.MaxGracePeriod = types.DefaultMaxGracePeriod
+	params.GracePeriodNotice = types.DefaultGracePeriodNotice
+
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -54,3 +54,19 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// AnnounceMaintenance implements MsgServer.AnnounceMaintenance method.
+// Validators can announce a one-time maintenance window in advance, during
+// which their missed blocks are not counted against their liveness.
+func (k msgServer) AnnounceMaintenance(ctx context.Context, msg *types.MsgAnnounceMaintenance) (*types.MsgAnnounceMaintenanceResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.AnnounceMaintenance(ctx, valAddr, msg.StartTime, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAnnounceMaintenanceResponse{}, nil
+}
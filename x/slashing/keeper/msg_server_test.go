@@ -128,6 +128,9 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					DowntimeJailDuration:    time.Duration(34800000000000),
 					SlashFractionDoubleSign: slashFractionDoubleSign,
 					SlashFractionDowntime:   slashFractionDowntime,
+
+					DowntimeJailEscalationFactor:  slashingtypes.DefaultDowntimeJailEscalationFactor,
+					DowntimeSlashEscalationFactor: slashingtypes.DefaultDowntimeSlashEscalationFactor,
 				},
 			},
 			expectErr: false,
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordOffence appends an offence to the history of a validator, pruning the
+// oldest offences beyond MaxOffenceHistory.
+func (k Keeper) recordOffence(ctx context.Context, consAddr sdk.ConsAddress, record types.OffenceRecord) error {
+	seq, err := k.OffenceSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.OffenceHistory.Set(ctx, collections.Join(consAddr, seq), record); err != nil {
+		return err
+	}
+
+	iter, err := k.OffenceHistory.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](consAddr))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for len(keys) > types.MaxOffenceHistory {
+		if err := k.OffenceHistory.Remove(ctx, keys[0]); err != nil {
+			return err
+		}
+		keys = keys[1:]
+	}
+
+	return nil
+}
+
+// GetOffenceHistory returns the offence history of a validator, oldest first.
+func (k Keeper) GetOffenceHistory(ctx context.Context, consAddr sdk.ConsAddress) ([]types.OffenceRecord, error) {
+	iter, err := k.OffenceHistory.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](consAddr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// escalateDowntimePenalty returns the downtime slash fraction and jail duration
+// of a validator, each multiplied by its escalation factor once per downtime
+// offence of the validator within the escalation window. The slash fraction is
+// capped by the double sign slash fraction.
+func (k Keeper) escalateDowntimePenalty(
+	ctx context.Context, params types.Params, consAddr sdk.ConsAddress, slashFraction sdkmath.LegacyDec, jailDuration time.Duration,
+) (sdkmath.LegacyDec, time.Duration, error) {
+	if params.DowntimeEscalationWindow == 0 {
+		return slashFraction, jailDuration, nil
+	}
+
+	since := k.HeaderService.HeaderInfo(ctx).Time.Add(-params.DowntimeEscalationWindow)
+	history, err := k.GetOffenceHistory(ctx, consAddr)
+	if err != nil {
+		return sdkmath.LegacyDec{}, 0, err
+	}
+
+	offences := 0
+	for _, record := range history {
+		if record.Reason == types.AttributeValueMissingSignature && !record.Time.Before(since) {
+			offences++
+		}
+	}
+
+	if slashFraction.LT(params.SlashFractionDoubleSign) {
+		slashFraction = escalate(slashFraction, params.DowntimeSlashEscalationFactor, offences, params.SlashFractionDoubleSign)
+	}
+	jail := escalate(sdkmath.LegacyNewDec(int64(jailDuration)), params.DowntimeJailEscalationFactor, offences, sdkmath.LegacyNewDec(math.MaxInt64))
+
+	return slashFraction, time.Duration(jail.TruncateInt64()), nil
+}
+
+// escalate multiplies value by factor n times, bounded by limit.
+func escalate(value, factor sdkmath.LegacyDec, n int, limit sdkmath.LegacyDec) sdkmath.LegacyDec {
+	for i := 0; i < n && value.LT(limit); i++ {
+		value = value.Mul(factor)
+	}
+
+	return sdkmath.LegacyMinDec(value, limit)
+}
+
+// moveOffenceHistory moves the offence history of a validator to its new
+// consensus address after a consensus key rotation.
+func (k Keeper) moveOffenceHistory(ctx context.Context, oldConsAddr, newConsAddr sdk.ConsAddress) error {
+	iter, err := k.OffenceHistory.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](oldConsAddr))
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := k.OffenceHistory.Set(ctx, collections.Join(newConsAddr, kv.Key.K2()), kv.Value); err != nil {
+			return err
+		}
+		if err := k.OffenceHistory.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"time"
+
+	gogoany "github.com/cosmos/gogoproto/types/any"
+	"go.uber.org/mock/gomock"
+
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestDowntimeEscalation() {
+	require := s.Require()
+
+	_, edPubKey, valAddr := testdata.KeyTestPubAddrED25519()
+	consAddr := sdk.ConsAddress(edPubKey.Address())
+	valStrAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	consStrAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	vpk, err := gogoany.NewAnyWithCacheWithValue(edPubKey)
+	require.NoError(err)
+	validator := stakingtypes.Validator{OperatorAddress: valStrAddr, ConsensusPubkey: vpk, Status: stakingtypes.Bonded}
+
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.DowntimeEscalationWindow = 24 * time.Hour
+	params.DowntimeSlashEscalationFactor = math.LegacyNewDec(2)
+	params.SlashFractionDoubleSign = math.LegacyNewDecWithPrec(3, 2)
+	require.NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil).AnyTimes()
+
+	blockTime := s.ctx.HeaderInfo().Time
+	punish := func(offset time.Duration, expSlash math.LegacyDec, expJail time.Duration) {
+		headerInfo := s.ctx.HeaderInfo()
+		headerInfo.Height = 2000
+		headerInfo.Time = blockTime.Add(offset)
+		s.ctx = s.ctx.WithHeaderInfo(headerInfo)
+
+		// the validator missed more than the allowed blocks of the window
+		require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr,
+			types.NewValidatorSigningInfo(consStrAddr, 0, time.Unix(0, 0), false, params.SignedBlocksWindow)))
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(1998), int64(0), expSlash, stakingv1beta1.Infraction_INFRACTION_DOWNTIME).
+			Return(math.NewInt(1), nil)
+
+		require.NoError(s.slashingKeeper.HandleValidatorSignatureWithParams(s.ctx, params, edPubKey.Address(), 0, comet.BlockIDFlagAbsent))
+
+		info, err := s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, consAddr)
+		require.NoError(err)
+		require.Equal(headerInfo.Time.Add(expJail), info.JailedUntil)
+	}
+
+	// every downtime offence within the window escalates the penalty, the slash
+	// fraction being capped by the double sign slash fraction
+	punish(0, math.LegacyNewDecWithPrec(1, 2), params.DowntimeJailDuration)
+	punish(time.Hour, math.LegacyNewDecWithPrec(2, 2), 2*params.DowntimeJailDuration)
+	punish(2*time.Hour, math.LegacyNewDecWithPrec(3, 2), 4*params.DowntimeJailDuration)
+
+	// offences older than the window are not counted
+	punish(25*time.Hour+time.Second, math.LegacyNewDecWithPrec(2, 2), 2*params.DowntimeJailDuration)
+
+	history, err := s.slashingKeeper.GetOffenceHistory(s.ctx, consAddr)
+	require.NoError(err)
+	require.Len(history, 4)
+	require.Equal(types.AttributeValueMissingSignature, history[3].Reason)
+	require.Equal(math.LegacyNewDecWithPrec(2, 2), history[3].SlashFraction)
+	require.Equal(2*params.DowntimeJailDuration, history[3].JailDuration)
+
+	res, err := s.queryClient.OffenceHistory(s.ctx, &types.QueryOffenceHistoryRequest{ConsAddress: consStrAddr})
+	require.NoError(err)
+	require.Equal(history, res.Offences)
+}
+
+func (s *KeeperTestSuite) TestOffenceHistoryPruning() {
+	require := s.Require()
+
+	fraction := math.LegacyNewDecWithPrec(5, 2)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), gomock.Any(), fraction, stakingv1beta1.Infraction_INFRACTION_DOUBLE_SIGN).
+		Return(math.NewInt(1), nil).AnyTimes()
+
+	for height := int64(1); height <= types.MaxOffenceHistory+5; height++ {
+		headerInfo := s.ctx.HeaderInfo()
+		headerInfo.Height = height
+		s.ctx = s.ctx.WithHeaderInfo(headerInfo)
+		require.NoError(s.slashingKeeper.SlashWithInfractionReason(s.ctx, consAddr, fraction, 1, height, stakingv1beta1.Infraction_INFRACTION_DOUBLE_SIGN))
+	}
+
+	// the oldest offences are pruned
+	history, err := s.slashingKeeper.GetOffenceHistory(s.ctx, consAddr)
+	require.NoError(err)
+	require.Len(history, types.MaxOffenceHistory)
+	require.Equal(int64(6), history[0].Height)
+	require.Equal(types.AttributeValueDoubleSign, history[0].Reason)
+	require.Equal(fraction, history[0].SlashFraction)
+}
@@ -13,6 +13,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/slashing/Params")
 	legacy.RegisterAminoMsg(registrar, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/slashing/MsgUpdateParams")
+	legacy.RegisterAminoMsg(registrar, &MsgAnnounceMaintenance{}, "cosmos-sdk/MsgAnnounceMaintenance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -20,6 +21,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUnjail{},
 		&MsgUpdateParams{},
+		&MsgAnnounceMaintenance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -14,4 +14,8 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrGracePeriodDisabled          = errors.Register(ModuleName, 12, "maintenance windows are disabled")
+	ErrInvalidGracePeriod           = errors.Register(ModuleName, 13, "invalid maintenance window")
+	ErrGracePeriodUsed              = errors.Register(ModuleName, 14, "validator already announced a maintenance window")
+	ErrMaintenanceWindowNotFound    = errors.Register(ModuleName, 15, "no maintenance window found")
 )
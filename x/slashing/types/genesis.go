@@ -32,6 +32,9 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+
+		OffenceHistories:   []ValidatorOffenceHistory{},
+		MaintenanceWindows: []MaintenanceWindow{},
 	}
 }
 
@@ -62,5 +65,39 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateNonNegativeDuration("downtime escalation window", data.Params.DowntimeEscalationWindow); err != nil {
+		return err
+	}
+	if err := validateEscalationFactor("downtime jail", data.Params.DowntimeJailEscalationFactor); err != nil {
+		return err
+	}
+	if err := validateEscalationFactor("downtime slash", data.Params.DowntimeSlashEscalationFactor); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("max grace period", data.Params.MaxGracePeriod); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("grace period notice", data.Params.GracePeriodNotice); err != nil {
+		return err
+	}
+
+	for _, history := range data.OffenceHistories {
+		if len(history.Offences) > MaxOffenceHistory {
+			return fmt.Errorf("offence history of %s has %d offences, at most %d are kept", history.Address, len(history.Offences), MaxOffenceHistory)
+		}
+	}
+
+	seenWindows := make(map[string]bool, len(data.MaintenanceWindows))
+	for _, window := range data.MaintenanceWindows {
+		if seenWindows[window.ValidatorAddress] {
+			return fmt.Errorf("duplicate maintenance window for validator %s", window.ValidatorAddress)
+		}
+		seenWindows[window.ValidatorAddress] = true
+
+		if !window.EndTime.After(window.StartTime) {
+			return fmt.Errorf("maintenance window of %s must end after its start", window.ValidatorAddress)
+		}
+	}
+
 	return nil
 }
@@ -40,6 +40,10 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// MaxOffenceHistory is the number of offences kept in the history of a
+	// validator, older offences are pruned.
+	MaxOffenceHistory = 100
 )
 
 // Keys for slashing store
@@ -50,12 +54,21 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><sequence>: OffenceRecord
+//
+// - 0x05: offence sequence
+//
+// - 0x06<valAddrLen (1 Byte)><valAddress_Bytes>: MaintenanceWindow
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	OffenceHistoryKeyPrefix             = collections.NewPrefix(4) // Prefix for offence history
+	OffenceSequenceKey                  = collections.NewPrefix(5) // Key for the offence sequence
+	MaintenanceWindowsKeyPrefix         = collections.NewPrefix(6) // Prefix for maintenance windows
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAnnounceMaintenance{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +19,12 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgAnnounceMaintenance creates a new MsgAnnounceMaintenance instance
+func NewMsgAnnounceMaintenance(validatorAddr string, startTime time.Time, duration time.Duration) *MsgAnnounceMaintenance {
+	return &MsgAnnounceMaintenance{
+		ValidatorAddr: validatorAddr,
+		StartTime:     startTime,
+		Duration:      duration,
+	}
+}
@@ -11,15 +11,28 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultDowntimeEscalationWindow and DefaultMaxGracePeriod are zero, downtime
+	// escalation and maintenance windows are opt-in.
+	DefaultDowntimeEscalationWindow = time.Duration(0)
+	DefaultMaxGracePeriod           = time.Duration(0)
+	DefaultGracePeriodNotice        = 24 * time.Hour
 )
 
 var (
-	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
-	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultMinSignedPerWindow            = math.LegacyNewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign       = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
+	DefaultSlashFractionDowntime         = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultDowntimeJailEscalationFactor  = math.LegacyNewDec(2)
+	DefaultDowntimeSlashEscalationFactor = math.LegacyOneDec()
+
+	// maxEscalationFactor bounds the escalation factors so that escalated
+	// penalties can not overflow.
+	maxEscalationFactor = math.LegacyNewDec(100)
 )
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object, the downtime escalation and grace period
+// parameters are set to their defaults.
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
@@ -30,6 +43,12 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+
+		DowntimeEscalationWindow:      DefaultDowntimeEscalationWindow,
+		DowntimeJailEscalationFactor:  DefaultDowntimeJailEscalationFactor,
+		DowntimeSlashEscalationFactor: DefaultDowntimeSlashEscalationFactor,
+		MaxGracePeriod:                DefaultMaxGracePeriod,
+		GracePeriodNotice:             DefaultGracePeriodNotice,
 	}
 }
 
@@ -61,6 +80,21 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateNonNegativeDuration("downtime escalation window", p.DowntimeEscalationWindow); err != nil {
+		return err
+	}
+	if err := validateEscalationFactor("downtime jail", p.DowntimeJailEscalationFactor); err != nil {
+		return err
+	}
+	if err := validateEscalationFactor("downtime slash", p.DowntimeSlashEscalationFactor); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("max grace period", p.MaxGracePeriod); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDuration("grace period notice", p.GracePeriodNotice); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +181,28 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateNonNegativeDuration(name string, v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("%s cannot be negative: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateEscalationFactor(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s escalation factor cannot be nil: %s", name, v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s escalation factor must be at least 1: %s", name, v)
+	}
+	if v.GT(maxEscalationFactor) {
+		return fmt.Errorf("%s escalation factor too large: %s", name, v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -135,6 +135,23 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_escalation_window is the period over which previous downtime
+	// offences of a validator escalate its downtime penalties. Zero disables the
+	// escalation.
+	DowntimeEscalationWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_escalation_window,json=downtimeEscalationWindow,proto3,stdduration" json:"downtime_escalation_window"`
+	// downtime_jail_escalation_factor multiplies the downtime jail duration once per
+	// previous downtime offence within the escalation window.
+	DowntimeJailEscalationFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=downtime_jail_escalation_factor,json=downtimeJailEscalationFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_jail_escalation_factor"`
+	// downtime_slash_escalation_factor multiplies the downtime slash fraction once per
+	// previous downtime offence within the escalation window. The escalated fraction
+	// never exceeds the double sign slash fraction.
+	DowntimeSlashEscalationFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=downtime_slash_escalation_factor,json=downtimeSlashEscalationFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_slash_escalation_factor"`
+	// max_grace_period is the maximum duration of a maintenance window. Zero
+	// disables maintenance windows.
+	MaxGracePeriod time.Duration `protobuf:"bytes,9,opt,name=max_grace_period,json=maxGracePeriod,proto3,stdduration" json:"max_grace_period"`
+	// grace_period_notice is the minimum time between the announcement of a
+	// maintenance window and its start.
+	GracePeriodNotice time.Duration `protobuf:"bytes,10,opt,name=grace_period_notice,json=gracePeriodNotice,proto3,stdduration" json:"grace_period_notice"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +201,173 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeEscalationWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeEscalationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGracePeriod() time.Duration {
+	if m != nil {
+		return m.MaxGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetGracePeriodNotice() time.Duration {
+	if m != nil {
+		return m.GracePeriodNotice
+	}
+	return 0
+}
+
+// OffenceRecord defines a slashing offence committed by a validator.
+type OffenceRecord struct {
+	// height is the block height at which the offence was punished.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the offence was punished.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// reason is the infraction, either "missing_signature", "double_sign" or
+	// "unspecified".
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// slash_fraction is the fraction of the stake slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail_duration is the duration the validator was jailed for, zero if the
+	// offence did not jail the validator for a set duration.
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *OffenceRecord) Reset()         { *m = OffenceRecord{} }
+func (m *OffenceRecord) String() string { return proto.CompactTextString(m) }
+func (*OffenceRecord) ProtoMessage()    {}
+func (*OffenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *OffenceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffenceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffenceRecord.Merge(m, src)
+}
+func (m *OffenceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffenceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffenceRecord proto.InternalMessageInfo
+
+func (m *OffenceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OffenceRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *OffenceRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OffenceRecord) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// MaintenanceWindow defines the grace period announced by a validator, during
+// which its missed blocks are not counted against its liveness.
+type MaintenanceWindow struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_time is the start of the maintenance window.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the end of the maintenance window, exclusive.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceWindow) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*OffenceRecord)(nil), "cosmos.slashing.v1beta1.OffenceRecord")
+	proto.RegisterType((*MaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MaintenanceWindow")
 }
 
 func init() {
@@ -194,47 +375,63 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0x3a, 0x89, 0x93, 0x8c, 0x93, 0xaa, 0x99, 0xa6, 0xcd, 0xd6, 0x34, 0x6b, 0x27, 0x12,
+	0x28, 0xaa, 0x14, 0x2f, 0x0d, 0x12, 0x87, 0x56, 0x1c, 0x70, 0x4d, 0x29, 0xa8, 0x8f, 0x68, 0xc3,
+	0x4b, 0x48, 0xb0, 0x1a, 0xef, 0xcc, 0x6e, 0x86, 0xec, 0xce, 0x58, 0x3b, 0xe3, 0xc4, 0x3d, 0x20,
+	0xee, 0x9c, 0x7a, 0xe4, 0x88, 0xc4, 0xa5, 0x07, 0x0e, 0x3d, 0xf4, 0x5f, 0x40, 0xea, 0xb1, 0xea,
+	0x09, 0x71, 0x28, 0xc8, 0x39, 0x84, 0x3b, 0xff, 0x00, 0x9a, 0xc7, 0xfa, 0x11, 0x8b, 0x83, 0xf1,
+	0xc5, 0xf2, 0x7c, 0x8f, 0xdf, 0x6f, 0xbf, 0xdf, 0x37, 0xf3, 0x7d, 0xe0, 0x9d, 0x88, 0x8b, 0x8c,
+	0x0b, 0x5f, 0xa4, 0x48, 0x1c, 0x51, 0x96, 0xf8, 0x27, 0xb7, 0xda, 0x44, 0xa2, 0x5b, 0x03, 0x43,
+	0xa3, 0x93, 0x73, 0xc9, 0xe1, 0xa6, 0x89, 0x6b, 0x0c, 0xcc, 0x36, 0xae, 0xba, 0x91, 0xf0, 0x84,
+	0xeb, 0x18, 0x5f, 0xfd, 0x33, 0xe1, 0x55, 0x2f, 0xe1, 0x3c, 0x49, 0x89, 0xaf, 0x4f, 0xed, 0x6e,
+	0xec, 0xe3, 0x6e, 0x8e, 0x24, 0xe5, 0xcc, 0xfa, 0x6b, 0x17, 0xfd, 0x92, 0x66, 0x44, 0x48, 0x94,
+	0x75, 0x6c, 0xc0, 0x75, 0xc3, 0x17, 0x1a, 0x64, 0x4b, 0x6e, 0x5c, 0xeb, 0x28, 0xa3, 0x8c, 0xfb,
+	0xfa, 0xd7, 0x98, 0x76, 0x7e, 0x2b, 0x81, 0x8d, 0x2f, 0x50, 0x4a, 0x31, 0x92, 0x3c, 0x3f, 0xa4,
+	0x09, 0xa3, 0x2c, 0xf9, 0x84, 0xc5, 0x1c, 0xde, 0x01, 0x4b, 0x08, 0xe3, 0x9c, 0x08, 0xe1, 0x3a,
+	0x75, 0x67, 0x77, 0xa5, 0xb9, 0xfd, 0xfa, 0xc5, 0xde, 0x96, 0x85, 0xbb, 0xcb, 0x99, 0x20, 0x4c,
+	0x74, 0xc5, 0x87, 0x26, 0xe4, 0x50, 0xe6, 0x94, 0x25, 0x41, 0x91, 0x01, 0xb7, 0xc1, 0xaa, 0x90,
+	0x28, 0x97, 0xe1, 0x11, 0xa1, 0xc9, 0x91, 0x74, 0x4b, 0x75, 0x67, 0x77, 0x3e, 0xa8, 0x68, 0xdb,
+	0x7d, 0x6d, 0x82, 0x6f, 0x83, 0x55, 0xca, 0x30, 0xe9, 0x85, 0x3c, 0x8e, 0x05, 0x91, 0xee, 0xbc,
+	0x0a, 0x69, 0x96, 0x5c, 0x27, 0xa8, 0x68, 0xfb, 0x63, 0x6d, 0x86, 0x0f, 0xc0, 0xea, 0x77, 0x88,
+	0xa6, 0x04, 0x87, 0x5d, 0x26, 0x69, 0xea, 0x2e, 0xd4, 0x9d, 0xdd, 0xca, 0x7e, 0xb5, 0x61, 0x54,
+	0x68, 0x14, 0x2a, 0x34, 0x3e, 0x2b, 0x54, 0x68, 0xae, 0xbd, 0x7c, 0x53, 0x9b, 0x7b, 0xfa, 0x67,
+	0xcd, 0x79, 0x76, 0xfe, 0xfc, 0xa6, 0x13, 0x54, 0x4c, 0xfa, 0xe7, 0x2a, 0x1b, 0x7a, 0x00, 0x48,
+	0x9e, 0xb5, 0x85, 0xe4, 0x8c, 0x60, 0x77, 0xb1, 0xee, 0xec, 0x2e, 0x07, 0x23, 0x16, 0xb8, 0x0f,
+	0xae, 0x66, 0x54, 0x08, 0x82, 0xc3, 0x76, 0xca, 0xa3, 0x63, 0x11, 0x46, 0xbc, 0xcb, 0x24, 0xc9,
+	0xdd, 0xb2, 0x2e, 0xe0, 0x8a, 0x71, 0x36, 0xb5, 0xef, 0xae, 0x71, 0xdd, 0x5e, 0xf8, 0xfb, 0xe7,
+	0x9a, 0xb3, 0xf3, 0xcb, 0x32, 0x28, 0x1f, 0xa0, 0x1c, 0x65, 0x02, 0xbe, 0x0b, 0x36, 0x04, 0x4d,
+	0xd8, 0x10, 0xe4, 0x94, 0x32, 0xcc, 0x4f, 0xb5, 0x8c, 0xf3, 0x01, 0x34, 0x3e, 0x83, 0xf1, 0xa5,
+	0xf6, 0x40, 0xaa, 0x68, 0x59, 0x68, 0xb3, 0x3a, 0x24, 0x2f, 0x52, 0x94, 0x6e, 0xab, 0xcd, 0xf7,
+	0x55, 0x45, 0x7f, 0xbc, 0xa9, 0xbd, 0x65, 0xd4, 0x17, 0xf8, 0xb8, 0x41, 0xb9, 0x9f, 0x21, 0x79,
+	0xd4, 0x78, 0x40, 0x12, 0x14, 0x3d, 0x69, 0x91, 0xe8, 0xf5, 0x8b, 0x3d, 0x60, 0x9b, 0xd3, 0x22,
+	0x91, 0x29, 0x1d, 0x66, 0x94, 0x1d, 0x6a, 0xcc, 0x03, 0x92, 0x5b, 0xaa, 0x6f, 0xc1, 0x35, 0xcc,
+	0x4f, 0x99, 0xba, 0x34, 0xa1, 0x52, 0x26, 0x2c, 0xae, 0x97, 0x6e, 0x40, 0x65, 0xff, 0xfa, 0x84,
+	0xb2, 0x2d, 0x1b, 0x60, 0x84, 0xfd, 0x69, 0x20, 0xec, 0x46, 0x81, 0xf3, 0x29, 0xa2, 0x69, 0x11,
+	0x04, 0x05, 0xa8, 0xea, 0x8b, 0x1e, 0xc6, 0x39, 0x8a, 0x94, 0x25, 0xc4, 0xbc, 0xdb, 0x4e, 0x89,
+	0x2e, 0xce, 0x5d, 0x98, 0xa9, 0x9e, 0x4d, 0x8d, 0x7c, 0xcf, 0x02, 0xb7, 0x34, 0xae, 0xaa, 0x0f,
+	0x32, 0xb0, 0x39, 0x41, 0x6a, 0xbe, 0xcd, 0x5d, 0x9c, 0x89, 0xf1, 0xea, 0x05, 0x46, 0x03, 0x0a,
+	0x63, 0x50, 0x1d, 0x88, 0x48, 0x44, 0x84, 0x52, 0x5d, 0x7b, 0xd1, 0xb4, 0xf2, 0x94, 0x42, 0xba,
+	0x05, 0xd6, 0x47, 0x03, 0x28, 0xdb, 0xac, 0xef, 0x41, 0x6d, 0xbc, 0x59, 0x23, 0x64, 0x31, 0x8a,
+	0x24, 0xcf, 0xdd, 0xa5, 0x99, 0xea, 0xbb, 0x31, 0xda, 0xc3, 0x21, 0xfd, 0x3d, 0x8d, 0x0d, 0x7f,
+	0x00, 0xf5, 0x01, 0xbd, 0xd1, 0x77, 0x92, 0x7f, 0x79, 0x26, 0xfe, 0xad, 0x02, 0xff, 0x50, 0xc1,
+	0x4f, 0x7c, 0x40, 0x00, 0x2e, 0x67, 0xa8, 0x17, 0x26, 0x39, 0x8a, 0x88, 0x7a, 0x16, 0x94, 0x63,
+	0x77, 0x65, 0x4a, 0x75, 0x2f, 0x65, 0xa8, 0xf7, 0xb1, 0x02, 0x38, 0xd0, 0xf9, 0xf0, 0x2b, 0x70,
+	0x65, 0x14, 0x2f, 0x64, 0x5c, 0xd2, 0x88, 0xb8, 0x60, 0x4a, 0xd8, 0xf5, 0x64, 0x88, 0xf9, 0x48,
+	0x43, 0xdc, 0xde, 0xfe, 0xf1, 0xfc, 0xf9, 0xcd, 0x1b, 0xa6, 0xc4, 0x3d, 0x81, 0x8f, 0xfd, 0xde,
+	0x70, 0x37, 0x98, 0xd1, 0xb0, 0xf3, 0x6b, 0x09, 0xac, 0x3d, 0x8e, 0x63, 0xc2, 0x22, 0x12, 0x90,
+	0x88, 0xe7, 0x18, 0x5e, 0x03, 0x65, 0x3b, 0x23, 0xcd, 0x78, 0xb0, 0x27, 0xf8, 0x01, 0x58, 0xd0,
+	0xf7, 0xb7, 0x34, 0xed, 0xbc, 0xd3, 0x69, 0x0a, 0x36, 0x27, 0x48, 0xd8, 0x67, 0xbd, 0x12, 0xd8,
+	0x13, 0xfc, 0x06, 0x5c, 0x1a, 0x7f, 0x29, 0x33, 0x3e, 0xc9, 0xb5, 0xb1, 0x07, 0x02, 0x1f, 0x82,
+	0xb5, 0xf1, 0xa1, 0xb2, 0x38, 0xa5, 0xac, 0x7a, 0xd8, 0x17, 0xce, 0x9d, 0x7f, 0x1c, 0xb0, 0xfe,
+	0x10, 0x51, 0x26, 0x09, 0x43, 0x2c, 0x22, 0xf6, 0x55, 0x3c, 0x02, 0xeb, 0x27, 0xc5, 0xc6, 0x0a,
+	0xff, 0x7b, 0x47, 0x0d, 0xb6, 0xda, 0xf8, 0x8e, 0xba, 0x7c, 0x72, 0xc1, 0x0e, 0xef, 0x03, 0x60,
+	0x96, 0xd5, 0xff, 0x13, 0x7c, 0x45, 0x27, 0x2b, 0x37, 0x6c, 0x81, 0x65, 0xc2, 0xb0, 0xc1, 0x99,
+	0x9f, 0x16, 0x67, 0x89, 0x30, 0xac, 0x9c, 0xcd, 0x3b, 0xcf, 0xfa, 0x9e, 0xf3, 0xb2, 0xef, 0x39,
+	0xaf, 0xfa, 0x9e, 0xf3, 0x57, 0xdf, 0x73, 0x9e, 0x9e, 0x79, 0x73, 0xaf, 0xce, 0xbc, 0xb9, 0xdf,
+	0xcf, 0xbc, 0xb9, 0xaf, 0xb7, 0xc6, 0x3a, 0x34, 0x72, 0xc5, 0xe4, 0x93, 0x0e, 0x11, 0xed, 0xb2,
+	0x26, 0x7a, 0xef, 0xdf, 0x01, 0x00, 0xb1, 0xdd, 0xdb, 0xd7, 0x9e, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -310,6 +507,87 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeEscalationWindow != that1.DowntimeEscalationWindow {
+		return false
+	}
+	if !this.DowntimeJailEscalationFactor.Equal(that1.DowntimeJailEscalationFactor) {
+		return false
+	}
+	if !this.DowntimeSlashEscalationFactor.Equal(that1.DowntimeSlashEscalationFactor) {
+		return false
+	}
+	if this.MaxGracePeriod != that1.MaxGracePeriod {
+		return false
+	}
+	if this.GracePeriodNotice != that1.GracePeriodNotice {
+		return false
+	}
+	return true
+}
+func (this *OffenceRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OffenceRecord)
+	if !ok {
+		that2, ok := that.(OffenceRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -395,6 +673,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriodNotice, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriodNotice):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxGracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DowntimeSlashEscalationFactor.Size()
+		i -= size
+		if _, err := m.DowntimeSlashEscalationFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailEscalationFactor.Size()
+		i -= size
+		if _, err := m.DowntimeJailEscalationFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeEscalationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +737,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -441,6 +763,113 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OffenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintSlashing(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintSlashing(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintSlashing(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -496,10 +925,59 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailEscalationFactor.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeSlashEscalationFactor.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxGracePeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriodNotice)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
-func sovSlashing(x uint64) (n int) {
+func (m *OffenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozSlashing(x uint64) (n int) {
@@ -877,6 +1355,519 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeEscalationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailEscalationFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailEscalationFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashEscalationFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeSlashEscalationFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriodNotice, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffenceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffenceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -12,18 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -201,47 +206,160 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgAnnounceMaintenance defines the Msg/AnnounceMaintenance request type.
+type MsgAnnounceMaintenance struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// start_time is the start of the maintenance window, at least grace_period_notice
+	// after the announcement.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration is the duration of the maintenance window, at most max_grace_period.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgAnnounceMaintenance) Reset()         { *m = MsgAnnounceMaintenance{} }
+func (m *MsgAnnounceMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgAnnounceMaintenance) ProtoMessage()    {}
+func (*MsgAnnounceMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgAnnounceMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnounceMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnounceMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnnounceMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnounceMaintenance.Merge(m, src)
+}
+func (m *MsgAnnounceMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnounceMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnounceMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnounceMaintenance proto.InternalMessageInfo
+
+func (m *MsgAnnounceMaintenance) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MsgAnnounceMaintenance) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgAnnounceMaintenance) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// MsgAnnounceMaintenanceResponse defines the Msg/AnnounceMaintenance response type.
+type MsgAnnounceMaintenanceResponse struct {
+}
+
+func (m *MsgAnnounceMaintenanceResponse) Reset()         { *m = MsgAnnounceMaintenanceResponse{} }
+func (m *MsgAnnounceMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnnounceMaintenanceResponse) ProtoMessage()    {}
+func (*MsgAnnounceMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnounceMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnounceMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnounceMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnounceMaintenanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAnnounceMaintenance)(nil), "cosmos.slashing.v1beta1.MsgAnnounceMaintenance")
+	proto.RegisterType((*MsgAnnounceMaintenanceResponse)(nil), "cosmos.slashing.v1beta1.MsgAnnounceMaintenanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xb7, 0x18, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xf2, 0xbe, 0xb4, 0x45, 0x97, 0x1f, 0xe1, 0x7c, 0x05,
-	0x03, 0x3d, 0x13, 0x73, 0x90, 0x2f, 0x11, 0xd6, 0x80, 0x3c, 0xa8, 0x8a, 0xe4, 0xc1, 0x0a, 0x44,
-	0x2c, 0xa3, 0x79, 0x44, 0x49, 0x8f, 0x4b, 0x1c, 0x4d, 0x08, 0xe6, 0x6f, 0x2b, 0x61, 0x2c, 0xf6,
-	0x18, 0x3d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xc6, 0xa5, 0x12,
-	0x4e, 0x2f, 0xc0, 0x83, 0x52, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0xb5, 0x42, 0xf5, 0x5c, 0x3c, 0x28,
-	0x41, 0xad, 0x81, 0x57, 0x2f, 0x92, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xa3, 0x56, 0xf8, 0x14,
-	0xa6, 0x17, 0xa5, 0x58, 0x1b, 0x40, 0xc1, 0xed, 0x64, 0xbd, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x41, 0x93, 0x66, 0x71, 0x4a, 0xb6, 0x5e, 0x66,
-	0x3e, 0x72, 0x00, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x53, 0xbd, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0x0b, 0x6f, 0x20, 0xb7, 0x03, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6b, 0x13, 0x4f,
+	0x1c, 0xce, 0xb6, 0xfc, 0xfb, 0x37, 0xa3, 0xb5, 0x74, 0x53, 0x6c, 0xba, 0xe0, 0x6e, 0xba, 0xa0,
+	0x94, 0x40, 0x66, 0xd3, 0x2a, 0x16, 0x23, 0x1e, 0x1a, 0x7a, 0x28, 0x48, 0x40, 0xe2, 0x0b, 0xe2,
+	0x25, 0x4c, 0xb2, 0xeb, 0x76, 0x6d, 0x76, 0x66, 0xd9, 0x99, 0x84, 0xf6, 0x54, 0xf1, 0x24, 0x9e,
+	0x7a, 0xf4, 0x28, 0x7a, 0xe9, 0x45, 0xc8, 0x21, 0x1f, 0xa2, 0x78, 0x2a, 0x39, 0x89, 0x87, 0x2a,
+	0xc9, 0x21, 0xe2, 0xa7, 0x90, 0xdd, 0x99, 0xdd, 0xbc, 0x34, 0x69, 0xf5, 0x92, 0xec, 0xcc, 0xef,
+	0x79, 0x9e, 0xdf, 0xdb, 0x33, 0x20, 0x53, 0x23, 0xd4, 0x25, 0xd4, 0xa0, 0x75, 0x44, 0x77, 0x1d,
+	0x6c, 0x1b, 0xcd, 0xf5, 0xaa, 0xc5, 0xd0, 0xba, 0xc1, 0xf6, 0xa1, 0xe7, 0x13, 0x46, 0xe4, 0x65,
+	0x8e, 0x80, 0x11, 0x02, 0x0a, 0x84, 0xb2, 0x64, 0x13, 0x9b, 0x84, 0x18, 0x23, 0xf8, 0xe2, 0x70,
+	0xe5, 0xf6, 0x34, 0xc1, 0x98, 0xcf, 0x71, 0x2b, 0x1c, 0x57, 0xe1, 0x02, 0x22, 0x07, 0x0f, 0x89,
+	0x8c, 0x86, 0x4b, 0x03, 0x76, 0xf0, 0x27, 0x02, 0x8b, 0xc8, 0x75, 0x30, 0x31, 0xc2, 0x5f, 0x71,
+	0xa5, 0xda, 0x84, 0xd8, 0x75, 0xcb, 0x08, 0x4f, 0xd5, 0xc6, 0x2b, 0xc3, 0x6c, 0xf8, 0x88, 0x39,
+	0x04, 0x8b, 0xb8, 0x36, 0x1e, 0x67, 0x8e, 0x6b, 0x51, 0x86, 0x5c, 0x8f, 0x03, 0xf4, 0xcf, 0x12,
+	0x48, 0x96, 0xa8, 0xfd, 0x0c, 0xbf, 0x46, 0x4e, 0x5d, 0x36, 0xc1, 0xf5, 0x26, 0xaa, 0x3b, 0x26,
+	0x62, 0xc4, 0xaf, 0x20, 0xd3, 0xf4, 0xd3, 0x52, 0x46, 0x5a, 0x4b, 0x16, 0x1f, 0xfe, 0x3e, 0xd3,
+	0xfe, 0x0f, 0xce, 0x16, 0xa5, 0x9d, 0x76, 0xee, 0xa6, 0xa8, 0xf7, 0x79, 0x84, 0xdd, 0xe2, 0xa1,
+	0x27, 0xcc, 0x77, 0xb0, 0xfd, 0xa9, 0xdf, 0xca, 0x46, 0xe0, 0xe3, 0x7e, 0x2b, 0x2b, 0x95, 0xe7,
+	0x9b, 0xc3, 0xc0, 0x42, 0xfe, 0xdd, 0x47, 0x2d, 0xf1, 0xb6, 0xdf, 0xca, 0x8e, 0x25, 0x7b, 0xdf,
+	0x6f, 0x65, 0x97, 0xb8, 0x74, 0x8e, 0x9a, 0x7b, 0x46, 0x5c, 0x97, 0x9e, 0x02, 0x8b, 0xf1, 0xa1,
+	0x6c, 0x51, 0x8f, 0x60, 0x6a, 0xe9, 0xdf, 0x25, 0xb0, 0x10, 0xdc, 0x7a, 0x26, 0x62, 0xd6, 0x63,
+	0xe4, 0x23, 0x97, 0xca, 0xf7, 0x40, 0x12, 0x35, 0xd8, 0x2e, 0xf1, 0x1d, 0x76, 0x20, 0x6a, 0x4f,
+	0x77, 0xda, 0x39, 0xa1, 0x0a, 0x47, 0xea, 0x2c, 0x0f, 0xa0, 0x72, 0x11, 0xcc, 0x79, 0xa1, 0x42,
+	0x7a, 0x26, 0x23, 0xad, 0x5d, 0xdd, 0xd0, 0xe0, 0x94, 0xb5, 0x43, 0x9e, 0xa8, 0x98, 0x3c, 0x39,
+	0xd3, 0x12, 0xbc, 0x3b, 0xc1, 0x2c, 0x3c, 0xea, 0xb4, 0x73, 0x0b, 0x83, 0xf2, 0x33, 0x79, 0x78,
+	0x77, 0x33, 0xe8, 0x72, 0x90, 0x26, 0x68, 0xf0, 0xd6, 0x50, 0x83, 0xfb, 0x03, 0x9b, 0x8c, 0x35,
+	0xa2, 0x43, 0xb0, 0x3c, 0x76, 0x15, 0xf5, 0x5d, 0x48, 0x4d, 0xc8, 0xa3, 0x7f, 0x99, 0x01, 0x37,
+	0x4a, 0xd4, 0xde, 0xc2, 0x98, 0x34, 0x70, 0xcd, 0x2a, 0x21, 0x07, 0x33, 0x0b, 0x23, 0x5c, 0xb3,
+	0xe4, 0x9d, 0x29, 0x4b, 0x5d, 0xbd, 0x74, 0x93, 0x63, 0x8b, 0x93, 0x77, 0x00, 0xa0, 0x0c, 0xf9,
+	0xac, 0x12, 0xb8, 0x48, 0x4c, 0x4a, 0x81, 0xdc, 0x62, 0x30, 0xb2, 0x18, 0x7c, 0x1a, 0x59, 0xac,
+	0x38, 0x1f, 0x0c, 0xe9, 0xe8, 0x87, 0x26, 0xf1, 0x41, 0x25, 0x43, 0x72, 0x10, 0x96, 0xb7, 0xc1,
+	0x95, 0xc8, 0xa9, 0xe9, 0xd9, 0x50, 0x67, 0xe5, 0x9c, 0xce, 0xb6, 0x00, 0x70, 0x99, 0x0f, 0xb1,
+	0x4c, 0xcc, 0x2c, 0xdc, 0x9f, 0x62, 0xa2, 0xd5, 0x51, 0x13, 0x4d, 0x18, 0x8a, 0x9e, 0x01, 0xea,
+	0xe4, 0x48, 0x34, 0xe6, 0x8d, 0x5f, 0x33, 0x60, 0xb6, 0x44, 0x6d, 0xf9, 0x05, 0x98, 0x13, 0xaf,
+	0x43, 0x9f, 0x6a, 0x8a, 0xd8, 0x9c, 0x4a, 0xf6, 0x72, 0x4c, 0x94, 0x41, 0x3e, 0x04, 0xd7, 0x46,
+	0xcc, 0xbb, 0x76, 0x21, 0x77, 0x08, 0xa9, 0xe4, 0xff, 0x16, 0x19, 0x3f, 0x96, 0xd4, 0xd7, 0xf3,
+	0xa6, 0x91, 0x0f, 0x41, 0x6a, 0x92, 0x61, 0x8c, 0x8b, 0xd4, 0x27, 0x10, 0x94, 0xcd, 0x7f, 0x24,
+	0x44, 0x55, 0x29, 0xff, 0xbd, 0x09, 0x36, 0x5a, 0x7c, 0x70, 0xdc, 0x55, 0xa5, 0x93, 0xae, 0x2a,
+	0x9d, 0x76, 0x55, 0xe9, 0x67, 0x57, 0x95, 0x8e, 0x7a, 0x6a, 0xe2, 0xb4, 0xa7, 0x26, 0xbe, 0xf5,
+	0xd4, 0xc4, 0x4b, 0xe1, 0x51, 0x6a, 0xee, 0x41, 0x87, 0x0c, 0xbf, 0x19, 0x76, 0xe0, 0x59, 0xb4,
+	0x3a, 0x17, 0x1a, 0xe6, 0xce, 0x9f, 0x01, 0x00, 0xff, 0xe0, 0xf2, 0x7d, 0xcb, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -337,6 +455,57 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgAnnounceMaintenance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgAnnounceMaintenance)
+	if !ok {
+		that2, ok := that.(MsgAnnounceMaintenance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (this *MsgAnnounceMaintenanceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgAnnounceMaintenanceResponse)
+	if !ok {
+		that2, ok := that.(MsgAnnounceMaintenanceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -357,6 +526,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AnnounceMaintenance defines a method for a validator to announce a one-time
+	// maintenance window, during which its missed blocks are not counted against
+	// its liveness.
+	AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error)
 }
 
 type msgClient struct {
@@ -385,6 +558,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error) {
+	out := new(MsgAnnounceMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/AnnounceMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -394,6 +576,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AnnounceMaintenance defines a method for a validator to announce a one-time
+	// maintenance window, during which its missed blocks are not counted against
+	// its liveness.
+	AnnounceMaintenance(context.Context, *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,6 +592,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) AnnounceMaintenance(ctx context.Context, req *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceMaintenance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -447,6 +636,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AnnounceMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnounceMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/AnnounceMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, req.(*MsgAnnounceMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
@@ -460,6 +667,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AnnounceMaintenance",
+			Handler:    _Msg_AnnounceMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -581,6 +792,75 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAnnounceMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnounceMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -638,6 +918,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAnnounceMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAnnounceMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -941,6 +1247,204 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAnnounceMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnounceMaintenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -54,9 +55,47 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.NextTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateCommissionSchedules(data.CommissionSchedules); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, nextID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		if record.Id >= nextID {
+			return fmt.Errorf("tokenize share record id %d must be lower than the next record id %d", record.Id, nextID)
+		}
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateCommissionSchedules(schedules []types.CommissionSchedule) error {
+	validators := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		if validators[schedule.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission schedule in genesis state: validator %s", schedule.ValidatorAddress)
+		}
+		if schedule.Rate.IsNil() || schedule.Rate.IsNegative() || schedule.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid commission schedule rate of validator %s: %s", schedule.ValidatorAddress, schedule.Rate)
+		}
+		validators[schedule.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -41,6 +41,19 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate tokenize share records
+		{"tokenize share records", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 0}, {Id: 1}}
+			data.NextTokenizeShareRecordId = 2
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 0}, {Id: 0}}
+			data.NextTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id not lower than next id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 1}}
+			data.NextTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
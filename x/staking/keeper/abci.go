@@ -14,5 +14,9 @@ func (k *Keeper) EndBlocker(ctx context.Context) ([]appmodule.ValidatorUpdate, e
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
 
+	if err := k.ApplyMatureCommissionSchedules(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
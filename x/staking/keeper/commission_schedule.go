@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleCommissionChange announces a commission rate of a validator applied at
+// effectiveTime, replacing any pending schedule. The effective time must be at
+// least MinCommissionScheduleNotice after the current block time, and the rate may
+// differ from the current rate by at most MaxChangeRate per whole day of notice.
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, valAddr sdk.ValAddress, rate math.LegacyDec, effectiveTime time.Time) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+	if !effectiveTime.After(blockTime) || effectiveTime.Before(blockTime.Add(params.MinCommissionScheduleNotice)) {
+		return types.ErrCommissionScheduleNotice.Wrapf("minimum notice is %s", params.MinCommissionScheduleNotice)
+	}
+
+	if err := validateScheduledRate(validator.Commission, rate, params.MinCommissionRate, effectiveTime.Sub(blockTime)); err != nil {
+		return err
+	}
+
+	if err := k.removeCommissionSchedule(ctx, valAddr); err != nil {
+		return err
+	}
+
+	schedule := types.CommissionSchedule{
+		ValidatorAddress: validator.OperatorAddress,
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+		AnnounceTime:     blockTime,
+	}
+	if err := k.setCommissionSchedule(ctx, valAddr, schedule); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleCommission,
+		event.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, rate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.Format(time.RFC3339)),
+	)
+}
+
+// validateScheduledRate validates a scheduled rate against the commission of the
+// validator, the change ramp allowed is MaxChangeRate per whole day of notice.
+func validateScheduledRate(commission types.Commission, rate, minRate math.LegacyDec, notice time.Duration) error {
+	switch {
+	case rate.IsNegative():
+		return types.ErrCommissionNegative
+	case rate.GT(commission.MaxRate):
+		return types.ErrCommissionGTMaxRate
+	case rate.LT(minRate):
+		return types.ErrCommissionLTMinRate
+	}
+
+	// a notice shorter than a day allows no change, so that the rate can't change
+	// by more than MaxChangeRate within 24h together with an edit of the commission
+	days := int64(notice / (24 * time.Hour))
+	if rate.Sub(commission.Rate).Abs().GT(commission.MaxChangeRate.MulInt64(days)) {
+		return types.ErrCommissionGTMaxChangeRate.Wrapf("at most %s over %d day(s) of notice", commission.MaxChangeRate.MulInt64(days), days)
+	}
+
+	return nil
+}
+
+// GetCommissionSchedule returns the pending commission schedule of a validator.
+func (k Keeper) GetCommissionSchedule(ctx context.Context, valAddr sdk.ValAddress) (types.CommissionSchedule, error) {
+	schedule, err := k.CommissionSchedules.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CommissionSchedule{}, types.ErrCommissionScheduleNotFound
+	}
+	return schedule, err
+}
+
+// GetAllCommissionSchedules returns all the pending commission schedules.
+func (k Keeper) GetAllCommissionSchedules(ctx context.Context) ([]types.CommissionSchedule, error) {
+	iter, err := k.CommissionSchedules.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// setCommissionSchedule stores a commission schedule and queues it by effective time.
+func (k Keeper) setCommissionSchedule(ctx context.Context, valAddr sdk.ValAddress, schedule types.CommissionSchedule) error {
+	if err := k.CommissionSchedules.Set(ctx, valAddr, schedule); err != nil {
+		return err
+	}
+	return k.CommissionScheduleQueue.Set(ctx, collections.Join(schedule.EffectiveTime, valAddr))
+}
+
+// removeCommissionSchedule removes the pending commission schedule of a validator, if any.
+func (k Keeper) removeCommissionSchedule(ctx context.Context, valAddr sdk.ValAddress) error {
+	schedule, err := k.CommissionSchedules.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.CommissionScheduleQueue.Remove(ctx, collections.Join(schedule.EffectiveTime, valAddr)); err != nil {
+		return err
+	}
+	return k.CommissionSchedules.Remove(ctx, valAddr)
+}
+
+// ApplyMatureCommissionSchedules applies the commission schedules whose effective
+// time has been reached. A schedule whose rate is no longer valid against the
+// current commission and minimum commission rate, e.g. because the rate has
+// fallen below the minimum or the commission changed since the announcement, is
+// dropped and a failure event is emitted.
+func (k Keeper) ApplyMatureCommissionSchedules(ctx context.Context) error {
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+
+	rng := collections.NewPrefixUntilPairRange[time.Time, sdk.ValAddress](blockTime)
+	keys, err := k.CommissionScheduleQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	mature, err := keys.Keys()
+	if err != nil {
+		return err
+	}
+
+	minRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range mature {
+		valAddr := key.K2()
+		schedule, err := k.CommissionSchedules.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.removeCommissionSchedule(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		// the commission may have changed since the announcement, so the rate is
+		// checked again against the current commission, the ramp being counted
+		// from the later of the announcement and the last commission update
+		since := schedule.AnnounceTime
+		if validator.Commission.UpdateTime.After(since) {
+			since = validator.Commission.UpdateTime
+		}
+		if err := validateScheduledRate(validator.Commission, schedule.Rate, minRate, blockTime.Sub(since)); err != nil {
+			if err := k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeCommissionScheduleFailed,
+				event.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+				event.NewAttribute(types.AttributeKeyCommissionRate, schedule.Rate.String()),
+				event.NewAttribute(types.AttributeKeyError, err.Error()),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = schedule.Rate
+		validator.Commission.UpdateTime = blockTime
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeApplyCommissionSchedule,
+			event.NewAttribute(types.AttributeKeyValidator, schedule.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, schedule.Rate.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	blockTime := ctx.HeaderInfo().Time
+	week := blockTime.Add(stakingtypes.DefaultMinCommissionScheduleNotice)
+
+	// the effective time must meet the minimum notice
+	err := keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(2, 1), week.Add(-time.Second))
+	require.ErrorIs(err, stakingtypes.ErrCommissionScheduleNotice)
+
+	// the rate is bounded by the max rate
+	err = keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(6, 1), week.Add(30*24*time.Hour))
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxRate)
+
+	// the rate can change by at most the max change rate per day of notice
+	err = keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(5, 1), week)
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+	require.NoError(keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(5, 1), week.Add(24*time.Hour)))
+
+	// a new schedule replaces the pending one
+	require.NoError(keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(3, 1), week))
+	schedule, err := keeper.GetCommissionSchedule(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(3, 1), schedule.Rate)
+	require.Equal(week, schedule.EffectiveTime)
+	require.Equal(blockTime, schedule.AnnounceTime)
+
+	res, err := s.queryClient.CommissionSchedules(ctx, &stakingtypes.QueryCommissionSchedulesRequest{})
+	require.NoError(err)
+	require.Len(res.Schedules, 1)
+
+	// the commission can not be edited while a schedule is pending
+	rate := math.LegacyNewDecWithPrec(2, 1)
+	_, err = s.msgServer.EditValidator(ctx, &stakingtypes.MsgEditValidator{
+		ValidatorAddress: validator.OperatorAddress,
+		Description:      stakingtypes.Description{Moniker: "moniker"},
+		CommissionRate:   &rate,
+	})
+	require.ErrorIs(err, stakingtypes.ErrCommissionSchedulePending)
+
+	// the schedule is not applied before its effective time
+	require.NoError(keeper.ApplyMatureCommissionSchedules(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: week})
+	require.NoError(keeper.ApplyMatureCommissionSchedules(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(3, 1), validator.Commission.Rate)
+	require.Equal(week, validator.Commission.UpdateTime)
+
+	_, err = keeper.GetCommissionSchedule(ctx, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrCommissionScheduleNotFound)
+}
+
+func (s *KeeperTestSuite) TestCommissionScheduleBelowMinRate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	effectiveTime := ctx.HeaderInfo().Time.Add(stakingtypes.DefaultMinCommissionScheduleNotice)
+	require.NoError(keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(5, 2), effectiveTime))
+
+	// the min commission rate is raised above the scheduled rate, the schedule is dropped
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: effectiveTime})
+	require.NoError(keeper.ApplyMatureCommissionSchedules(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	_, err = keeper.GetCommissionSchedule(ctx, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrCommissionScheduleNotFound)
+}
+
+func (s *KeeperTestSuite) TestCommissionScheduleAfterCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	effectiveTime := ctx.HeaderInfo().Time.Add(stakingtypes.DefaultMinCommissionScheduleNotice)
+	require.NoError(keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(3, 1), effectiveTime))
+
+	// the commission is changed one day before the effective time, the combined
+	// change would exceed the max change rate so the schedule is dropped
+	validator.Commission.Rate = math.LegacyNewDecWithPrec(5, 2)
+	validator.Commission.UpdateTime = effectiveTime.Add(-24 * time.Hour)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: effectiveTime})
+	require.NoError(keeper.ApplyMatureCommissionSchedules(ctx))
+	validator, err := keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(5, 2), validator.Commission.Rate)
+
+	_, err = keeper.GetCommissionSchedule(ctx, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrCommissionScheduleNotFound)
+}
+
+func (s *KeeperTestSuite) TestCommissionScheduleAfterEditValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// params stored before commission schedules were added are migrated to the default notice
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinCommissionScheduleNotice = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate6to7(ctx))
+	params, err = keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.DefaultMinCommissionScheduleNotice, params.MinCommissionScheduleNotice)
+
+	params.MinCommissionScheduleNotice = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	// the commission is edited by the max change rate
+	rate := math.LegacyNewDecWithPrec(15, 2)
+	_, err = s.msgServer.EditValidator(ctx, &stakingtypes.MsgEditValidator{
+		ValidatorAddress: validator.OperatorAddress,
+		Description:      stakingtypes.Description{Moniker: "moniker"},
+		CommissionRate:   &rate,
+	})
+	require.NoError(err)
+
+	// a schedule maturing within a day can not change the rate again
+	blockTime := ctx.HeaderInfo().Time
+	err = keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(2, 1), blockTime.Add(10*time.Minute))
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+	require.NoError(keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(2, 1), blockTime.Add(24*time.Hour)))
+}
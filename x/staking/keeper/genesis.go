@@ -224,6 +224,39 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.setTokenizeShareRecord(ctx, record); err != nil {
+			return nil, err
+		}
+
+		// the liquid shares of the validators are the shares delegated by the record accounts
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+		delegation, err := k.Delegations.Get(ctx, collections.Join(types.TokenizeShareRecordAddress(record.Id), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return nil, fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+		}
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.TokenizeShareRecordID.Set(ctx, data.NextTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
+	for _, schedule := range data.CommissionSchedules {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(schedule.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.setCommissionSchedule(ctx, valAddr, schedule); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -393,6 +426,21 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, fmt.Errorf("old to new cons addrs: %w", err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTokenizeShareRecordID, err := k.TokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionSchedules, err := k.GetAllCommissionSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -407,5 +455,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationQueue:        rotationQueue,
 		RotatedConsAddresses: rotatedConsAddrs,
 		InitialConsAddresses: initConsAddrs,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		NextTokenizeShareRecordId: nextTokenizeShareRecordID,
+
+		CommissionSchedules: commissionSchedules,
 	}, nil
 }
@@ -661,3 +661,95 @@ func redelegationsToRedelegationResponses(ctx context.Context, k *Keeper, redels
 
 	return resp, nil
 }
+
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsByOwner queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsByOwner(ctx context.Context, req *types.QueryTokenizeShareRecordsByOwnerRequest) (*types.QueryTokenizeShareRecordsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.TokenizeShareRecordsByOwner, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.TokenizeShareRecord, error) {
+			return k.Keeper.TokenizeShareRecords.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the amount of bonded tokens tokenized into liquid staking share denoms
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.TotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// CommissionSchedule queries the pending commission schedule of a validator
+func (k Querier) CommissionSchedule(ctx context.Context, req *types.QueryCommissionScheduleRequest) (*types.QueryCommissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.GetCommissionSchedule(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryCommissionScheduleResponse{Schedule: schedule}, nil
+}
+
+// CommissionSchedules queries all the pending commission schedules
+func (k Querier) CommissionSchedules(ctx context.Context, req *types.QueryCommissionSchedulesRequest) (*types.QueryCommissionSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedules, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.CommissionSchedules, req.Pagination,
+		func(_ sdk.ValAddress, schedule types.CommissionSchedule) (types.CommissionSchedule, error) {
+			return schedule, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommissionSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -107,6 +107,18 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecordID key: TokenizeShareRecordIDKey | value: next tokenize share record id
+	TokenizeShareRecordID collections.Sequence
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// TokenizeShareRecordsByOwner key: owner+recordID | value: none used (index key for tokenize share records by owner)
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator tokenized into share denoms
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
+	// CommissionSchedules key: valAddr | value: pending CommissionSchedule of the validator
+	CommissionSchedules collections.Map[sdk.ValAddress, types.CommissionSchedule]
+	// CommissionScheduleQueue key: effectiveTime+valAddr | value: none used (index key for commission schedules by effective time)
+	CommissionScheduleQueue collections.KeySet[collections.Pair[time.Time, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -321,6 +333,37 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+		TokenizeShareRecordID: collections.NewSequence(sb, types.TokenizeShareRecordIDKey, "tokenize_share_record_id"),
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordsKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+		TokenizeShareRecordsByOwner: collections.NewKeySet(
+			sb, types.TokenizeShareRecordsByOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+		// key format is: 125 | valAddr
+		CommissionSchedules: collections.NewMap(
+			sb, types.CommissionSchedulesKey,
+			"commission_schedules",
+			sdk.ValAddressKey,
+			codec.CollValue[types.CommissionSchedule](cdc),
+		),
+		// key format is: 126 | time | valAddr
+		CommissionScheduleQueue: collections.NewKeySet(
+			sb, types.CommissionScheduleQueueKey,
+			"commission_schedule_queue",
+			collections.PairKeyCodec(sdk.TimeKey, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,369 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vestingAccount is the subset of the vesting account interface needed to prevent
+// the tokenization or the transfer of vesting delegations.
+type vestingAccount interface {
+	GetDelegatedFree() sdk.Coins
+}
+
+// TokenizeShares converts the shares of a delegation worth amount tokens into a
+// tokenize share record. The shares are delegated by the record account and the
+// delegator receives the {validator}/{id} share denom in exchange, one token per
+// share. The rewards of the tokenized delegation go to the owner of the record.
+// It returns the minted share tokens and the id of the created record.
+func (k Keeper) TokenizeShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress) (sdk.Coin, uint64, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	// vesting delegations can not be tokenized, the share tokens would be transferable.
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingAccount); ok {
+		if free := acc.GetDelegatedFree().AmountOf(bondDenom); amount.GT(free) {
+			return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrTokenizeVestingShares, "amount %s is greater than the delegated free amount %s", amount, free)
+		}
+	}
+
+	// redelegated shares must remain slashable from the delegation they were redelegated
+	// to, the record account is out of reach of SlashRedelegation.
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if hasRedelegation {
+		return sdk.Coin{}, 0, types.ErrTokenizeRedelegatedShares
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	id, err := k.TokenizeShareRecordID.Next(ctx)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	recordAddr := types.TokenizeShareRecordAddress(id)
+
+	tokens, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	// the tokens stay in the pool of the validator status, no coins are moved.
+	newShares, err := k.Delegate(ctx, recordAddr, tokens, validator.Status, validator, false)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	recordAddrStr, err := k.authKeeper.AddressCodec().BytesToString(recordAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	record := types.NewTokenizeShareRecord(id, ownerStr, recordAddrStr, validator.GetOperator())
+	if err := k.setTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is too small to be tokenized")
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	return shareTokens, id, k.emitTokenizeSharesEvent(ctx, types.EventTypeTokenizeShares, delAddrStr, record, shareTokens)
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record and delegates
+// back the corresponding shares from the record account to the redeemer. The record
+// is removed once all its share tokens are redeemed, the rewards left in the record
+// account are then sent to the record owner. It returns the tokens delegated to the
+// redeemer.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	id, err := types.ParseShareTokenDenom(shareTokens.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if shareTokens.Denom != record.GetShareTokenDenom() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNotTokenizeShareDenom, "denom %s does not match record %d denom", shareTokens.Denom, id)
+	}
+
+	if balance := k.bankKeeper.GetBalance(ctx, delAddr, shareTokens.Denom); balance.IsLT(shareTokens) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "balance %s is smaller than %s", balance, shareTokens)
+	}
+
+	recordAddr := types.TokenizeShareRecordAddress(id)
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// redeeming the whole supply also redeems the fractional shares left by the
+	// truncation of the minted share tokens.
+	shares := math.LegacyNewDecFromInt(shareTokens.Amount)
+	redeemAll := k.bankKeeper.GetSupply(ctx, shareTokens.Denom).IsEqual(shareTokens)
+	if redeemAll || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokens, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.subValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if _, err := k.Delegate(ctx, delAddr, tokens, validator.Status, validator, false); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if shares.Equal(delegation.Shares) {
+		// the rewards withdrawn when unbonding are held by the record account.
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+		if err := k.deleteTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	redeemed := sdk.NewCoin(bondDenom, tokens)
+	return redeemed, k.emitTokenizeSharesEvent(ctx, types.EventTypeRedeemShares, delAddrStr, record, redeemed)
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+	return record, err
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	var records []types.TokenizeShareRecord
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.TokenizeShareRecordsByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (stop bool, err error) {
+		record, err := k.TokenizeShareRecords.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		records = append(records, record)
+		return false, nil
+	})
+	return records, err
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	iter, err := k.TokenizeShareRecords.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator tokenized
+// into share denoms.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// TotalLiquidStakedTokens returns the amount of tokens delegated through tokenize
+// share records.
+func (k Keeper) TotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+		total = total.Add(validator.TokensFromShares(shares))
+		return false, nil
+	})
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	return total.TruncateInt(), nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of the validator would
+// exceed the validator or global liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if valCap := params.ValidatorLiquidStakingCap; !valCap.IsNil() && valCap.LT(math.LegacyOneDec()) {
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if liquidShares.Add(shares).GT(validator.DelegatorShares.Mul(valCap)) {
+			return errorsmod.Wrapf(types.ErrLiquidStakingCapExceeded, "validator liquid staking cap %s", valCap)
+		}
+	}
+
+	if globalCap := params.GlobalLiquidStakingCap; !globalCap.IsNil() && globalCap.LT(math.LegacyOneDec()) {
+		liquidTokens, err := k.TotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+		bondedTokens, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+		tokens := validator.TokensFromShares(shares)
+		if tokens.Add(math.LegacyNewDecFromInt(liquidTokens)).GT(math.LegacyNewDecFromInt(bondedTokens).Mul(globalCap)) {
+			return errorsmod.Wrapf(types.ErrLiquidStakingCapExceeded, "global liquid staking cap %s", globalCap)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares.Add(shares))
+}
+
+func (k Keeper) subValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	liquidShares = liquidShares.Sub(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// setTokenizeShareRecord stores a tokenize share record along with its owner index entry.
+func (k Keeper) setTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+	return k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), record.Id))
+}
+
+// deleteTokenizeShareRecord removes a tokenize share record along with its owner index entry.
+func (k Keeper) deleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+		return err
+	}
+	return k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id))
+}
+
+func (k Keeper) emitTokenizeSharesEvent(ctx context.Context, eventType, delegator string, record types.TokenizeShareRecord, amount sdk.Coin) error {
+	return k.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, record.Validator),
+		event.NewAttribute(types.AttributeKeyShareOwner, record.Owner),
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
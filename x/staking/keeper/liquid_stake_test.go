@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// setupLiquidStakingValidator creates a bonded validator with a delegation of
+// delAddr, it returns the delegated tokens.
+func (s *KeeperTestSuite) setupLiquidStakingValidator(delAddr sdk.AccAddress, valAddr sdk.ValAddress) math.Int {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delegation := stakingtypes.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddr), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	return startTokens
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	delAddr, owner, valAddr := addrs[0], addrs[1], valAddrs[0]
+	startTokens := s.setupLiquidStakingValidator(delAddr, valAddr)
+	tokenized := startTokens.QuoRaw(2)
+
+	denom := s.valAddressToString(valAddr) + "/0"
+	shareTokens := sdk.NewCoin(denom, tokenized)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr))
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, sdk.NewCoins(shareTokens)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, sdk.NewCoins(shareTokens)).Return(nil)
+
+	minted, id, err := keeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, owner)
+	require.NoError(err)
+	require.Equal(shareTokens, minted)
+	require.Equal(uint64(0), id)
+
+	recordAddr := stakingtypes.TokenizeShareRecordAddress(id)
+	record, err := keeper.GetTokenizeShareRecord(ctx, id)
+	require.NoError(err)
+	require.Equal(stakingtypes.NewTokenizeShareRecord(id, s.addressToString(owner), s.addressToString(recordAddr), s.valAddressToString(valAddr)), record)
+	records, err := keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, records)
+
+	// the tokenized shares moved from the delegator to the record account
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(startTokens.Sub(tokenized)), delegation.Shares)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), delegation.Shares)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), liquidShares)
+	liquidTokens, err := keeper.TotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokenized, liquidTokens)
+
+	// redeem half of the share tokens
+	redeemer := addrs[1]
+	half := sdk.NewCoin(denom, tokenized.QuoRaw(2))
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), redeemer, denom).Return(shareTokens)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(shareTokens)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(redeemer), sdk.NewCoins(half)).Return(nil)
+
+	redeemed, err := keeper.RedeemTokensForShares(ctx, redeemer, half)
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, half.Amount), redeemed)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(redeemer, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(half.Amount), delegation.Shares)
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized.Sub(half.Amount)), liquidShares)
+
+	// redeeming the remaining supply removes the record and sends the rewards to the owner
+	rest := sdk.NewCoin(denom, tokenized.Sub(half.Amount))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), redeemer, denom).Return(rest)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(rest)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(redeemer), sdk.NewCoins(rest)).Return(nil)
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, rewards).Return(nil)
+
+	_, err = keeper.RedeemTokensForShares(ctx, redeemer, rest)
+	require.NoError(err)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, id)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+	records, err = keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Empty(records)
+	_, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, valAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+
+	// the share denom of a removed record can not be redeemed
+	_, err = keeper.RedeemTokensForShares(ctx, redeemer, rest)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+	_, err = keeper.RedeemTokensForShares(ctx, redeemer, sdk.NewCoin(sdk.DefaultBondDenom, tokenized))
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareDenom)
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesLimits() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	delAddr, valAddr := addrs[0], valAddrs[0]
+	startTokens := s.setupLiquidStakingValidator(delAddr, valAddr)
+	tokenized := startTokens.QuoRaw(5)
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	// validator cap
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, delAddr)
+	require.ErrorIs(err, stakingtypes.ErrLiquidStakingCapExceeded)
+
+	// global cap
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, startTokens))
+
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, delAddr)
+	require.ErrorIs(err, stakingtypes.ErrLiquidStakingCapExceeded)
+
+	// vesting delegations
+	params.GlobalLiquidStakingCap = math.LegacyOneDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+	vestingAddr := addrs[1]
+	vestingAcc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)), ctx.HeaderInfo().Time.Unix()+1000)
+	require.NoError(err)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(vestingAddr), s.valAddressToString(valAddr), math.LegacyNewDecFromInt(tokenized))))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), vestingAddr).Return(vestingAcc)
+
+	_, _, err = keeper.TokenizeShares(ctx, vestingAddr, valAddr, tokenized, vestingAddr)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeVestingShares)
+
+	// shares redelegated to the validator
+	red := stakingtypes.NewRedelegation(delAddr, valAddrs[1], valAddr, 0, ctx.HeaderInfo().Time.Add(time.Hour), tokenized, math.LegacyNewDecFromInt(tokenized), address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+	require.NoError(keeper.SetRedelegation(ctx, red))
+
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddr, tokenized, delAddr)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeRedelegatedShares)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferDelegation moves the shares of a delegation worth amount tokens to the
+// delegation of the recipient with the same validator. The shares stay bonded, no
+// tokens are moved and the validator power is unchanged. The delegation hooks are
+// called for both delegations so that their rewards are settled before the transfer.
+// It returns the transferred shares.
+func (k Keeper) TransferDelegation(ctx context.Context, delAddr, recipient sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (math.LegacyDec, error) {
+	if delAddr.Equals(recipient) {
+		return math.LegacyDec{}, types.ErrTransferToSelf
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// vesting delegations can not be transferred, the vesting schedule stays with the account.
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingAccount); ok {
+		if free := acc.GetDelegatedFree().AmountOf(bondDenom); amount.GT(free) {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrTransferVestingShares, "amount %s is greater than the delegated free amount %s", amount, free)
+		}
+	}
+
+	// redelegated shares must remain slashable from the delegation they were redelegated to.
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if hasRedelegation {
+		return math.LegacyDec{}, types.ErrTransferRedelegatedShares
+	}
+
+	if err := k.removeDelegationShares(ctx, delAddr, validator, shares); err != nil {
+		return math.LegacyDec{}, err
+	}
+	if err := k.addDelegationShares(ctx, recipient, validator, shares); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	recipientStr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return shares, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferDelegation,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		event.NewAttribute(types.AttributeKeyNewShares, shares.String()),
+	)
+}
+
+// removeDelegationShares subtracts shares from a delegation without changing the
+// validator tokens, removing the delegation if no shares are left. As in Unbond, the
+// validator is jailed if its self-delegation drops below its minimum.
+func (k Keeper) removeDelegationShares(ctx context.Context, delAddr sdk.AccAddress, validator types.Validator, shares math.LegacyDec) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+	delegation.Shares = delegation.Shares.Sub(shares)
+
+	if bytes.Equal(delAddr, valAddr) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		if err := k.jailValidator(ctx, validator); err != nil {
+			return fmt.Errorf("failed to jail validator: %w", err)
+		}
+	}
+
+	if delegation.Shares.IsZero() {
+		return k.RemoveDelegation(ctx, delegation)
+	}
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		return err
+	}
+	return k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+}
+
+// addDelegationShares adds shares to a delegation without changing the validator
+// tokens, creating the delegation if it doesn't exist.
+func (k Keeper) addDelegationShares(ctx context.Context, delAddr sdk.AccAddress, validator types.Validator, shares math.LegacyDec) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+	switch {
+	case err == nil:
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	case errors.Is(err, collections.ErrNotFound):
+		delAddrStr, err1 := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err1 != nil {
+			return err1
+		}
+		delegation = types.NewDelegation(delAddrStr, validator.GetOperator(), math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		return err
+	}
+	return k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *KeeperTestSuite) TestTransferDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	delAddr, recipient, valAddr := addrs[0], addrs[1], valAddrs[0]
+	startTokens := s.setupLiquidStakingValidator(delAddr, valAddr)
+	transferred := startTokens.QuoRaw(4)
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	_, err := keeper.TransferDelegation(ctx, delAddr, delAddr, valAddr, transferred)
+	require.ErrorIs(err, stakingtypes.ErrTransferToSelf)
+
+	// transfer part of the delegation, the recipient delegation is created
+	shares, err := keeper.TransferDelegation(ctx, delAddr, recipient, valAddr, transferred)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(transferred), shares)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(startTokens.Sub(transferred)), delegation.Shares)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recipient, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(transferred), delegation.Shares)
+
+	// the validator tokens and shares are unchanged
+	validator, err := keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(startTokens, validator.Tokens)
+	require.Equal(math.LegacyNewDecFromInt(startTokens), validator.DelegatorShares)
+
+	// transferring the rest of the delegation removes it
+	_, err = keeper.TransferDelegation(ctx, delAddr, recipient, valAddr, startTokens)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = keeper.TransferDelegation(ctx, delAddr, recipient, valAddr, startTokens.Sub(transferred))
+	require.NoError(err)
+
+	_, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recipient, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(startTokens), delegation.Shares)
+}
+
+func (s *KeeperTestSuite) TestTransferDelegationLimits() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, recipient, valAddr := addrs[0], addrs[1], valAddrs[0]
+	startTokens := s.setupLiquidStakingValidator(delAddr, valAddr)
+
+	// shares redelegated to the validator can not be transferred
+	red := stakingtypes.NewRedelegation(delAddr, valAddrs[1], valAddr, 0, ctx.HeaderInfo().Time.Add(time.Hour), startTokens, math.LegacyNewDecFromInt(startTokens), address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+	require.NoError(keeper.SetRedelegation(ctx, red))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr))
+
+	_, err := keeper.TransferDelegation(ctx, delAddr, recipient, valAddr, startTokens)
+	require.ErrorIs(err, stakingtypes.ErrTransferRedelegatedShares)
+
+	// vesting delegations can not be transferred
+	vestingAddr := addrs[2]
+	vestingAcc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(vestingAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)), ctx.HeaderInfo().Time.Unix()+1000)
+	require.NoError(err)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(vestingAddr), s.valAddressToString(valAddr), math.LegacyNewDecFromInt(startTokens))))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), vestingAddr).Return(vestingAcc)
+
+	_, err = keeper.TransferDelegation(ctx, vestingAddr, recipient, valAddr, startTokens)
+	require.ErrorIs(err, stakingtypes.ErrTransferVestingShares)
+}
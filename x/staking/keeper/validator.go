@@ -249,6 +249,10 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err := k.removeCommissionSchedule(ctx, address); err != nil {
+		return err
+	}
+
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, str); err != nil {
 		return fmt.Errorf("error in after validator removed hook: %w", err)
 	}
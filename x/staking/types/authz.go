@@ -98,6 +98,9 @@ func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Acce
 	case *MsgCancelUnbondingDelegation:
 		validatorAddress = msg.ValidatorAddress
 		amount = msg.Amount
+	case *MsgTransferDelegation:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
@@ -219,6 +222,8 @@ func normalizeAuthzType(authzType AuthorizationType) (string, error) {
 		return sdk.MsgTypeURL(&MsgBeginRedelegate{}), nil
 	case AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION:
 		return sdk.MsgTypeURL(&MsgCancelUnbondingDelegation{}), nil
+	case AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION:
+		return sdk.MsgTypeURL(&MsgTransferDelegation{}), nil
 	default:
 		return "", errorsmod.Wrapf(errors.New("unknown authorization type"),
 			"cannot normalize authz type with %T", authzType)
@@ -42,6 +42,8 @@ const (
 	AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE AuthorizationType = 3
 	// AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION defines an authorization type for Msg/MsgCancelUnbondingDelegation
 	AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION AuthorizationType = 4
+	// AUTHORIZATION_TYPE_TRANSFER_DELEGATION defines an authorization type for Msg/TransferDelegation
+	AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION AuthorizationType = 5
 )
 
 var AuthorizationType_name = map[int32]string{
@@ -50,6 +52,7 @@ var AuthorizationType_name = map[int32]string{
 	2: "AUTHORIZATION_TYPE_UNDELEGATE",
 	3: "AUTHORIZATION_TYPE_REDELEGATE",
 	4: "AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION",
+	5: "AUTHORIZATION_TYPE_TRANSFER_DELEGATION",
 }
 
 var AuthorizationType_value = map[string]int32{
@@ -58,6 +61,7 @@ var AuthorizationType_value = map[string]int32{
 	"AUTHORIZATION_TYPE_UNDELEGATE":                  2,
 	"AUTHORIZATION_TYPE_REDELEGATE":                  3,
 	"AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION": 4,
+	"AUTHORIZATION_TYPE_TRANSFER_DELEGATION":         5,
 }
 
 func (x AuthorizationType) String() string {
@@ -231,43 +235,44 @@ func init() {
 }
 
 var fileDescriptor_d6d8cdbc6f4432f0 = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc0, 0x9b, 0x6d, 0xff, 0x3f, 0xd4, 0x20, 0xd8, 0xac, 0x09, 0x75, 0x83, 0x65, 0x63, 0x07,
-	0x56, 0x3a, 0xea, 0xb0, 0x0e, 0x38, 0x70, 0x22, 0x69, 0xc3, 0x16, 0xa9, 0x4a, 0xa7, 0x34, 0x45,
-	0x30, 0x0e, 0x91, 0xdb, 0x44, 0xad, 0xd5, 0x36, 0xae, 0x6a, 0x77, 0xb4, 0xbb, 0x21, 0x71, 0x42,
-	0x42, 0xe2, 0x73, 0x70, 0x9a, 0x50, 0x3f, 0x04, 0xe2, 0x34, 0xf5, 0xc4, 0x0d, 0xd4, 0x1e, 0xf6,
-	0x35, 0x50, 0x12, 0xb7, 0x1b, 0xb4, 0xdb, 0x85, 0x4b, 0xe2, 0xf8, 0xfd, 0xf2, 0xde, 0xcf, 0x2f,
-	0x76, 0xc0, 0x66, 0x85, 0xb2, 0x26, 0x65, 0x0a, 0xe3, 0xb8, 0x4e, 0xfc, 0xaa, 0x72, 0xb4, 0x53,
-	0xf6, 0x38, 0xde, 0x51, 0x70, 0x87, 0xd7, 0x8e, 0x51, 0xab, 0x4d, 0x39, 0x85, 0x77, 0x22, 0x06,
-	0x09, 0x06, 0x09, 0x66, 0x75, 0xb9, 0x4a, 0xab, 0x34, 0x44, 0x94, 0x60, 0x14, 0xd1, 0xab, 0x2b,
-	0x11, 0xed, 0x44, 0x01, 0xf1, 0x6a, 0x14, 0x92, 0x45, 0xb1, 0x32, 0x66, 0xde, 0xa4, 0x52, 0x85,
-	0x12, 0x5f, 0xc4, 0x97, 0x70, 0x93, 0xf8, 0x54, 0x09, 0xaf, 0xd1, 0xd4, 0xe6, 0xa7, 0xff, 0x00,
-	0x2c, 0x72, 0x5c, 0xf7, 0xd4, 0x0e, 0xaf, 0xd1, 0x36, 0x39, 0xc6, 0x9c, 0x50, 0x1f, 0x7a, 0x00,
-	0x34, 0x71, 0xd7, 0xe1, 0xb4, 0xee, 0xf9, 0x2c, 0x21, 0x6d, 0x48, 0xc9, 0x1b, 0x99, 0x15, 0x24,
-	0x8a, 0x05, 0xe9, 0xc7, 0x92, 0x28, 0x4b, 0x89, 0xaf, 0x6d, 0x7f, 0xf9, 0xb9, 0xbe, 0x55, 0x25,
-	0xbc, 0xd6, 0x29, 0xa3, 0x0a, 0x6d, 0x0a, 0x2b, 0x71, 0x4b, 0x33, 0xb7, 0xae, 0xf0, 0x5e, 0xcb,
-	0x63, 0x21, 0x6c, 0xc5, 0x9b, 0xb8, 0x6b, 0x87, 0x89, 0xe1, 0x07, 0x09, 0x00, 0xdc, 0x68, 0xd0,
-	0x77, 0x4e, 0x83, 0x30, 0x9e, 0x98, 0x0b, 0xeb, 0x3c, 0x45, 0xb3, 0xfb, 0x81, 0xa6, 0x3d, 0xd1,
-	0x2b, 0xdc, 0x20, 0x2e, 0xe6, 0xb4, 0xcd, 0xb4, 0x47, 0x5f, 0xcf, 0x4e, 0x52, 0x5b, 0x17, 0x4a,
-	0x4e, 0xe3, 0x8a, 0x1a, 0xd4, 0xca, 0x13, 0xc6, 0xf7, 0x63, 0x56, 0x1c, 0x8f, 0x1f, 0xe0, 0x7b,
-	0x09, 0xc4, 0x5d, 0xcf, 0xef, 0x45, 0x16, 0xf3, 0xff, 0x62, 0xb1, 0x1d, 0x58, 0x3c, 0xb8, 0xda,
-	0x22, 0xe7, 0xf9, 0x3d, 0x21, 0x71, 0xdd, 0x15, 0x63, 0xf8, 0x1a, 0x40, 0x7c, 0x91, 0x72, 0x82,
-	0x8e, 0x25, 0x16, 0x36, 0xa4, 0xe4, 0xad, 0xcc, 0xc3, 0xcb, 0x5c, 0xfe, 0xc8, 0x6b, 0xf7, 0x5a,
-	0x9e, 0xb5, 0x84, 0xff, 0x9e, 0x5a, 0x7d, 0x01, 0xc0, 0xb9, 0x20, 0xcc, 0x80, 0x6b, 0xd8, 0x75,
-	0xdb, 0x1e, 0x0b, 0x3e, 0xeb, 0x7c, 0x32, 0xae, 0x25, 0x06, 0xfd, 0xf4, 0xb2, 0xc8, 0xaf, 0x46,
-	0x91, 0x22, 0x6f, 0x13, 0xbf, 0x6a, 0x8d, 0xc1, 0xe7, 0x6f, 0xbf, 0xf7, 0xd3, 0x62, 0x23, 0xa3,
-	0x68, 0xe3, 0xce, 0x14, 0x18, 0xf4, 0xd3, 0xb7, 0xcf, 0x57, 0xbe, 0xf1, 0x18, 0x3d, 0xd9, 0xfd,
-	0x78, 0x76, 0x92, 0x5a, 0xbb, 0xb2, 0x1b, 0xda, 0x4d, 0x00, 0x8e, 0x26, 0x7a, 0xa9, 0x81, 0x04,
-	0x96, 0xa6, 0x56, 0x05, 0x37, 0x81, 0xac, 0x96, 0xec, 0xfd, 0x82, 0x65, 0x1c, 0xaa, 0xb6, 0x51,
-	0x30, 0x1d, 0xfb, 0xcd, 0x81, 0xee, 0x94, 0xcc, 0xe2, 0x81, 0x9e, 0x35, 0x5e, 0x1a, 0x7a, 0x6e,
-	0x31, 0x06, 0xd7, 0xc1, 0xdd, 0x19, 0x4c, 0x4e, 0xcf, 0xeb, 0x7b, 0xaa, 0xad, 0x2f, 0x4a, 0xf0,
-	0x3e, 0x58, 0x9b, 0x99, 0x64, 0x82, 0xcc, 0x5d, 0x82, 0x58, 0xfa, 0x04, 0x99, 0x87, 0x19, 0x80,
-	0x66, 0x20, 0x59, 0xd5, 0xcc, 0xea, 0x79, 0xa7, 0x64, 0x6a, 0x05, 0x33, 0x67, 0x98, 0x7b, 0xe3,
-	0xba, 0x46, 0xc1, 0x5c, 0x5c, 0xd0, 0x9e, 0x7d, 0x1b, 0xca, 0xd2, 0xe9, 0x50, 0x96, 0x7e, 0x0d,
-	0x65, 0xe9, 0xf3, 0x48, 0x8e, 0x9d, 0x8e, 0xe4, 0xd8, 0x8f, 0x91, 0x1c, 0x3b, 0xbc, 0x17, 0xf5,
-	0x86, 0xb9, 0x75, 0x44, 0xa8, 0xd2, 0x9d, 0xfc, 0x26, 0xc2, 0xe3, 0x52, 0xfe, 0x3f, 0x3c, 0xa3,
-	0xbb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x61, 0x6c, 0xe3, 0x38, 0x45, 0x04, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc0, 0x9b, 0xfd, 0x79, 0x5f, 0x6a, 0x10, 0x6c, 0xd6, 0x84, 0xba, 0xc1, 0xb2, 0xb1, 0xc3,
+	0x36, 0x3a, 0xea, 0xb0, 0x0e, 0x38, 0x70, 0x22, 0x69, 0xb3, 0x2d, 0xd2, 0x94, 0x4e, 0x69, 0x86,
+	0x60, 0x1c, 0x22, 0x77, 0x89, 0x5a, 0xab, 0x6d, 0x5c, 0xd5, 0xee, 0x68, 0x77, 0x43, 0xe2, 0x84,
+	0x84, 0xc4, 0xe7, 0xe0, 0x34, 0xa1, 0x7e, 0x08, 0xc4, 0x69, 0xda, 0x89, 0x1b, 0xa8, 0x3d, 0xec,
+	0x5b, 0x20, 0x94, 0xc4, 0xed, 0x0a, 0xcd, 0x76, 0xe1, 0xd2, 0x3a, 0x7e, 0x7e, 0x7e, 0x9e, 0x9f,
+	0x9f, 0xd8, 0x01, 0x2b, 0x47, 0x94, 0xd5, 0x29, 0x53, 0x18, 0xc7, 0x55, 0xe2, 0x97, 0x95, 0xe3,
+	0xcd, 0x92, 0xc7, 0xf1, 0xa6, 0x82, 0x5b, 0xbc, 0x72, 0x82, 0x1a, 0x4d, 0xca, 0x29, 0xbc, 0x1b,
+	0x31, 0x48, 0x30, 0x48, 0x30, 0x0b, 0x73, 0x65, 0x5a, 0xa6, 0x21, 0xa2, 0x04, 0xa3, 0x88, 0x5e,
+	0x98, 0x8f, 0x68, 0x27, 0x0a, 0x88, 0xa5, 0x51, 0x48, 0x16, 0xc5, 0x4a, 0x98, 0x79, 0xc3, 0x4a,
+	0x47, 0x94, 0xf8, 0x22, 0x3e, 0x8b, 0xeb, 0xc4, 0xa7, 0x4a, 0xf8, 0x1b, 0x4d, 0xad, 0x7c, 0x9c,
+	0x06, 0xb0, 0xc8, 0x71, 0xd5, 0x53, 0x5b, 0xbc, 0x42, 0x9b, 0xe4, 0x04, 0x73, 0x42, 0x7d, 0xe8,
+	0x01, 0x50, 0xc7, 0x6d, 0x87, 0xd3, 0xaa, 0xe7, 0xb3, 0x94, 0xb4, 0x2c, 0xad, 0xdf, 0xcc, 0xce,
+	0x23, 0x51, 0x2c, 0x48, 0x3f, 0x90, 0x44, 0x39, 0x4a, 0x7c, 0x6d, 0xe3, 0xf3, 0x8f, 0xa5, 0xb5,
+	0x32, 0xe1, 0x95, 0x56, 0x09, 0x1d, 0xd1, 0xba, 0xb0, 0x12, 0x7f, 0x19, 0xe6, 0x56, 0x15, 0xde,
+	0x69, 0x78, 0x2c, 0x84, 0xad, 0x64, 0x1d, 0xb7, 0xed, 0x30, 0x31, 0x7c, 0x2f, 0x01, 0x80, 0x6b,
+	0x35, 0xfa, 0xd6, 0xa9, 0x11, 0xc6, 0x53, 0x13, 0x61, 0x9d, 0xa7, 0x28, 0xbe, 0x1f, 0x68, 0xdc,
+	0x13, 0xbd, 0xc4, 0x35, 0xe2, 0x62, 0x4e, 0x9b, 0x4c, 0x7b, 0xf4, 0xe5, 0xe2, 0x34, 0xbd, 0x36,
+	0x52, 0x72, 0x1c, 0x57, 0xd4, 0xa0, 0xd6, 0x1e, 0x61, 0x7c, 0x37, 0x61, 0x25, 0xf1, 0xe0, 0x01,
+	0xbe, 0x93, 0x40, 0xd2, 0xf5, 0xfc, 0x4e, 0x64, 0x31, 0xf9, 0x2f, 0x16, 0x1b, 0x81, 0xc5, 0xea,
+	0xf5, 0x16, 0x79, 0xcf, 0xef, 0x08, 0x89, 0x1b, 0xae, 0x18, 0xc3, 0x57, 0x00, 0xe2, 0x51, 0xca,
+	0x09, 0x3a, 0x96, 0x9a, 0x5a, 0x96, 0xd6, 0x6f, 0x67, 0x1f, 0x5e, 0xe5, 0xf2, 0x47, 0x5e, 0xbb,
+	0xd3, 0xf0, 0xac, 0x59, 0xfc, 0xf7, 0xd4, 0xc2, 0x0b, 0x00, 0x2e, 0x05, 0x61, 0x16, 0xfc, 0x8f,
+	0x5d, 0xb7, 0xe9, 0xb1, 0xe0, 0xb5, 0x4e, 0xae, 0x27, 0xb5, 0xd4, 0x79, 0x37, 0x33, 0x27, 0xf2,
+	0xab, 0x51, 0xa4, 0xc8, 0x9b, 0xc4, 0x2f, 0x5b, 0x03, 0xf0, 0xf9, 0x9b, 0x6f, 0xdd, 0x8c, 0x38,
+	0xc8, 0x28, 0x3a, 0xb8, 0xb1, 0x02, 0xe7, 0xdd, 0xcc, 0x9d, 0xcb, 0x9d, 0x2f, 0x3f, 0x46, 0x4f,
+	0xb6, 0x3e, 0x5c, 0x9c, 0xa6, 0x17, 0xaf, 0xed, 0x86, 0x76, 0x0b, 0x80, 0xe3, 0xa1, 0x5e, 0xfa,
+	0x97, 0x04, 0x66, 0xc7, 0x76, 0x05, 0x57, 0x80, 0xac, 0x1e, 0xd8, 0xbb, 0x05, 0xcb, 0x38, 0x54,
+	0x6d, 0xa3, 0x60, 0x3a, 0xf6, 0xeb, 0x7d, 0xdd, 0x39, 0x30, 0x8b, 0xfb, 0x7a, 0xce, 0xd8, 0x36,
+	0xf4, 0xfc, 0x4c, 0x02, 0x2e, 0x81, 0x7b, 0x31, 0x4c, 0x5e, 0xdf, 0xd3, 0x77, 0x54, 0x5b, 0x9f,
+	0x91, 0xe0, 0x03, 0xb0, 0x18, 0x9b, 0x64, 0x88, 0x4c, 0x5c, 0x81, 0x58, 0xfa, 0x10, 0x99, 0x84,
+	0x59, 0x80, 0x62, 0x90, 0x9c, 0x6a, 0xe6, 0xf4, 0x3d, 0xe7, 0xc0, 0xd4, 0x0a, 0x66, 0xde, 0x30,
+	0x77, 0x06, 0x75, 0x8d, 0x82, 0x39, 0x33, 0x05, 0xd3, 0x60, 0x35, 0x66, 0x8d, 0x6d, 0xa9, 0x66,
+	0x71, 0x5b, 0xb7, 0x46, 0xd9, 0x69, 0xed, 0xd9, 0xd7, 0x9e, 0x2c, 0x9d, 0xf5, 0x64, 0xe9, 0x67,
+	0x4f, 0x96, 0x3e, 0xf5, 0xe5, 0xc4, 0x59, 0x5f, 0x4e, 0x7c, 0xef, 0xcb, 0x89, 0xc3, 0xfb, 0x51,
+	0x1f, 0x99, 0x5b, 0x45, 0x84, 0x2a, 0xed, 0xe1, 0x27, 0x25, 0xbc, 0x5a, 0xa5, 0xff, 0xc2, 0xfb,
+	0xbc, 0xf5, 0x7b, 0x00, 0x5f, 0x01, 0x7a, 0x0e, 0x71, 0x04, 0x00, 0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
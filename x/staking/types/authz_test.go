@@ -109,6 +109,10 @@ func TestAuthzAuthorizations(t *testing.T) {
 	cancelUnbondAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION, &coin100, valAddressCodec)
 	require.Equal(t, cancelUnbondAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}))
 
+	// verify MethodName for TransferDelegation
+	transferAuth, _ := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION, &coin100, valAddressCodec)
+	require.Equal(t, transferAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgTransferDelegation{}))
+
 	validators1_2 := []string{valAddressToString(t, val1), valAddressToString(t, val2)}
 
 	testCases := []struct {
@@ -405,6 +409,34 @@ func TestAuthzAuthorizations(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"transfer delegation: verify remaining coins",
+			[]sdk.ValAddress{val1},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION,
+			&coin100,
+			stakingtypes.NewMsgTransferDelegation(accAddressToString(t, delAddr), valAddressToString(t, val1), accAddressToString(t, delAddr), coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{valAddressToString(t, val1)}},
+				},
+				MaxTokens:         &coin50,
+				AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION,
+			},
+		},
+		{
+			"transfer delegation: fail cannot transfer, permission denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_TRANSFER_DELEGATION,
+			&coin100,
+			stakingtypes.NewMsgTransferDelegation(accAddressToString(t, delAddr), valAddressToString(t, val1), accAddressToString(t, delAddr), coin100),
+			true,
+			false,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
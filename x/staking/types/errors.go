@@ -50,4 +50,21 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound = errors.Register(ModuleName, 48, "tokenize share record not found")
+	ErrNotTokenizeShareDenom       = errors.Register(ModuleName, 49, "denom is not a tokenize share denom")
+	ErrLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "liquid staking cap exceeded")
+	ErrTokenizeVestingShares       = errors.Register(ModuleName, 51, "vesting delegation shares cannot be tokenized")
+	ErrTokenizeRedelegatedShares   = errors.Register(ModuleName, 58, "delegation with a redelegation in progress cannot be tokenized")
+
+	// delegation transfer errors
+	ErrTransferVestingShares     = errors.Register(ModuleName, 52, "vesting delegation shares cannot be transferred")
+	ErrTransferToSelf            = errors.Register(ModuleName, 53, "cannot transfer a delegation to its own delegator")
+	ErrTransferRedelegatedShares = errors.Register(ModuleName, 54, "delegation with a redelegation in progress cannot be transferred")
+
+	// commission schedule errors
+	ErrCommissionScheduleNotice   = errors.Register(ModuleName, 55, "commission schedule effective time does not meet the minimum notice")
+	ErrCommissionScheduleNotFound = errors.Register(ModuleName, 56, "commission schedule not found")
+	ErrCommissionSchedulePending  = errors.Register(ModuleName, 57, "commission cannot be edited while a commission schedule is pending")
 )
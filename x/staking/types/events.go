@@ -10,6 +10,12 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_tokens_for_shares"
+	EventTypeTransferDelegation        = "transfer_delegation"
+	EventTypeScheduleCommission        = "schedule_commission_change"
+	EventTypeApplyCommissionSchedule   = "apply_commission_schedule"
+	EventTypeCommissionScheduleFailed  = "commission_schedule_failed"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +26,9 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyEffectiveTime     = "effective_time"
+	AttributeKeyError             = "error"
 )
@@ -58,6 +58,12 @@ type GenesisState struct {
 	RotatedConsAddresses []RotatedConsensusAddresses `protobuf:"bytes,12,rep,name=rotated_cons_addresses,json=rotatedConsAddresses,proto3" json:"rotated_cons_addresses"`
 	// InitialConsAddresses contains current to initial address pair
 	InitialConsAddresses []RotatedConsensusAddresses `protobuf:"bytes,13,rep,name=initial_cons_addresses,json=initialConsAddresses,proto3" json:"initial_cons_addresses"`
+	// tokenize_share_records defines the tokenized delegations at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,14,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// next_tokenize_share_record_id is the id of the next tokenize share record to be created.
+	NextTokenizeShareRecordId uint64 `protobuf:"varint,15,opt,name=next_tokenize_share_record_id,json=nextTokenizeShareRecordId,proto3" json:"next_tokenize_share_record_id,omitempty"`
+	// commission_schedules defines the announced commission schedules at genesis.
+	CommissionSchedules []CommissionSchedule `protobuf:"bytes,16,rep,name=commission_schedules,json=commissionSchedules,proto3" json:"commission_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -177,6 +183,27 @@ func (m *GenesisState) GetInitialConsAddresses() []RotatedConsensusAddresses {
 	return nil
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.NextTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommissionSchedules() []CommissionSchedule {
+	if m != nil {
+		return m.CommissionSchedules
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -364,57 +391,62 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0xe3, 0x46,
-	0x18, 0xc6, 0x63, 0xfe, 0x84, 0x64, 0x12, 0x28, 0x1d, 0x02, 0x32, 0x51, 0x95, 0xa4, 0x11, 0x87,
-	0x88, 0x0a, 0xbb, 0xa1, 0x55, 0xa5, 0xf6, 0x46, 0x5a, 0xa9, 0x45, 0xa0, 0x42, 0x0d, 0xf4, 0x80,
-	0x54, 0x59, 0x13, 0x3c, 0x18, 0x0b, 0x67, 0x26, 0x9d, 0x99, 0x04, 0xf2, 0x0d, 0x7a, 0xe4, 0xde,
-	0x0b, 0xc7, 0x1e, 0x7b, 0x60, 0xbf, 0x03, 0x47, 0xc4, 0x69, 0xb5, 0x07, 0x76, 0x05, 0x87, 0xdd,
-	0x8f, 0xb1, 0xf2, 0x8c, 0x6d, 0x9c, 0x4d, 0xcc, 0xb2, 0xd2, 0x5e, 0x10, 0xf6, 0xfb, 0x3c, 0xbf,
-	0xe7, 0x7d, 0x47, 0xce, 0xbc, 0x60, 0xe5, 0x88, 0xf2, 0x0e, 0xe5, 0x26, 0x17, 0xe8, 0xd4, 0x23,
-	0xae, 0xd9, 0x6f, 0xb6, 0xb1, 0x40, 0x4d, 0xd3, 0xc5, 0x04, 0x73, 0x8f, 0x1b, 0x5d, 0x46, 0x05,
-	0x85, 0x4b, 0x4a, 0x65, 0x84, 0x2a, 0x23, 0x54, 0x95, 0x4b, 0x2e, 0x75, 0xa9, 0x94, 0x98, 0xc1,
-	0x7f, 0x4a, 0x5d, 0x4e, 0x63, 0x46, 0x6e, 0xa5, 0x5a, 0x56, 0x2a, 0x5b, 0xd9, 0xc3, 0x00, 0x55,
-	0xfa, 0x12, 0x75, 0x3c, 0x42, 0x4d, 0xf9, 0x37, 0x7c, 0x55, 0x75, 0x29, 0x75, 0x7d, 0x6c, 0xca,
-	0xa7, 0x76, 0xef, 0xd8, 0x14, 0x5e, 0x07, 0x73, 0x81, 0x3a, 0x5d, 0x25, 0xa8, 0xbf, 0xc8, 0x83,
-	0xe2, 0xaf, 0xaa, 0xe9, 0x3d, 0x81, 0x04, 0x86, 0x1b, 0x20, 0xdb, 0x45, 0x0c, 0x75, 0xb8, 0xae,
-	0xd5, 0xb4, 0x46, 0x61, 0xbd, 0x62, 0x8c, 0x1f, 0xc2, 0xd8, 0x95, 0xaa, 0x56, 0xfe, 0xfa, 0xae,
-	0x9a, 0xf9, 0xef, 0xed, 0xff, 0xab, 0x9a, 0x15, 0x1a, 0xe1, 0x21, 0x98, 0xf7, 0x11, 0x17, 0xb6,
-	0xa0, 0x02, 0xf9, 0x76, 0x97, 0x9e, 0x61, 0xa6, 0x4f, 0xd4, 0xb4, 0x46, 0xb1, 0xf5, 0x6d, 0x20,
-	0x7e, 0x75, 0x57, 0x5d, 0x54, 0x4c, 0xee, 0x9c, 0x1a, 0x1e, 0x35, 0x3b, 0x48, 0x9c, 0x18, 0x9b,
-	0x44, 0xdc, 0x5e, 0xad, 0x81, 0x30, 0x6c, 0x93, 0x08, 0xc5, 0x9c, 0x0b, 0x48, 0xfb, 0x01, 0x68,
-	0x37, 0xe0, 0x40, 0x0f, 0x2c, 0x4a, 0x76, 0x1f, 0xf9, 0x9e, 0x83, 0x04, 0x65, 0x8a, 0xcf, 0xf5,
-	0xc9, 0xda, 0x64, 0xa3, 0xb0, 0xbe, 0x9a, 0xd6, 0xed, 0x36, 0xe2, 0xe2, 0xcf, 0xc8, 0x23, 0x51,
-	0xc9, 0xce, 0x17, 0xfc, 0x91, 0x32, 0x87, 0xdb, 0x00, 0xc4, 0x29, 0x5c, 0x9f, 0x92, 0xfc, 0xaf,
-	0xd3, 0xf8, 0xb1, 0x39, 0x89, 0x4d, 0xf8, 0xe1, 0x0e, 0x28, 0x38, 0xd8, 0xc7, 0x2e, 0x12, 0x1e,
-	0x25, 0x5c, 0x9f, 0x96, 0xb8, 0x7a, 0x1a, 0xee, 0x97, 0x58, 0x9a, 0xe4, 0x25, 0x09, 0xf0, 0x14,
-	0x2c, 0xf6, 0x48, 0x9b, 0x12, 0xc7, 0x23, 0xae, 0x9d, 0x44, 0x67, 0x25, 0xfa, 0x9b, 0x34, 0xf4,
-	0x41, 0x64, 0x1a, 0x9f, 0x51, 0xea, 0x8d, 0xd6, 0x39, 0x3c, 0x00, 0xb3, 0x0c, 0x27, 0x43, 0x66,
-	0x64, 0xc8, 0x4a, 0x5a, 0x88, 0x95, 0x10, 0x27, 0xe9, 0xc3, 0x14, 0x58, 0x06, 0x39, 0x7c, 0xde,
-	0xa5, 0x4c, 0x60, 0x47, 0xcf, 0xd5, 0xb4, 0x46, 0xce, 0x8a, 0x9f, 0xa1, 0x0f, 0x96, 0x18, 0x15,
-	0x52, 0x68, 0x7b, 0xc4, 0xc1, 0xe7, 0x36, 0xc3, 0x47, 0x94, 0x39, 0x5c, 0xcf, 0x3f, 0x3d, 0xa0,
-	0x15, 0xba, 0x36, 0x03, 0x93, 0x25, 0x3d, 0x43, 0x03, 0xb2, 0xd1, 0x3a, 0x87, 0x2e, 0x98, 0x8f,
-	0xd3, 0x4e, 0x3c, 0x2e, 0x28, 0x1b, 0xe8, 0x40, 0xe6, 0x34, 0xd3, 0x72, 0x7e, 0xa6, 0x84, 0xef,
-	0xf6, 0xda, 0x5b, 0x78, 0x10, 0x25, 0xfe, 0xa6, 0x8c, 0xc9, 0xb4, 0x2f, 0xd8, 0x70, 0x0d, 0xfe,
-	0x05, 0xe6, 0xe2, 0xa0, 0xbf, 0x7b, 0xb8, 0x87, 0xf5, 0xc2, 0xf3, 0xc6, 0xf9, 0x23, 0x10, 0x8f,
-	0x8e, 0x33, 0xcb, 0x92, 0x75, 0xc8, 0xc2, 0x53, 0xc3, 0x8e, 0x7d, 0x44, 0x09, 0xb7, 0x91, 0xe3,
-	0x30, 0xcc, 0x39, 0xe6, 0x7a, 0xf1, 0xe9, 0x69, 0x2c, 0xe5, 0x0a, 0x86, 0xc2, 0x84, 0xf7, 0xf8,
-	0x46, 0x64, 0x1c, 0x3d, 0x3b, 0xa5, 0x8a, 0x05, 0x41, 0xa6, 0x47, 0x3c, 0xe1, 0x21, 0xff, 0xc3,
-	0xcc, 0xd9, 0xcf, 0x91, 0x19, 0xb2, 0x87, 0x32, 0xeb, 0x27, 0x00, 0x8e, 0xfe, 0xa4, 0xe1, 0x3a,
-	0x98, 0x09, 0xc3, 0xe5, 0xed, 0x95, 0x6f, 0xe9, 0xb7, 0x57, 0x6b, 0xa5, 0x30, 0x3d, 0x34, 0xef,
-	0x09, 0xe6, 0x11, 0xd7, 0x8a, 0x84, 0xb0, 0x04, 0xa6, 0x1f, 0xaf, 0xa8, 0x49, 0x4b, 0x3d, 0xfc,
-	0x94, 0xfb, 0xe7, 0xb2, 0x9a, 0x79, 0x77, 0x59, 0xcd, 0xd4, 0xff, 0xd5, 0xc0, 0x72, 0x6a, 0xa3,
-	0xf0, 0x47, 0x50, 0xa0, 0xbe, 0x63, 0x3f, 0x37, 0x15, 0x50, 0xdf, 0x09, 0xdf, 0x04, 0x56, 0x82,
-	0xcf, 0x62, 0xeb, 0xc4, 0xc7, 0xac, 0x04, 0x9f, 0x85, 0x6f, 0x12, 0xdd, 0x51, 0xb0, 0x30, 0xe6,
-	0x7b, 0x87, 0xfa, 0xf0, 0x41, 0x14, 0x1f, 0xc7, 0xfd, 0x1e, 0x4c, 0x05, 0x3b, 0x40, 0xcf, 0xca,
-	0xdb, 0xbd, 0x6c, 0xa8, 0x05, 0x61, 0x44, 0x0b, 0xc2, 0xd8, 0x8f, 0x16, 0x44, 0x6b, 0xea, 0xe2,
-	0x75, 0x55, 0xb3, 0xa4, 0x7a, 0xf8, 0x38, 0x16, 0xc6, 0x7c, 0x92, 0xf0, 0x77, 0x90, 0xef, 0x23,
-	0x5f, 0x4e, 0x13, 0xad, 0x8e, 0xe6, 0x13, 0x97, 0x65, 0x30, 0x09, 0xdf, 0x39, 0x4e, 0x1c, 0xec,
-	0x16, 0x1e, 0x70, 0x2b, 0xd7, 0x0f, 0x4b, 0x71, 0x9f, 0x13, 0x9f, 0xd2, 0x67, 0xeb, 0x87, 0xeb,
-	0xfb, 0x8a, 0x76, 0x73, 0x5f, 0xd1, 0xde, 0xdc, 0x57, 0xb4, 0x8b, 0x87, 0x4a, 0xe6, 0xe6, 0xa1,
-	0x92, 0x79, 0xf9, 0x50, 0xc9, 0x1c, 0x7e, 0x35, 0xb4, 0x72, 0xce, 0xe3, 0x2d, 0x2b, 0x06, 0x5d,
-	0xcc, 0xdb, 0x59, 0xc9, 0xfd, 0xee, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x68, 0x15, 0xf4,
-	0xd8, 0x07, 0x00, 0x00,
+	// 877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x69, 0xea, 0x8c, 0x93, 0x34, 0x4c, 0x9c, 0x68, 0x13, 0x81, 0x6d, 0xa2, 0x1e,
+	0xac, 0xa2, 0xae, 0x49, 0x40, 0x48, 0x70, 0xa2, 0x2e, 0x12, 0x44, 0xad, 0x68, 0xd8, 0xa4, 0x1c,
+	0x2a, 0xa1, 0xd5, 0xd8, 0x3b, 0x5d, 0x8f, 0xb2, 0x9e, 0x31, 0xf3, 0xc6, 0x49, 0xcc, 0x27, 0xe0,
+	0xd8, 0x3b, 0x97, 0x1e, 0x39, 0x72, 0xe8, 0x87, 0xe8, 0x8d, 0xaa, 0x27, 0xc4, 0xa1, 0xa0, 0xe4,
+	0x00, 0x1f, 0x03, 0xed, 0xcc, 0xec, 0x76, 0x17, 0x7b, 0x4d, 0x91, 0x7a, 0xb1, 0xbc, 0xf3, 0x7e,
+	0x7f, 0xde, 0x7b, 0xb3, 0x7e, 0xcf, 0xe8, 0x66, 0x5f, 0xc0, 0x50, 0x40, 0x07, 0x14, 0x39, 0x65,
+	0x3c, 0xea, 0x9c, 0xed, 0xf7, 0xa8, 0x22, 0xfb, 0x9d, 0x88, 0x72, 0x0a, 0x0c, 0xbc, 0x91, 0x14,
+	0x4a, 0xe0, 0x6d, 0x83, 0xf2, 0x2c, 0xca, 0xb3, 0xa8, 0xdd, 0x7a, 0x24, 0x22, 0xa1, 0x21, 0x9d,
+	0xe4, 0x9b, 0x41, 0xef, 0x96, 0x69, 0xa6, 0x6c, 0x83, 0xda, 0x31, 0xa8, 0xc0, 0xd0, 0xad, 0x81,
+	0x09, 0xbd, 0x43, 0x86, 0x8c, 0x8b, 0x8e, 0xfe, 0xb4, 0x47, 0xcd, 0x48, 0x88, 0x28, 0xa6, 0x1d,
+	0xfd, 0xd4, 0x1b, 0x3f, 0xee, 0x28, 0x36, 0xa4, 0xa0, 0xc8, 0x70, 0x64, 0x00, 0x7b, 0xbf, 0xd6,
+	0xd0, 0xea, 0x97, 0x26, 0xe9, 0x63, 0x45, 0x14, 0xc5, 0x77, 0xd0, 0xf2, 0x88, 0x48, 0x32, 0x04,
+	0xd7, 0x69, 0x39, 0xed, 0xda, 0x41, 0xc3, 0x9b, 0x5d, 0x84, 0x77, 0xa4, 0x51, 0xdd, 0x95, 0xe7,
+	0xaf, 0x9a, 0x95, 0x9f, 0xff, 0xfa, 0xe5, 0x96, 0xe3, 0x5b, 0x22, 0x7e, 0x84, 0x36, 0x62, 0x02,
+	0x2a, 0x50, 0x42, 0x91, 0x38, 0x18, 0x89, 0x73, 0x2a, 0xdd, 0x85, 0x96, 0xd3, 0x5e, 0xed, 0x7e,
+	0x98, 0x80, 0x7f, 0x7f, 0xd5, 0xdc, 0x32, 0x9a, 0x10, 0x9e, 0x7a, 0x4c, 0x74, 0x86, 0x44, 0x0d,
+	0xbc, 0x43, 0xae, 0x5e, 0x3e, 0xbb, 0x8d, 0xac, 0xd9, 0x21, 0x57, 0x46, 0x73, 0x3d, 0x51, 0x3a,
+	0x49, 0x84, 0x8e, 0x12, 0x1d, 0xcc, 0xd0, 0x96, 0xd6, 0x3e, 0x23, 0x31, 0x0b, 0x89, 0x12, 0xd2,
+	0xe8, 0x83, 0xbb, 0xd8, 0x5a, 0x6c, 0xd7, 0x0e, 0x6e, 0x95, 0x65, 0x7b, 0x9f, 0x80, 0xfa, 0x36,
+	0xe5, 0x68, 0xa9, 0x7c, 0xe6, 0x9b, 0xf1, 0x54, 0x18, 0xf0, 0x7d, 0x84, 0x32, 0x17, 0x70, 0x97,
+	0xb4, 0xfe, 0xfb, 0x65, 0xfa, 0x19, 0x39, 0x2f, 0x9b, 0xe3, 0xe3, 0x07, 0xa8, 0x16, 0xd2, 0x98,
+	0x46, 0x44, 0x31, 0xc1, 0xc1, 0xbd, 0xa6, 0xe5, 0xf6, 0xca, 0xe4, 0xbe, 0xc8, 0xa0, 0x79, 0xbd,
+	0xbc, 0x02, 0x3e, 0x45, 0x5b, 0x63, 0xde, 0x13, 0x3c, 0x64, 0x3c, 0x0a, 0xf2, 0xd2, 0xcb, 0x5a,
+	0xfa, 0x83, 0x32, 0xe9, 0x87, 0x29, 0x69, 0xb6, 0x47, 0x7d, 0x3c, 0x1d, 0x07, 0xfc, 0x10, 0xad,
+	0x49, 0x9a, 0x37, 0xb9, 0xae, 0x4d, 0x6e, 0x96, 0x99, 0xf8, 0x34, 0x9c, 0xa9, 0x5e, 0x54, 0xc1,
+	0xbb, 0xa8, 0x4a, 0x2f, 0x46, 0x42, 0x2a, 0x1a, 0xba, 0xd5, 0x96, 0xd3, 0xae, 0xfa, 0xd9, 0x33,
+	0x8e, 0xd1, 0xb6, 0x14, 0x4a, 0x03, 0x03, 0xc6, 0x43, 0x7a, 0x11, 0x48, 0xda, 0x17, 0x32, 0x04,
+	0x77, 0x65, 0x7e, 0x81, 0xbe, 0x65, 0x1d, 0x26, 0x24, 0x5f, 0x73, 0x0a, 0x05, 0xca, 0xe9, 0x38,
+	0xe0, 0x08, 0x6d, 0x64, 0x6e, 0x03, 0x06, 0x4a, 0xc8, 0x89, 0x8b, 0xb4, 0xcf, 0x7e, 0x99, 0xcf,
+	0x5d, 0xc1, 0xe1, 0x68, 0xdc, 0xbb, 0x47, 0x27, 0xa9, 0xe3, 0x57, 0x86, 0x98, 0x77, 0xbb, 0x21,
+	0x8b, 0x31, 0xfc, 0x1d, 0x5a, 0xcf, 0x8c, 0xbe, 0x1f, 0xd3, 0x31, 0x75, 0x6b, 0x6f, 0x56, 0xce,
+	0x37, 0x09, 0x78, 0xba, 0x9c, 0x35, 0x99, 0x8f, 0x63, 0x69, 0xbb, 0x46, 0xc3, 0xa0, 0x2f, 0x38,
+	0x04, 0x24, 0x0c, 0x25, 0x05, 0xa0, 0xe0, 0xae, 0xce, 0xaf, 0xc6, 0x37, 0xac, 0xa4, 0x28, 0xca,
+	0x61, 0x0c, 0x77, 0x52, 0xe2, 0x74, 0xef, 0x0c, 0x2a, 0x03, 0x24, 0x9e, 0x8c, 0x33, 0xc5, 0x48,
+	0xfc, 0x6f, 0xcf, 0xb5, 0xb7, 0xe1, 0x69, 0xb5, 0x8b, 0x9e, 0x31, 0xda, 0x56, 0xe2, 0x94, 0x72,
+	0xf6, 0x03, 0x0d, 0x60, 0x40, 0x24, 0xcd, 0xde, 0x8e, 0xf5, 0xf9, 0xed, 0x3c, 0xb1, 0xac, 0xe3,
+	0x84, 0x34, 0xe3, 0xed, 0x50, 0xd3, 0x71, 0xc0, 0x9f, 0xa3, 0xf7, 0x38, 0xbd, 0x50, 0x41, 0x1a,
+	0x2c, 0x58, 0x06, 0x2c, 0x74, 0x6f, 0xb4, 0x9c, 0xf6, 0x92, 0xbf, 0x93, 0x80, 0x66, 0x18, 0x1c,
+	0x86, 0x78, 0x80, 0xea, 0x7d, 0x31, 0x1c, 0x32, 0x80, 0xe4, 0xe2, 0xa1, 0x3f, 0xa0, 0xe1, 0x38,
+	0xa6, 0xe0, 0x6e, 0xcc, 0x1f, 0x5b, 0x77, 0x33, 0xce, 0xb1, 0xa5, 0x14, 0xc6, 0x56, 0x7f, 0x2a,
+	0x0c, 0x7b, 0x03, 0x84, 0xa7, 0x87, 0x1d, 0x3e, 0x40, 0xd7, 0xed, 0xb5, 0xe8, 0xb9, 0xbe, 0xd2,
+	0x75, 0x5f, 0x3e, 0xbb, 0x5d, 0xb7, 0xae, 0xb6, 0xad, 0xc7, 0x4a, 0x32, 0x1e, 0xf9, 0x29, 0x10,
+	0xd7, 0xd1, 0xb5, 0xd7, 0xc3, 0x7b, 0xd1, 0x37, 0x0f, 0x9f, 0x55, 0x7f, 0x7c, 0xda, 0xac, 0xfc,
+	0xfd, 0xb4, 0x59, 0xd9, 0xfb, 0xc9, 0x41, 0x3b, 0xa5, 0x57, 0x88, 0x3f, 0x45, 0x35, 0x11, 0x87,
+	0xc1, 0x9b, 0xba, 0x22, 0x11, 0x87, 0xf6, 0x24, 0xa1, 0x72, 0x7a, 0x9e, 0x51, 0x17, 0xfe, 0x8b,
+	0xca, 0xe9, 0xb9, 0x3d, 0xc9, 0x65, 0x27, 0xd0, 0xe6, 0x8c, 0x49, 0x80, 0xdd, 0x62, 0x23, 0x56,
+	0x5f, 0x97, 0xfb, 0x31, 0x5a, 0x4a, 0xb6, 0xa3, 0xbb, 0xac, 0xf7, 0xde, 0xae, 0x67, 0x56, 0xa7,
+	0x97, 0xae, 0x4e, 0xef, 0x24, 0x5d, 0x9d, 0xdd, 0xa5, 0x27, 0x7f, 0x34, 0x1d, 0x5f, 0xa3, 0x8b,
+	0xed, 0xd8, 0x9c, 0xf1, 0x63, 0xc5, 0x5f, 0xa3, 0x95, 0x33, 0x12, 0xeb, 0x6a, 0xd2, 0xa5, 0xba,
+	0x3f, 0x67, 0x8d, 0x24, 0x95, 0xc0, 0x83, 0xc7, 0xb9, 0xc6, 0xde, 0xa3, 0x13, 0xf0, 0xab, 0x67,
+	0x36, 0x94, 0xe5, 0xb9, 0xf0, 0x7f, 0xf2, 0xec, 0x7e, 0xf2, 0xfc, 0xb2, 0xe1, 0xbc, 0xb8, 0x6c,
+	0x38, 0x7f, 0x5e, 0x36, 0x9c, 0x27, 0x57, 0x8d, 0xca, 0x8b, 0xab, 0x46, 0xe5, 0xb7, 0xab, 0x46,
+	0xe5, 0xd1, 0xbb, 0x85, 0x65, 0x7c, 0x91, 0xfd, 0xff, 0x50, 0x93, 0x11, 0x85, 0xde, 0xb2, 0xd6,
+	0xfd, 0xe8, 0x9f, 0x01, 0x00, 0xb8, 0x7d, 0xbd, 0xe6, 0xf2, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -437,6 +469,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionSchedules) > 0 {
+		for iNdEx := len(m.CommissionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NextTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.InitialConsAddresses) > 0 {
 		for iNdEx := len(m.InitialConsAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -851,6 +918,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTokenizeShareRecordId))
+	}
+	if len(m.CommissionSchedules) > 0 {
+		for _, e := range m.CommissionSchedules {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1382,6 +1464,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTokenizeShareRecordId", wireType)
+			}
+			m.NextTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionSchedules = append(m.CommissionSchedules, CommissionSchedule{})
+			if err := m.CommissionSchedules[len(m.CommissionSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -63,6 +63,14 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordIDKey       = collections.NewPrefix(121) // key for the sequence of the tokenize share record ids
+	TokenizeShareRecordsKey        = collections.NewPrefix(122) // prefix for each key to a tokenize share record
+	TokenizeShareRecordsByOwnerKey = collections.NewPrefix(123) // prefix for each key to a tokenize share record, by owner
+	ValidatorLiquidSharesKey       = collections.NewPrefix(124) // prefix for the tokenized shares of each validator
+
+	CommissionSchedulesKey     = collections.NewPrefix(125) // prefix for the pending commission schedule of each validator
+	CommissionScheduleQueueKey = collections.NewPrefix(126) // prefix for the commission schedules by effective time
 )
 
 // Reserved kvstore keys
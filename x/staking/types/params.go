@@ -25,10 +25,22 @@ const (
 	// DefaultMaxEntries is the default maximum number of entries
 	// in a UBD (Unbonding Delegation) or RED (Redelegation) pair.
 	DefaultMaxEntries uint32 = 7
+
+	// DefaultMinCommissionScheduleNotice is the default minimum notice of a
+	// commission schedule, one week.
+	DefaultMinCommissionScheduleNotice time.Duration = time.Hour * 24 * 7
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, no cap on the total tokenized shares
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, no cap on the tokenized shares of a validator
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
@@ -44,6 +56,11 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+
+		MinCommissionScheduleNotice: DefaultMinCommissionScheduleNotice,
 	}
 }
 
@@ -109,6 +126,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if p.MinCommissionScheduleNotice < 0 {
+		return fmt.Errorf("min commission schedule notice must not be negative: %s", p.MinCommissionScheduleNotice)
+	}
+
 	return nil
 }
 
@@ -221,3 +250,17 @@ func validateKeyRotationFee(bondDenom string, coin sdk.Coin) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil", name)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
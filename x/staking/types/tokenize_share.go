@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner, moduleAccount, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: moduleAccount,
+		Validator:     validator,
+	}
+}
+
+// TokenizeShareRecordAddress returns the address of the account holding the delegation
+// of the tokenize share record with the given id.
+func TokenizeShareRecordAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("tokenize_share_record_%d", id)))
+}
+
+// GetShareTokenDenom returns the {validator}/{id} denom of the tokenized shares of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// ParseShareTokenDenom returns the record id of a {validator}/{id} tokenized shares denom.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return 0, errorsmod.Wrapf(ErrNotTokenizeShareDenom, "denom %s is not of the form {validator}/{id}", denom)
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, errorsmod.Wrapf(ErrNotTokenizeShareDenom, "denom %s has an invalid record id: %s", denom, err)
+	}
+	return id, nil
+}